@@ -4,6 +4,9 @@ type Dialect interface {
 	IsIdentifierStart(r rune) bool
 	IsIdentifierPart(r rune) bool
 	IsDelimitedIdentifierStart(r rune) bool
+	NormalizeIdent(raw string, quoted bool) string // canonical form of identifier used for comparison
+	IsReservedKeyword(word string) bool            // word can't be an identifier without quotes
+	Supports(f Feature) bool
 }
 
 type GenericSQLDialect struct {
@@ -21,4 +24,23 @@ func (*GenericSQLDialect) IsDelimitedIdentifierStart(r rune) bool {
 	return r == '"'
 }
 
+func (*GenericSQLDialect) NormalizeIdent(raw string, quoted bool) string {
+	return raw
+}
+
+// IsReservedKeyword reports true only for the keywords reserved in all dialects.
+func (*GenericSQLDialect) IsReservedKeyword(word string) bool {
+	return isReservedKeyword(ReservedKeywords, word)
+}
+
+// Supports reports true for the features which do not conflict with
+// the standard syntax.
+func (*GenericSQLDialect) Supports(f Feature) bool {
+	switch f {
+	case ArrayTypes, WildcardModifiers:
+		return true
+	}
+	return false
+}
+
 var _ Dialect = &GenericSQLDialect{}
@@ -0,0 +1,78 @@
+package dialect
+
+import "testing"
+
+func TestDialect_Supports(t *testing.T) {
+	cases := []struct {
+		feature                        Feature
+		generic, postgres, mysql, orcl bool
+	}{
+		{feature: NestedBlockComments, postgres: true},
+		{feature: HashComments, mysql: true},
+		{feature: DoubleQuotedStrings, mysql: true},
+		{feature: UnicodeEscapes, postgres: true},
+		{feature: DollarQuotes, postgres: true},
+		{feature: RegexOperators, postgres: true},
+		{feature: DelimiterCommand, mysql: true},
+		{feature: PipesAsOr, mysql: true},
+		{feature: ArrayTypes, generic: true, postgres: true},
+		{feature: ReturningInto, postgres: true, orcl: true},
+		{feature: LimitComma, mysql: true},
+		{feature: IndexHints, mysql: true},
+		{feature: WildcardModifiers, generic: true},
+		{feature: OuterJoinMarker, orcl: true},
+	}
+
+	for _, c := range cases {
+		for _, d := range []struct {
+			dialect   Dialect
+			supported bool
+		}{
+			{dialect: &GenericSQLDialect{}, supported: c.generic},
+			{dialect: &PostgresqlDialect{}, supported: c.postgres},
+			{dialect: &MySQLDialect{}, supported: c.mysql},
+			{dialect: &OracleDialect{}, supported: c.orcl},
+		} {
+			if got := d.dialect.Supports(c.feature); got != d.supported {
+				t.Errorf("Supports(%d) of %T must be %t but %t", c.feature, d.dialect, d.supported, got)
+			}
+		}
+	}
+
+	t.Run("ANSI_QUOTES", func(t *testing.T) {
+		if (&MySQLDialect{ANSIQuotes: true}).Supports(DoubleQuotedStrings) {
+			t.Error("double-quoted values must be identifiers in ANSI_QUOTES mode")
+		}
+	})
+}
+
+func TestDialect_IsReservedKeyword(t *testing.T) {
+	cases := []struct {
+		word                           string
+		generic, postgres, mysql, orcl bool
+	}{
+		{word: "select", generic: true, postgres: true, mysql: true, orcl: true},
+		{word: "FROM", generic: true, postgres: true, mysql: true, orcl: true},
+		{word: "USER", postgres: true, orcl: true},
+		{word: "OFFSET", postgres: true},
+		{word: "LIMIT", postgres: true, mysql: true},
+		{word: "VALUE"},
+		{word: "NAME"},
+	}
+
+	for _, c := range cases {
+		for _, d := range []struct {
+			dialect  Dialect
+			reserved bool
+		}{
+			{dialect: &GenericSQLDialect{}, reserved: c.generic},
+			{dialect: &PostgresqlDialect{}, reserved: c.postgres},
+			{dialect: &MySQLDialect{}, reserved: c.mysql},
+			{dialect: &OracleDialect{}, reserved: c.orcl},
+		} {
+			if got := d.dialect.IsReservedKeyword(c.word); got != d.reserved {
+				t.Errorf("IsReservedKeyword(%s) of %T must be %t but %t", c.word, d.dialect, d.reserved, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package dialect
+
+// Feature is a syntax which is supported only by some dialects.
+// The tokenizer and the parser ask Dialect.Supports whether to accept it.
+type Feature int
+
+const (
+	// tokenizer
+
+	NestedBlockComments Feature = iota // /* /* ... */ */ nests (PostgreSQL)
+	HashComments                       // # starts a comment (MySQL)
+	DoubleQuotedStrings                // "abc" is a string literal (MySQL without ANSI_QUOTES)
+	UnicodeEscapes                     // U&'...' and U&"..." (PostgreSQL)
+	DollarQuotes                       // $$...$$ and $tag$...$tag$ are string literals (PostgreSQL)
+	RegexOperators                     // ~, ~*, !~ and !~* match regular expressions (PostgreSQL)
+	DelimiterCommand                   // DELIMITER command of the client (MySQL)
+
+	// parser
+
+	PipesAsOr            // || is logical OR (MySQL)
+	ArrayTypes           // array types such as int[] (PostgreSQL)
+	TableInheritance     // ONLY t and t * (PostgreSQL)
+	CopyStatement        // COPY (PostgreSQL)
+	ResetStatement       // RESET (PostgreSQL)
+	CommentStatement     // COMMENT ON (PostgreSQL)
+	DoStatement          // DO anonymous code blocks (PostgreSQL)
+	Cursors              // DECLARE, FETCH, MOVE and CLOSE of cursors (PostgreSQL)
+	PositionedUpdate     // WHERE CURRENT OF cursor of UPDATE and DELETE (PostgreSQL)
+	ReturningInto        // RETURNING ... INTO variables (PL/pgSQL and PL/SQL)
+	DataModifyingCTE     // INSERT, UPDATE and DELETE in WITH (PostgreSQL)
+	AttachPartition      // ALTER TABLE ... ATTACH PARTITION (PostgreSQL)
+	ViewOptions          // CREATE VIEW v WITH ( options ) (PostgreSQL)
+	OptionalInsertInto   // INSERT without INTO (MySQL)
+	ColumnCharset        // CHARACTER SET and CHARSET of columns (MySQL)
+	LimitComma           // LIMIT offset, count (MySQL)
+	IndexHints           // USE, IGNORE and FORCE INDEX of tables (MySQL)
+	GroupConcatSeparator // SEPARATOR in GROUP_CONCAT (MySQL)
+	WildcardModifiers    // * EXCLUDE ( ... ) REPLACE ( ... ) (DuckDB, BigQuery)
+	OuterJoinMarker      // (+) of outer joins (Oracle)
+)
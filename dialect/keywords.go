@@ -1,13 +1,27 @@
 package dialect
 
+import "strings"
+
 var Keywords map[string]struct{}
 var ReservedForTableAlias map[string]struct{}
 var ReservedForColumnAlias map[string]struct{}
 
+// ReservedKeywords are keywords which cannot be used as identifiers without quotes
+// in any dialect. GenericSQLDialect reserves only them, and the other dialects
+// reserve their own keywords. Other keywords such as NAME, VALUE and COUNT are
+// accepted as identifiers.
+var ReservedKeywords map[string]struct{}
+var PostgresqlReservedKeywords map[string]struct{}
+var MySQLReservedKeywords map[string]struct{}
+var OracleReservedKeywords map[string]struct{}
+
 func init() {
 	Keywords = make(map[string]struct{})
 	Keywords[ABS] = struct{}{}
+	Keywords[ABSOLUTE] = struct{}{}
+	Keywords[ACTION] = struct{}{}
 	Keywords[ADD] = struct{}{}
+	Keywords[ALWAYS] = struct{}{}
 	Keywords[ASC] = struct{}{}
 	Keywords[ALL] = struct{}{}
 	Keywords[ALLOCATE] = struct{}{}
@@ -23,11 +37,14 @@ func init() {
 	Keywords[ASYMMETRIC] = struct{}{}
 	Keywords[AT] = struct{}{}
 	Keywords[ATOMIC] = struct{}{}
+	Keywords[ATTACH] = struct{}{}
 	Keywords[AUTHORIZATION] = struct{}{}
 	Keywords[AVG] = struct{}{}
+	Keywords[BACKWARD] = struct{}{}
 	Keywords[BEGIN] = struct{}{}
 	Keywords[BEGIN_FRAME] = struct{}{}
 	Keywords[BEGIN_PARTITION] = struct{}{}
+	Keywords[BERNOULLI] = struct{}{}
 	Keywords[BETWEEN] = struct{}{}
 	Keywords[BIGINT] = struct{}{}
 	Keywords[BINARY] = struct{}{}
@@ -36,14 +53,17 @@ func init() {
 	Keywords[BOTH] = struct{}{}
 	Keywords[BY] = struct{}{}
 	Keywords[BYTEA] = struct{}{}
+	Keywords[CACHE] = struct{}{}
 	Keywords[CALL] = struct{}{}
 	Keywords[CALLED] = struct{}{}
 	Keywords[CARDINALITY] = struct{}{}
+	Keywords[CASCADE] = struct{}{}
 	Keywords[CASCADED] = struct{}{}
 	Keywords[CASE] = struct{}{}
 	Keywords[CAST] = struct{}{}
 	Keywords[CEIL] = struct{}{}
 	Keywords[CEILING] = struct{}{}
+	Keywords[CHAIN] = struct{}{}
 	Keywords[CHR] = struct{}{}
 	Keywords[CHAR] = struct{}{}
 	Keywords[CHAR_LENGTH] = struct{}{}
@@ -56,7 +76,9 @@ func init() {
 	Keywords[COLLATE] = struct{}{}
 	Keywords[COLLECT] = struct{}{}
 	Keywords[COLUMN] = struct{}{}
+	Keywords[COMMENT] = struct{}{}
 	Keywords[COMMIT] = struct{}{}
+	Keywords[COMMITTED] = struct{}{}
 	Keywords[CONDITION] = struct{}{}
 	Keywords[CONNECT] = struct{}{}
 	Keywords[CONSTRAINT] = struct{}{}
@@ -87,6 +109,7 @@ func init() {
 	Keywords[CURRENT_USER] = struct{}{}
 	Keywords[CURSOR] = struct{}{}
 	Keywords[CYCLE] = struct{}{}
+	Keywords[DATA] = struct{}{}
 	Keywords[DATE] = struct{}{}
 	Keywords[DAY] = struct{}{}
 	Keywords[DEALLOCATE] = struct{}{}
@@ -94,14 +117,17 @@ func init() {
 	Keywords[DECIMAL] = struct{}{}
 	Keywords[DECLARE] = struct{}{}
 	Keywords[DEFAULT] = struct{}{}
+	Keywords[DEFERRABLE] = struct{}{}
 	Keywords[DELETE] = struct{}{}
 	Keywords[DENSE_RANK] = struct{}{}
 	Keywords[DEREF] = struct{}{}
 	Keywords[DESC] = struct{}{}
 	Keywords[DESCRIBE] = struct{}{}
+	Keywords[DETACH] = struct{}{}
 	Keywords[DETERMINISTIC] = struct{}{}
 	Keywords[DISCONNECT] = struct{}{}
 	Keywords[DISTINCT] = struct{}{}
+	Keywords[DO] = struct{}{}
 	Keywords[DOUBLE] = struct{}{}
 	Keywords[DROP] = struct{}{}
 	Keywords[DYNAMIC] = struct{}{}
@@ -115,6 +141,7 @@ func init() {
 	Keywords[ESCAPE] = struct{}{}
 	Keywords[EVERY] = struct{}{}
 	Keywords[EXCEPT] = struct{}{}
+	Keywords[EXCLUDE] = struct{}{}
 	Keywords[EXEC] = struct{}{}
 	Keywords[EXECUTE] = struct{}{}
 	Keywords[EXISTS] = struct{}{}
@@ -124,18 +151,22 @@ func init() {
 	Keywords[FALSE] = struct{}{}
 	Keywords[FETCH] = struct{}{}
 	Keywords[FILTER] = struct{}{}
+	Keywords[FIRST] = struct{}{}
 	Keywords[FIRST_VALUE] = struct{}{}
 	Keywords[FLOAT] = struct{}{}
 	Keywords[FLOOR] = struct{}{}
 	Keywords[FOLLOWING] = struct{}{}
 	Keywords[FOR] = struct{}{}
 	Keywords[FOREIGN] = struct{}{}
+	Keywords[FORMAT] = struct{}{}
+	Keywords[FORWARD] = struct{}{}
 	Keywords[FRAME_ROW] = struct{}{}
 	Keywords[FREE] = struct{}{}
 	Keywords[FROM] = struct{}{}
 	Keywords[FULL] = struct{}{}
 	Keywords[FUNCTION] = struct{}{}
 	Keywords[FUSION] = struct{}{}
+	Keywords[GENERATED] = struct{}{}
 	Keywords[GET] = struct{}{}
 	Keywords[GLOBAL] = struct{}{}
 	Keywords[GRANT] = struct{}{}
@@ -148,6 +179,7 @@ func init() {
 	Keywords[HOUR] = struct{}{}
 	Keywords[IDENTITY] = struct{}{}
 	Keywords[IN] = struct{}{}
+	Keywords[INCREMENT] = struct{}{}
 	Keywords[INDICATOR] = struct{}{}
 	Keywords[INNER] = struct{}{}
 	Keywords[INOUT] = struct{}{}
@@ -160,16 +192,19 @@ func init() {
 	Keywords[INTERVAL] = struct{}{}
 	Keywords[INTO] = struct{}{}
 	Keywords[IS] = struct{}{}
+	Keywords[ISOLATION] = struct{}{}
 	Keywords[JOIN] = struct{}{}
 	Keywords[KEY] = struct{}{}
 	Keywords[LAG] = struct{}{}
 	Keywords[LANGUAGE] = struct{}{}
 	Keywords[LARGE] = struct{}{}
+	Keywords[LAST] = struct{}{}
 	Keywords[LAST_VALUE] = struct{}{}
 	Keywords[LATERAL] = struct{}{}
 	Keywords[LEAD] = struct{}{}
 	Keywords[LEADING] = struct{}{}
 	Keywords[LEFT] = struct{}{}
+	Keywords[LEVEL] = struct{}{}
 	Keywords[LIKE] = struct{}{}
 	Keywords[LIKE_REGEX] = struct{}{}
 	Keywords[LIMIT] = struct{}{}
@@ -178,29 +213,35 @@ func init() {
 	Keywords[LOCALTIME] = struct{}{}
 	Keywords[LOCALTIMESTAMP] = struct{}{}
 	Keywords[LOCATION] = struct{}{}
+	Keywords[LOCKED] = struct{}{}
 	Keywords[LOWER] = struct{}{}
 	Keywords[MATCH] = struct{}{}
 	Keywords[MATERIALIZED] = struct{}{}
 	Keywords[MAX] = struct{}{}
+	Keywords[MAXVALUE] = struct{}{}
 	Keywords[MEMBER] = struct{}{}
 	Keywords[MERGE] = struct{}{}
 	Keywords[METHOD] = struct{}{}
 	Keywords[MIN] = struct{}{}
 	Keywords[MINUTE] = struct{}{}
+	Keywords[MINVALUE] = struct{}{}
 	Keywords[MOD] = struct{}{}
 	Keywords[MODIFIES] = struct{}{}
 	Keywords[MODULE] = struct{}{}
 	Keywords[MONTH] = struct{}{}
+	Keywords[MOVE] = struct{}{}
 	Keywords[MULTISET] = struct{}{}
 	Keywords[NATIONAL] = struct{}{}
 	Keywords[NATURAL] = struct{}{}
 	Keywords[NCHAR] = struct{}{}
 	Keywords[NCLOB] = struct{}{}
 	Keywords[NEW] = struct{}{}
+	Keywords[NEXT] = struct{}{}
 	Keywords[NO] = struct{}{}
 	Keywords[NONE] = struct{}{}
 	Keywords[NORMALIZE] = struct{}{}
 	Keywords[NOT] = struct{}{}
+	Keywords[NOWAIT] = struct{}{}
 	Keywords[NTH_VALUE] = struct{}{}
 	Keywords[NTILE] = struct{}{}
 	Keywords[NULL] = struct{}{}
@@ -215,14 +256,18 @@ func init() {
 	Keywords[ON] = struct{}{}
 	Keywords[ONLY] = struct{}{}
 	Keywords[OPEN] = struct{}{}
+	Keywords[OPTION] = struct{}{}
 	Keywords[OR] = struct{}{}
 	Keywords[ORDER] = struct{}{}
+	Keywords[ORDINALITY] = struct{}{}
 	Keywords[OUT] = struct{}{}
 	Keywords[OUTER] = struct{}{}
 	Keywords[OVER] = struct{}{}
 	Keywords[OVERLAPS] = struct{}{}
 	Keywords[OVERLAY] = struct{}{}
+	Keywords[OWNED] = struct{}{}
 	Keywords[PARAMETER] = struct{}{}
+	Keywords[PARTIAL] = struct{}{}
 	Keywords[PARTITION] = struct{}{}
 	Keywords[PARQUET] = struct{}{}
 	Keywords[PERCENT] = struct{}{}
@@ -239,9 +284,11 @@ func init() {
 	Keywords[PRECISION] = struct{}{}
 	Keywords[PREPARE] = struct{}{}
 	Keywords[PRIMARY] = struct{}{}
+	Keywords[PRIOR] = struct{}{}
 	Keywords[PROCEDURE] = struct{}{}
 	Keywords[RANGE] = struct{}{}
 	Keywords[RANK] = struct{}{}
+	Keywords[READ] = struct{}{}
 	Keywords[READS] = struct{}{}
 	Keywords[REAL] = struct{}{}
 	Keywords[RECURSIVE] = struct{}{}
@@ -258,9 +305,15 @@ func init() {
 	Keywords[REGR_SXX] = struct{}{}
 	Keywords[REGR_SXY] = struct{}{}
 	Keywords[REGR_SYY] = struct{}{}
+	Keywords[RELATIVE] = struct{}{}
 	Keywords[RELEASE] = struct{}{}
+	Keywords[REPEATABLE] = struct{}{}
+	Keywords[REPLACE] = struct{}{}
+	Keywords[RESET] = struct{}{}
+	Keywords[RESTRICT] = struct{}{}
 	Keywords[RESULT] = struct{}{}
 	Keywords[RETURN] = struct{}{}
+	Keywords[RETURNING] = struct{}{}
 	Keywords[RETURNS] = struct{}{}
 	Keywords[REVOKE] = struct{}{}
 	Keywords[RIGHT] = struct{}{}
@@ -276,9 +329,16 @@ func init() {
 	Keywords[SECOND] = struct{}{}
 	Keywords[SELECT] = struct{}{}
 	Keywords[SENSITIVE] = struct{}{}
+	Keywords[SEPARATOR] = struct{}{}
+	Keywords[SEQUENCE] = struct{}{}
+	Keywords[SERIALIZABLE] = struct{}{}
 	Keywords[SESSION_USER] = struct{}{}
 	Keywords[SET] = struct{}{}
+	Keywords[SHARE] = struct{}{}
+	Keywords[SHOW] = struct{}{}
 	Keywords[SIMILAR] = struct{}{}
+	Keywords[SIMPLE] = struct{}{}
+	Keywords[SKIP] = struct{}{}
 	Keywords[SMALLINT] = struct{}{}
 	Keywords[SOME] = struct{}{}
 	Keywords[SPECIFIC] = struct{}{}
@@ -293,6 +353,7 @@ func init() {
 	Keywords[STDDEV_POP] = struct{}{}
 	Keywords[STDDEV_SAMP] = struct{}{}
 	Keywords[STDIN] = struct{}{}
+	Keywords[STDOUT] = struct{}{}
 	Keywords[STORED] = struct{}{}
 	Keywords[SUBMULTISET] = struct{}{}
 	Keywords[SUBSTRING] = struct{}{}
@@ -305,6 +366,8 @@ func init() {
 	Keywords[SYSTEM_USER] = struct{}{}
 	Keywords[TABLE] = struct{}{}
 	Keywords[TABLESAMPLE] = struct{}{}
+	Keywords[TEMP] = struct{}{}
+	Keywords[TEMPORARY] = struct{}{}
 	Keywords[TEXT] = struct{}{}
 	Keywords[THEN] = struct{}{}
 	Keywords[TIME] = struct{}{}
@@ -313,6 +376,7 @@ func init() {
 	Keywords[TIMEZONE_MINUTE] = struct{}{}
 	Keywords[TO] = struct{}{}
 	Keywords[TRAILING] = struct{}{}
+	Keywords[TRANSACTION] = struct{}{}
 	Keywords[TRANSLATE] = struct{}{}
 	Keywords[TRANSLATE_REGEX] = struct{}{}
 	Keywords[TRANSLATION] = struct{}{}
@@ -324,9 +388,11 @@ func init() {
 	Keywords[TRUE] = struct{}{}
 	Keywords[UESCAPE] = struct{}{}
 	Keywords[UNBOUNDED] = struct{}{}
+	Keywords[UNCOMMITTED] = struct{}{}
 	Keywords[UNION] = struct{}{}
 	Keywords[UNIQUE] = struct{}{}
 	Keywords[UNKNOWN] = struct{}{}
+	Keywords[UNLOGGED] = struct{}{}
 	Keywords[UNNEST] = struct{}{}
 	Keywords[UPDATE] = struct{}{}
 	Keywords[UPPER] = struct{}{}
@@ -343,6 +409,7 @@ func init() {
 	Keywords[VARYING] = struct{}{}
 	Keywords[VERSIONING] = struct{}{}
 	Keywords[VIEW] = struct{}{}
+	Keywords[VIRTUAL] = struct{}{}
 	Keywords[WHEN] = struct{}{}
 	Keywords[WHENEVER] = struct{}{}
 	Keywords[WHERE] = struct{}{}
@@ -351,6 +418,8 @@ func init() {
 	Keywords[WITH] = struct{}{}
 	Keywords[WITHIN] = struct{}{}
 	Keywords[WITHOUT] = struct{}{}
+	Keywords[WORK] = struct{}{}
+	Keywords[WRITE] = struct{}{}
 	Keywords[YEAR] = struct{}{}
 	Keywords[ZONE] = struct{}{}
 
@@ -372,6 +441,12 @@ func init() {
 	ReservedForTableAlias[RIGHT] = struct{}{}
 	ReservedForTableAlias[NATURAL] = struct{}{}
 	ReservedForTableAlias[USING] = struct{}{}
+	ReservedForTableAlias[FOR] = struct{}{}
+	ReservedForTableAlias[LIMIT] = struct{}{}
+	ReservedForTableAlias[OFFSET] = struct{}{}
+	ReservedForTableAlias[FETCH] = struct{}{}
+	ReservedForTableAlias[TABLESAMPLE] = struct{}{}
+	ReservedForTableAlias[RETURNING] = struct{}{}
 
 	ReservedForColumnAlias = make(map[string]struct{})
 	ReservedForColumnAlias[WITH] = struct{}{}
@@ -383,11 +458,219 @@ func init() {
 	ReservedForColumnAlias[EXCEPT] = struct{}{}
 	ReservedForColumnAlias[INTERSECT] = struct{}{}
 	ReservedForColumnAlias[FROM] = struct{}{}
+	ReservedForColumnAlias[FOR] = struct{}{}
+	ReservedForColumnAlias[LIMIT] = struct{}{}
+	ReservedForColumnAlias[OFFSET] = struct{}{}
+	ReservedForColumnAlias[FETCH] = struct{}{}
+	ReservedForColumnAlias[RETURNING] = struct{}{}
+	ReservedForColumnAlias[INTO] = struct{}{}
+
+	ReservedKeywords = make(map[string]struct{})
+	ReservedKeywords[ALL] = struct{}{}
+	ReservedKeywords[AND] = struct{}{}
+	ReservedKeywords[AS] = struct{}{}
+	ReservedKeywords[ASC] = struct{}{}
+	ReservedKeywords[CHECK] = struct{}{}
+	ReservedKeywords[COLUMN] = struct{}{}
+	ReservedKeywords[CREATE] = struct{}{}
+	ReservedKeywords[DEFAULT] = struct{}{}
+	ReservedKeywords[DESC] = struct{}{}
+	ReservedKeywords[DISTINCT] = struct{}{}
+	ReservedKeywords[ELSE] = struct{}{}
+	ReservedKeywords[FOR] = struct{}{}
+	ReservedKeywords[FROM] = struct{}{}
+	ReservedKeywords[GRANT] = struct{}{}
+	ReservedKeywords[GROUP] = struct{}{}
+	ReservedKeywords[HAVING] = struct{}{}
+	ReservedKeywords[IN] = struct{}{}
+	ReservedKeywords[INTERSECT] = struct{}{}
+	ReservedKeywords[INTO] = struct{}{}
+	ReservedKeywords[NOT] = struct{}{}
+	ReservedKeywords[NULL] = struct{}{}
+	ReservedKeywords[ON] = struct{}{}
+	ReservedKeywords[OR] = struct{}{}
+	ReservedKeywords[ORDER] = struct{}{}
+	ReservedKeywords[SELECT] = struct{}{}
+	ReservedKeywords[TABLE] = struct{}{}
+	ReservedKeywords[THEN] = struct{}{}
+	ReservedKeywords[TO] = struct{}{}
+	ReservedKeywords[UNION] = struct{}{}
+	ReservedKeywords[UNIQUE] = struct{}{}
+	ReservedKeywords[WHERE] = struct{}{}
+	ReservedKeywords[WITH] = struct{}{}
+
+	PostgresqlReservedKeywords = make(map[string]struct{})
+	PostgresqlReservedKeywords[ALL] = struct{}{}
+	PostgresqlReservedKeywords[AND] = struct{}{}
+	PostgresqlReservedKeywords[ANY] = struct{}{}
+	PostgresqlReservedKeywords[ARRAY] = struct{}{}
+	PostgresqlReservedKeywords[AS] = struct{}{}
+	PostgresqlReservedKeywords[ASC] = struct{}{}
+	PostgresqlReservedKeywords[ASYMMETRIC] = struct{}{}
+	PostgresqlReservedKeywords[BOTH] = struct{}{}
+	PostgresqlReservedKeywords[CASE] = struct{}{}
+	PostgresqlReservedKeywords[CAST] = struct{}{}
+	PostgresqlReservedKeywords[CHECK] = struct{}{}
+	PostgresqlReservedKeywords[COLLATE] = struct{}{}
+	PostgresqlReservedKeywords[COLUMN] = struct{}{}
+	PostgresqlReservedKeywords[CONSTRAINT] = struct{}{}
+	PostgresqlReservedKeywords[CREATE] = struct{}{}
+	PostgresqlReservedKeywords[CURRENT_DATE] = struct{}{}
+	PostgresqlReservedKeywords[CURRENT_TIME] = struct{}{}
+	PostgresqlReservedKeywords[CURRENT_TIMESTAMP] = struct{}{}
+	PostgresqlReservedKeywords[CURRENT_USER] = struct{}{}
+	PostgresqlReservedKeywords[DEFAULT] = struct{}{}
+	PostgresqlReservedKeywords[DESC] = struct{}{}
+	PostgresqlReservedKeywords[DISTINCT] = struct{}{}
+	PostgresqlReservedKeywords[ELSE] = struct{}{}
+	PostgresqlReservedKeywords[END] = struct{}{}
+	PostgresqlReservedKeywords[EXCEPT] = struct{}{}
+	PostgresqlReservedKeywords[FALSE] = struct{}{}
+	PostgresqlReservedKeywords[FETCH] = struct{}{}
+	PostgresqlReservedKeywords[FOR] = struct{}{}
+	PostgresqlReservedKeywords[FOREIGN] = struct{}{}
+	PostgresqlReservedKeywords[FROM] = struct{}{}
+	PostgresqlReservedKeywords[GRANT] = struct{}{}
+	PostgresqlReservedKeywords[GROUP] = struct{}{}
+	PostgresqlReservedKeywords[HAVING] = struct{}{}
+	PostgresqlReservedKeywords[IN] = struct{}{}
+	PostgresqlReservedKeywords[INTERSECT] = struct{}{}
+	PostgresqlReservedKeywords[INTO] = struct{}{}
+	PostgresqlReservedKeywords[LATERAL] = struct{}{}
+	PostgresqlReservedKeywords[LEADING] = struct{}{}
+	PostgresqlReservedKeywords[LIMIT] = struct{}{}
+	PostgresqlReservedKeywords[NOT] = struct{}{}
+	PostgresqlReservedKeywords[NULL] = struct{}{}
+	PostgresqlReservedKeywords[OFFSET] = struct{}{}
+	PostgresqlReservedKeywords[ON] = struct{}{}
+	PostgresqlReservedKeywords[ONLY] = struct{}{}
+	PostgresqlReservedKeywords[OR] = struct{}{}
+	PostgresqlReservedKeywords[ORDER] = struct{}{}
+	PostgresqlReservedKeywords[PRIMARY] = struct{}{}
+	PostgresqlReservedKeywords[REFERENCES] = struct{}{}
+	PostgresqlReservedKeywords[RETURNING] = struct{}{}
+	PostgresqlReservedKeywords[SELECT] = struct{}{}
+	PostgresqlReservedKeywords[SESSION_USER] = struct{}{}
+	PostgresqlReservedKeywords[SOME] = struct{}{}
+	PostgresqlReservedKeywords[SYMMETRIC] = struct{}{}
+	PostgresqlReservedKeywords[TABLE] = struct{}{}
+	PostgresqlReservedKeywords[THEN] = struct{}{}
+	PostgresqlReservedKeywords[TO] = struct{}{}
+	PostgresqlReservedKeywords[TRAILING] = struct{}{}
+	PostgresqlReservedKeywords[TRUE] = struct{}{}
+	PostgresqlReservedKeywords[UNION] = struct{}{}
+	PostgresqlReservedKeywords[UNIQUE] = struct{}{}
+	PostgresqlReservedKeywords[USER] = struct{}{}
+	PostgresqlReservedKeywords[USING] = struct{}{}
+	PostgresqlReservedKeywords[WHEN] = struct{}{}
+	PostgresqlReservedKeywords[WHERE] = struct{}{}
+	PostgresqlReservedKeywords[WINDOW] = struct{}{}
+	PostgresqlReservedKeywords[WITH] = struct{}{}
+
+	MySQLReservedKeywords = make(map[string]struct{})
+	MySQLReservedKeywords[ALL] = struct{}{}
+	MySQLReservedKeywords[AND] = struct{}{}
+	MySQLReservedKeywords[AS] = struct{}{}
+	MySQLReservedKeywords[ASC] = struct{}{}
+	MySQLReservedKeywords[BOTH] = struct{}{}
+	MySQLReservedKeywords[CASE] = struct{}{}
+	MySQLReservedKeywords[CHECK] = struct{}{}
+	MySQLReservedKeywords[COLLATE] = struct{}{}
+	MySQLReservedKeywords[COLUMN] = struct{}{}
+	MySQLReservedKeywords[CONSTRAINT] = struct{}{}
+	MySQLReservedKeywords[CREATE] = struct{}{}
+	MySQLReservedKeywords[CURRENT_DATE] = struct{}{}
+	MySQLReservedKeywords[CURRENT_TIME] = struct{}{}
+	MySQLReservedKeywords[CURRENT_TIMESTAMP] = struct{}{}
+	MySQLReservedKeywords[CURRENT_USER] = struct{}{}
+	MySQLReservedKeywords[DEFAULT] = struct{}{}
+	MySQLReservedKeywords[DESC] = struct{}{}
+	MySQLReservedKeywords[DISTINCT] = struct{}{}
+	MySQLReservedKeywords[ELSE] = struct{}{}
+	MySQLReservedKeywords[EXCEPT] = struct{}{}
+	MySQLReservedKeywords[FALSE] = struct{}{}
+	MySQLReservedKeywords[FETCH] = struct{}{}
+	MySQLReservedKeywords[FOR] = struct{}{}
+	MySQLReservedKeywords[FOREIGN] = struct{}{}
+	MySQLReservedKeywords[FROM] = struct{}{}
+	MySQLReservedKeywords[GRANT] = struct{}{}
+	MySQLReservedKeywords[GROUP] = struct{}{}
+	MySQLReservedKeywords[HAVING] = struct{}{}
+	MySQLReservedKeywords[IN] = struct{}{}
+	MySQLReservedKeywords[INTERSECT] = struct{}{}
+	MySQLReservedKeywords[INTO] = struct{}{}
+	MySQLReservedKeywords[LATERAL] = struct{}{}
+	MySQLReservedKeywords[LEADING] = struct{}{}
+	MySQLReservedKeywords[LIMIT] = struct{}{}
+	MySQLReservedKeywords[NOT] = struct{}{}
+	MySQLReservedKeywords[NULL] = struct{}{}
+	MySQLReservedKeywords[ON] = struct{}{}
+	MySQLReservedKeywords[OR] = struct{}{}
+	MySQLReservedKeywords[ORDER] = struct{}{}
+	MySQLReservedKeywords[PRIMARY] = struct{}{}
+	MySQLReservedKeywords[REFERENCES] = struct{}{}
+	MySQLReservedKeywords[SELECT] = struct{}{}
+	MySQLReservedKeywords[TABLE] = struct{}{}
+	MySQLReservedKeywords[THEN] = struct{}{}
+	MySQLReservedKeywords[TO] = struct{}{}
+	MySQLReservedKeywords[TRAILING] = struct{}{}
+	MySQLReservedKeywords[TRUE] = struct{}{}
+	MySQLReservedKeywords[UNION] = struct{}{}
+	MySQLReservedKeywords[UNIQUE] = struct{}{}
+	MySQLReservedKeywords[USING] = struct{}{}
+	MySQLReservedKeywords[WHEN] = struct{}{}
+	MySQLReservedKeywords[WHERE] = struct{}{}
+	MySQLReservedKeywords[WINDOW] = struct{}{}
+	MySQLReservedKeywords[WITH] = struct{}{}
+
+	OracleReservedKeywords = make(map[string]struct{})
+	OracleReservedKeywords[ALL] = struct{}{}
+	OracleReservedKeywords[AND] = struct{}{}
+	OracleReservedKeywords[ANY] = struct{}{}
+	OracleReservedKeywords[AS] = struct{}{}
+	OracleReservedKeywords[ASC] = struct{}{}
+	OracleReservedKeywords[CHECK] = struct{}{}
+	OracleReservedKeywords[COLUMN] = struct{}{}
+	OracleReservedKeywords[CREATE] = struct{}{}
+	OracleReservedKeywords[DEFAULT] = struct{}{}
+	OracleReservedKeywords[DESC] = struct{}{}
+	OracleReservedKeywords[DISTINCT] = struct{}{}
+	OracleReservedKeywords[ELSE] = struct{}{}
+	OracleReservedKeywords[FOR] = struct{}{}
+	OracleReservedKeywords[FROM] = struct{}{}
+	OracleReservedKeywords[GRANT] = struct{}{}
+	OracleReservedKeywords[GROUP] = struct{}{}
+	OracleReservedKeywords[HAVING] = struct{}{}
+	OracleReservedKeywords[IN] = struct{}{}
+	OracleReservedKeywords[INTERSECT] = struct{}{}
+	OracleReservedKeywords[INTO] = struct{}{}
+	OracleReservedKeywords[NOT] = struct{}{}
+	OracleReservedKeywords[NULL] = struct{}{}
+	OracleReservedKeywords[ON] = struct{}{}
+	OracleReservedKeywords[OR] = struct{}{}
+	OracleReservedKeywords[ORDER] = struct{}{}
+	OracleReservedKeywords[SELECT] = struct{}{}
+	OracleReservedKeywords[TABLE] = struct{}{}
+	OracleReservedKeywords[THEN] = struct{}{}
+	OracleReservedKeywords[TO] = struct{}{}
+	OracleReservedKeywords[UNION] = struct{}{}
+	OracleReservedKeywords[UNIQUE] = struct{}{}
+	OracleReservedKeywords[USER] = struct{}{}
+	OracleReservedKeywords[WHERE] = struct{}{}
+	OracleReservedKeywords[WITH] = struct{}{}
+}
+
+func isReservedKeyword(reserved map[string]struct{}, word string) bool {
+	_, ok := reserved[strings.ToUpper(word)]
+	return ok
 }
 
 const (
 	ABS                              string = "ABS"
+	ABSOLUTE                                = "ABSOLUTE"
+	ACTION                                  = "ACTION"
 	ADD                                     = "ADD"
+	ALWAYS                                  = "ALWAYS"
 	ASC                                     = "ASC"
 	ALL                                     = "ALL"
 	ALLOCATE                                = "ALLOCATE"
@@ -403,11 +686,14 @@ const (
 	ASYMMETRIC                              = "ASYMMETRIC"
 	AT                                      = "AT"
 	ATOMIC                                  = "ATOMIC"
+	ATTACH                                  = "ATTACH"
 	AUTHORIZATION                           = "AUTHORIZATION"
 	AVG                                     = "AVG"
+	BACKWARD                                = "BACKWARD"
 	BEGIN                                   = "BEGIN"
 	BEGIN_FRAME                             = "BEGIN_FRAME"
 	BEGIN_PARTITION                         = "BEGIN_PARTITION"
+	BERNOULLI                               = "BERNOULLI"
 	BETWEEN                                 = "BETWEEN"
 	BIGINT                                  = "BIGINT"
 	BINARY                                  = "BINARY"
@@ -416,14 +702,17 @@ const (
 	BOTH                                    = "BOTH"
 	BY                                      = "BY"
 	BYTEA                                   = "BYTEA"
+	CACHE                                   = "CACHE"
 	CALL                                    = "CALL"
 	CALLED                                  = "CALLED"
 	CARDINALITY                             = "CARDINALITY"
+	CASCADE                                 = "CASCADE"
 	CASCADED                                = "CASCADED"
 	CASE                                    = "CASE"
 	CAST                                    = "CAST"
 	CEIL                                    = "CEIL"
 	CEILING                                 = "CEILING"
+	CHAIN                                   = "CHAIN"
 	CHR                                     = "CHR"
 	CHAR                                    = "CHAR"
 	CHAR_LENGTH                             = "CHAR_LENGTH"
@@ -436,7 +725,9 @@ const (
 	COLLATE                                 = "COLLATE"
 	COLLECT                                 = "COLLECT"
 	COLUMN                                  = "COLUMN"
+	COMMENT                                 = "COMMENT"
 	COMMIT                                  = "COMMIT"
+	COMMITTED                               = "COMMITTED"
 	CONDITION                               = "CONDITION"
 	CONNECT                                 = "CONNECT"
 	CONSTRAINT                              = "CONSTRAINT"
@@ -467,6 +758,7 @@ const (
 	CURRENT_USER                            = "CURRENT_USER"
 	CURSOR                                  = "CURSOR"
 	CYCLE                                   = "CYCLE"
+	DATA                                    = "DATA"
 	DATE                                    = "DATE"
 	DAY                                     = "DAY"
 	DEALLOCATE                              = "DEALLOCATE"
@@ -474,14 +766,17 @@ const (
 	DECIMAL                                 = "DECIMAL"
 	DECLARE                                 = "DECLARE"
 	DEFAULT                                 = "DEFAULT"
+	DEFERRABLE                              = "DEFERRABLE"
 	DELETE                                  = "DELETE"
 	DENSE_RANK                              = "DENSE_RANK"
 	DEREF                                   = "DEREF"
 	DESC                                    = "DESC"
 	DESCRIBE                                = "DESCRIBE"
+	DETACH                                  = "DETACH"
 	DETERMINISTIC                           = "DETERMINISTIC"
 	DISCONNECT                              = "DISCONNECT"
 	DISTINCT                                = "DISTINCT"
+	DO                                      = "DO"
 	DOUBLE                                  = "DOUBLE"
 	DROP                                    = "DROP"
 	DYNAMIC                                 = "DYNAMIC"
@@ -495,6 +790,7 @@ const (
 	ESCAPE                                  = "ESCAPE"
 	EVERY                                   = "EVERY"
 	EXCEPT                                  = "EXCEPT"
+	EXCLUDE                                 = "EXCLUDE"
 	EXEC                                    = "EXEC"
 	EXECUTE                                 = "EXECUTE"
 	EXISTS                                  = "EXISTS"
@@ -504,18 +800,22 @@ const (
 	FALSE                                   = "FALSE"
 	FETCH                                   = "FETCH"
 	FILTER                                  = "FILTER"
+	FIRST                                   = "FIRST"
 	FIRST_VALUE                             = "FIRST_VALUE"
 	FLOAT                                   = "FLOAT"
 	FLOOR                                   = "FLOOR"
 	FOLLOWING                               = "FOLLOWING"
 	FOR                                     = "FOR"
 	FOREIGN                                 = "FOREIGN"
+	FORMAT                                  = "FORMAT"
+	FORWARD                                 = "FORWARD"
 	FRAME_ROW                               = "FRAME_ROW"
 	FREE                                    = "FREE"
 	FROM                                    = "FROM"
 	FULL                                    = "FULL"
 	FUNCTION                                = "FUNCTION"
 	FUSION                                  = "FUSION"
+	GENERATED                               = "GENERATED"
 	GET                                     = "GET"
 	GLOBAL                                  = "GLOBAL"
 	GRANT                                   = "GRANT"
@@ -528,6 +828,7 @@ const (
 	HOUR                                    = "HOUR"
 	IDENTITY                                = "IDENTITY"
 	IN                                      = "IN"
+	INCREMENT                               = "INCREMENT"
 	INDICATOR                               = "INDICATOR"
 	INNER                                   = "INNER"
 	INOUT                                   = "INOUT"
@@ -540,16 +841,19 @@ const (
 	INTERVAL                                = "INTERVAL"
 	INTO                                    = "INTO"
 	IS                                      = "IS"
+	ISOLATION                               = "ISOLATION"
 	JOIN                                    = "JOIN"
 	KEY                                     = "KEY"
 	LAG                                     = "LAG"
 	LANGUAGE                                = "LANGUAGE"
 	LARGE                                   = "LARGE"
+	LAST                                    = "LAST"
 	LAST_VALUE                              = "LAST_VALUE"
 	LATERAL                                 = "LATERAL"
 	LEAD                                    = "LEAD"
 	LEADING                                 = "LEADING"
 	LEFT                                    = "LEFT"
+	LEVEL                                   = "LEVEL"
 	LIKE                                    = "LIKE"
 	LIKE_REGEX                              = "LIKE_REGEX"
 	LIMIT                                   = "LIMIT"
@@ -558,29 +862,35 @@ const (
 	LOCALTIME                               = "LOCALTIME"
 	LOCALTIMESTAMP                          = "LOCALTIMESTAMP"
 	LOCATION                                = "LOCATION"
+	LOCKED                                  = "LOCKED"
 	LOWER                                   = "LOWER"
 	MATCH                                   = "MATCH"
 	MATERIALIZED                            = "MATERIALIZED"
 	MAX                                     = "MAX"
+	MAXVALUE                                = "MAXVALUE"
 	MEMBER                                  = "MEMBER"
 	MERGE                                   = "MERGE"
 	METHOD                                  = "METHOD"
 	MIN                                     = "MIN"
 	MINUTE                                  = "MINUTE"
+	MINVALUE                                = "MINVALUE"
 	MOD                                     = "MOD"
 	MODIFIES                                = "MODIFIES"
 	MODULE                                  = "MODULE"
 	MONTH                                   = "MONTH"
+	MOVE                                    = "MOVE"
 	MULTISET                                = "MULTISET"
 	NATIONAL                                = "NATIONAL"
 	NATURAL                                 = "NATURAL"
 	NCHAR                                   = "NCHAR"
 	NCLOB                                   = "NCLOB"
 	NEW                                     = "NEW"
+	NEXT                                    = "NEXT"
 	NO                                      = "NO"
 	NONE                                    = "NONE"
 	NORMALIZE                               = "NORMALIZE"
 	NOT                                     = "NOT"
+	NOWAIT                                  = "NOWAIT"
 	NTH_VALUE                               = "NTH_VALUE"
 	NTILE                                   = "NTILE"
 	NULL                                    = "NULL"
@@ -595,14 +905,18 @@ const (
 	ON                                      = "ON"
 	ONLY                                    = "ONLY"
 	OPEN                                    = "OPEN"
+	OPTION                                  = "OPTION"
 	OR                                      = "OR"
 	ORDER                                   = "ORDER"
+	ORDINALITY                              = "ORDINALITY"
 	OUT                                     = "OUT"
 	OUTER                                   = "OUTER"
 	OVER                                    = "OVER"
 	OVERLAPS                                = "OVERLAPS"
 	OVERLAY                                 = "OVERLAY"
+	OWNED                                   = "OWNED"
 	PARAMETER                               = "PARAMETER"
+	PARTIAL                                 = "PARTIAL"
 	PARTITION                               = "PARTITION"
 	PARQUET                                 = "PARQUET"
 	PERCENT                                 = "PERCENT"
@@ -619,9 +933,11 @@ const (
 	PRECISION                               = "PRECISION"
 	PREPARE                                 = "PREPARE"
 	PRIMARY                                 = "PRIMARY"
+	PRIOR                                   = "PRIOR"
 	PROCEDURE                               = "PROCEDURE"
 	RANGE                                   = "RANGE"
 	RANK                                    = "RANK"
+	READ                                    = "READ"
 	READS                                   = "READS"
 	REAL                                    = "REAL"
 	RECURSIVE                               = "RECURSIVE"
@@ -638,9 +954,15 @@ const (
 	REGR_SXX                                = "REGR_SXX"
 	REGR_SXY                                = "REGR_SXY"
 	REGR_SYY                                = "REGR_SYY"
+	RELATIVE                                = "RELATIVE"
 	RELEASE                                 = "RELEASE"
+	REPEATABLE                              = "REPEATABLE"
+	REPLACE                                 = "REPLACE"
+	RESET                                   = "RESET"
+	RESTRICT                                = "RESTRICT"
 	RESULT                                  = "RESULT"
 	RETURN                                  = "RETURN"
+	RETURNING                               = "RETURNING"
 	RETURNS                                 = "RETURNS"
 	REVOKE                                  = "REVOKE"
 	RIGHT                                   = "RIGHT"
@@ -656,9 +978,16 @@ const (
 	SECOND                                  = "SECOND"
 	SELECT                                  = "SELECT"
 	SENSITIVE                               = "SENSITIVE"
+	SEPARATOR                               = "SEPARATOR"
+	SEQUENCE                                = "SEQUENCE"
+	SERIALIZABLE                            = "SERIALIZABLE"
 	SESSION_USER                            = "SESSION_USER"
 	SET                                     = "SET"
+	SHARE                                   = "SHARE"
+	SHOW                                    = "SHOW"
 	SIMILAR                                 = "SIMILAR"
+	SIMPLE                                  = "SIMPLE"
+	SKIP                                    = "SKIP"
 	SMALLINT                                = "SMALLINT"
 	SOME                                    = "SOME"
 	SPECIFIC                                = "SPECIFIC"
@@ -673,6 +1002,7 @@ const (
 	STDDEV_POP                              = "STDDEV_POP"
 	STDDEV_SAMP                             = "STDDEV_SAMP"
 	STDIN                                   = "STDIN"
+	STDOUT                                  = "STDOUT"
 	STORED                                  = "STORED"
 	SUBMULTISET                             = "SUBMULTISET"
 	SUBSTRING                               = "SUBSTRING"
@@ -685,6 +1015,8 @@ const (
 	SYSTEM_USER                             = "SYSTEM_USER"
 	TABLE                                   = "TABLE"
 	TABLESAMPLE                             = "TABLESAMPLE"
+	TEMP                                    = "TEMP"
+	TEMPORARY                               = "TEMPORARY"
 	TEXT                                    = "TEXT"
 	THEN                                    = "THEN"
 	TIME                                    = "TIME"
@@ -693,6 +1025,7 @@ const (
 	TIMEZONE_MINUTE                         = "TIMEZONE_MINUTE"
 	TO                                      = "TO"
 	TRAILING                                = "TRAILING"
+	TRANSACTION                             = "TRANSACTION"
 	TRANSLATE                               = "TRANSLATE"
 	TRANSLATE_REGEX                         = "TRANSLATE_REGEX"
 	TRANSLATION                             = "TRANSLATION"
@@ -704,9 +1037,11 @@ const (
 	TRUE                                    = "TRUE"
 	UESCAPE                                 = "UESCAPE"
 	UNBOUNDED                               = "UNBOUNDED"
+	UNCOMMITTED                             = "UNCOMMITTED"
 	UNION                                   = "UNION"
 	UNIQUE                                  = "UNIQUE"
 	UNKNOWN                                 = "UNKNOWN"
+	UNLOGGED                                = "UNLOGGED"
 	UNNEST                                  = "UNNEST"
 	UPDATE                                  = "UPDATE"
 	UPPER                                   = "UPPER"
@@ -723,6 +1058,7 @@ const (
 	VARYING                                 = "VARYING"
 	VERSIONING                              = "VERSIONING"
 	VIEW                                    = "VIEW"
+	VIRTUAL                                 = "VIRTUAL"
 	WHEN                                    = "WHEN"
 	WHENEVER                                = "WHENEVER"
 	WHERE                                   = "WHERE"
@@ -731,6 +1067,8 @@ const (
 	WITH                                    = "WITH"
 	WITHIN                                  = "WITHIN"
 	WITHOUT                                 = "WITHOUT"
+	WORK                                    = "WORK"
+	WRITE                                   = "WRITE"
 	YEAR                                    = "YEAR"
 	ZONE                                    = "ZONE"
 )
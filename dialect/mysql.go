@@ -0,0 +1,46 @@
+package dialect
+
+type MySQLDialect struct {
+	// ANSIQuotes makes `"` quote identifiers as ANSI_QUOTES mode of MySQL does.
+	// Double-quoted values are string literals by default.
+	ANSIQuotes bool
+}
+
+// DoubleQuoteIsString reports whether `"abc"` is a string literal rather than
+// a quoted identifier.
+func (d *MySQLDialect) DoubleQuoteIsString() bool {
+	return !d.ANSIQuotes
+}
+
+func (*MySQLDialect) IsIdentifierStart(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r == '_' || r == '@'
+}
+
+func (*MySQLDialect) IsIdentifierPart(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '$' || r == '_' || r == '@'
+}
+
+func (d *MySQLDialect) IsDelimitedIdentifierStart(r rune) bool {
+	return r == '`' || (d.ANSIQuotes && r == '"')
+}
+
+func (*MySQLDialect) NormalizeIdent(raw string, quoted bool) string {
+	return raw
+}
+
+func (*MySQLDialect) IsReservedKeyword(word string) bool {
+	return isReservedKeyword(MySQLReservedKeywords, word)
+}
+
+func (d *MySQLDialect) Supports(f Feature) bool {
+	switch f {
+	case DoubleQuotedStrings:
+		return d.DoubleQuoteIsString()
+	case HashComments, DelimiterCommand, PipesAsOr, OptionalInsertInto,
+		ColumnCharset, LimitComma, IndexHints, GroupConcatSeparator:
+		return true
+	}
+	return false
+}
+
+var _ Dialect = &MySQLDialect{}
@@ -0,0 +1,40 @@
+package dialect
+
+import "strings"
+
+type OracleDialect struct {
+}
+
+func (*OracleDialect) IsIdentifierStart(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func (*OracleDialect) IsIdentifierPart(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '$' || r == '#' || r == '_'
+}
+
+func (*OracleDialect) IsDelimitedIdentifierStart(r rune) bool {
+	return r == '"'
+}
+
+// unquoted identifiers are folded to upper case, quoted identifiers are kept as is.
+func (*OracleDialect) NormalizeIdent(raw string, quoted bool) string {
+	if quoted {
+		return raw
+	}
+	return strings.ToUpper(raw)
+}
+
+func (*OracleDialect) IsReservedKeyword(word string) bool {
+	return isReservedKeyword(OracleReservedKeywords, word)
+}
+
+func (*OracleDialect) Supports(f Feature) bool {
+	switch f {
+	case OuterJoinMarker, ReturningInto:
+		return true
+	}
+	return false
+}
+
+var _ Dialect = &OracleDialect{}
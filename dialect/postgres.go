@@ -1,5 +1,7 @@
 package dialect
 
+import "strings"
+
 type PostgresqlDialect struct {
 }
 
@@ -15,4 +17,26 @@ func (*PostgresqlDialect) IsDelimitedIdentifierStart(r rune) bool {
 	return r == '"' || r == '`'
 }
 
+// unquoted identifiers are folded to lower case, quoted identifiers are kept as is.
+func (*PostgresqlDialect) NormalizeIdent(raw string, quoted bool) string {
+	if quoted {
+		return raw
+	}
+	return strings.ToLower(raw)
+}
+
+func (*PostgresqlDialect) IsReservedKeyword(word string) bool {
+	return isReservedKeyword(PostgresqlReservedKeywords, word)
+}
+
+func (*PostgresqlDialect) Supports(f Feature) bool {
+	switch f {
+	case NestedBlockComments, UnicodeEscapes, DollarQuotes, RegexOperators,
+		ArrayTypes, TableInheritance, CopyStatement, ResetStatement, CommentStatement, DoStatement,
+		Cursors, PositionedUpdate, ReturningInto, DataModifyingCTE, AttachPartition, ViewOptions:
+		return true
+	}
+	return false
+}
+
 var _ Dialect = &PostgresqlDialect{}
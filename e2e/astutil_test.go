@@ -156,3 +156,487 @@ func TestApply(t *testing.T) {
 		})
 	}
 }
+
+func TestFunctionCalls(t *testing.T) {
+	cases := []struct {
+		name     string
+		in       string
+		expected []string
+	}{
+		{
+			name: "nested calls",
+			in: `WITH recent AS (SELECT user_id, max(created_at) AS last FROM logins GROUP BY user_id)
+SELECT upper(trim(u.name)), pg_catalog.pg_read_file('/etc/passwd')
+FROM users u
+WHERE u.id IN (SELECT user_id FROM recent WHERE last > now() - interval_of(coalesce(u.days, 7)))`,
+			expected: []string{"max", "upper", "pg_catalog.pg_read_file", "now", "interval_of", "coalesce"},
+		},
+		{
+			name:     "searched case",
+			in:       "SELECT CASE WHEN f(a) > 0 THEN 1 ELSE abs(a) END FROM t",
+			expected: []string{"f", "abs"},
+		},
+		{
+			name:     "case branches",
+			in:       "SELECT CASE lower(a) WHEN f(b) THEN g(c) ELSE h(d) END FROM t",
+			expected: []string{"lower", "f", "g", "h"},
+		},
+		{
+			name:     "update without where",
+			in:       "UPDATE t SET a = upper(b)",
+			expected: []string{"upper"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := xsqlparser.NewParser(strings.NewReader(c.in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			var names []string
+			for _, f := range sqlast.FunctionCalls(stmt) {
+				names = append(names, f.Name.ToSQLString())
+			}
+			if strings.Join(names, ",") != strings.Join(c.expected, ",") {
+				t.Errorf("expected %v but %v", c.expected, names)
+			}
+		})
+	}
+}
+
+func TestIsReadOnly(t *testing.T) {
+	cases := []struct {
+		in       string
+		readOnly bool
+	}{
+		{in: "SELECT a FROM t WHERE b IN (SELECT b FROM u)", readOnly: true},
+		{in: "WITH x AS (SELECT a FROM t) SELECT * FROM x", readOnly: true},
+		{in: "TABLE t", readOnly: true},
+		{in: "EXPLAIN SELECT 1", readOnly: true},
+		{in: "COPY t TO STDOUT", readOnly: true},
+		{in: "WITH x AS (DELETE FROM t WHERE a = 1 RETURNING *) SELECT * FROM x", readOnly: false},
+		{in: "WITH x AS (SELECT 1), y AS (INSERT INTO t (a) VALUES (1) RETURNING a) SELECT * FROM y", readOnly: false},
+		{in: "SELECT * FROM (WITH x AS (UPDATE t SET a = 1 RETURNING a) SELECT * FROM x) AS s", readOnly: false},
+		{in: "EXPLAIN DELETE FROM t", readOnly: false},
+		{in: "COPY t FROM STDIN", readOnly: false},
+		{in: "COPY t TO '/tmp/t.csv'", readOnly: false},
+		{in: "COPY (SELECT a FROM t) TO '/tmp/t.csv'", readOnly: false},
+		{in: "SELECT CASE WHEN a > 0 THEN 1 ELSE 0 END FROM t", readOnly: true},
+		{in: "UPDATE t SET a = CASE WHEN a > 0 THEN 1 END", readOnly: false},
+		{in: "SELECT a INTO b FROM t", readOnly: false},
+		{in: "SELECT a FROM t FOR UPDATE", readOnly: false},
+		{in: "UPDATE t SET a = 1", readOnly: false},
+		{in: "CREATE TABLE t (a int)", readOnly: false},
+		{in: "DROP TABLE t", readOnly: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			parser, err := xsqlparser.NewParser(strings.NewReader(c.in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if r := sqlast.IsReadOnly(stmt); r != c.readOnly {
+				t.Errorf("expected %v but %v", c.readOnly, r)
+			}
+		})
+	}
+}
+
+func TestRenameIdent(t *testing.T) {
+	cases := []struct {
+		name      string
+		in        string
+		from, to  string
+		matchCase bool
+		dialect   dialect.Dialect
+		out       string
+	}{
+		{
+			name: "column in select, where and order by",
+			in:   "SELECT u.name, Name AS n FROM users AS u WHERE name LIKE 'a%' ORDER BY u.NAME",
+			from: "name",
+			to:   "full_name",
+			out:  "SELECT u.full_name, full_name AS n FROM users AS u WHERE full_name LIKE 'a%' ORDER BY u.full_name",
+		},
+		{
+			name:      "match case",
+			in:        "SELECT name, Name FROM users",
+			from:      "name",
+			to:        "full_name",
+			matchCase: true,
+			out:       "SELECT full_name, Name FROM users",
+		},
+		{
+			name: "only the last part of qualified names",
+			in:   "SELECT name.id, t.name FROM name, t",
+			from: "name",
+			to:   "n",
+			out:  "SELECT name.id, t.n FROM n, t",
+		},
+		{
+			name: "quote keywords",
+			in:   "SELECT a FROM t WHERE a > 1",
+			from: "a",
+			to:   "order",
+			out:  `SELECT "order" FROM t WHERE "order" > 1`,
+		},
+		{
+			name: "non-reserved keywords are not quoted",
+			in:   "SELECT a FROM t",
+			from: "a",
+			to:   "value",
+			out:  "SELECT value FROM t",
+		},
+		{
+			name: "keep quote style",
+			in:   `SELECT "a" FROM t`,
+			from: "a",
+			to:   "b",
+			out:  `SELECT "b" FROM t`,
+		},
+		{
+			name: "case branches",
+			in:   "SELECT CASE a WHEN 1 THEN a ELSE b END FROM t WHERE CASE WHEN a > 0 THEN TRUE END",
+			from: "a",
+			to:   "c",
+			out:  "SELECT CASE c WHEN 1 THEN c ELSE b END FROM t WHERE CASE WHEN c > 0 THEN TRUE END",
+		},
+		{
+			name: "escape quotes in name",
+			in:   "SELECT a FROM t",
+			from: "a",
+			to:   `say "hi"`,
+			out:  `SELECT "say ""hi""" FROM t`,
+		},
+		{
+			name:    "quote of dialect",
+			in:      "SELECT a FROM t",
+			from:    "a",
+			to:      "select",
+			dialect: &dialect.MySQLDialect{},
+			out:     "SELECT `select` FROM t",
+		},
+		{
+			name:    "fold quoted name",
+			in:      "SELECT a FROM t",
+			from:    "a",
+			to:      "Order",
+			dialect: &dialect.PostgresqlDialect{},
+			out:     `SELECT "order" FROM t`,
+		},
+		{
+			name:      "match folded name",
+			in:        `SELECT Name, "Name", "name" FROM users`,
+			from:      "NAME",
+			to:        "full_name",
+			matchCase: true,
+			dialect:   &dialect.PostgresqlDialect{},
+			out:       `SELECT full_name, "Name", "full_name" FROM users`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := c.dialect
+			if d == nil {
+				d = &dialect.GenericSQLDialect{}
+			}
+			parser, err := xsqlparser.NewParser(strings.NewReader(c.in), d)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			sqlast.RenameIdent(stmt, c.from, c.to, c.matchCase, d)
+			if out := stmt.ToSQLString(); out != c.out {
+				t.Errorf("expected %s but %s", c.out, out)
+			}
+		})
+	}
+}
+
+func TestRedact(t *testing.T) {
+	cases := []struct {
+		dialect dialect.Dialect
+		in      string
+		out     string
+	}{
+		{
+			in:  "SELECT a FROM t WHERE name = 'bob' AND age > 30 LIMIT 10 OFFSET 5",
+			out: "SELECT a FROM t WHERE name = ? AND age > ? LIMIT ? OFFSET ?",
+		},
+		{
+			in:  "INSERT INTO t (a, b, c) VALUES (1, 'x', NULL), ($1, 2.5, TRUE)",
+			out: "INSERT INTO t (a, b, c) VALUES (?, ?, NULL), (?, ?, TRUE)",
+		},
+		{
+			in:  "UPDATE t SET a = 'x', b = b + 1 WHERE id IN (1, 2, 3)",
+			out: "UPDATE t SET a = ?, b = b + ? WHERE id IN (?, ?, ?)",
+		},
+		{
+			in:  "SELECT count(*) FROM t WHERE created_at >= DATE '2020-01-01' GROUP BY a HAVING count(*) > 1",
+			out: "SELECT count(*) FROM t WHERE created_at >= ? GROUP BY a HAVING count(*) > ?",
+		},
+		{
+			in:  "SELECT a FROM t WHERE b = $$secret$$ OR c = $x$it's$x$",
+			out: "SELECT a FROM t WHERE b = ? OR c = ?",
+		},
+		{
+			in:  "SELECT EXTRACT('epoch' FROM ts) FROM t WHERE a = 1",
+			out: "SELECT EXTRACT('epoch' FROM ts) FROM t WHERE a = ?",
+		},
+		{
+			in:  "COMMENT ON TABLE t IS 'secret'",
+			out: "COMMENT ON TABLE t IS ?",
+		},
+		{
+			dialect: &dialect.MySQLDialect{},
+			in:      "SELECT a FROM t LIMIT 5, 10",
+			out:     "SELECT a FROM t LIMIT ?, ?",
+		},
+		{
+			dialect: &dialect.MySQLDialect{},
+			in:      "SELECT GROUP_CONCAT(a SEPARATOR ';') FROM t",
+			out:     "SELECT GROUP_CONCAT(a SEPARATOR ?) FROM t",
+		},
+		{
+			dialect: &dialect.MySQLDialect{},
+			in:      "SELECT j.a FROM t, JSON_TABLE(t.doc, '$.items[*]' COLUMNS (a INT PATH '$.a')) AS j",
+			out:     "SELECT j.a FROM t, JSON_TABLE(t.doc, ? COLUMNS (a int PATH ?)) AS j",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			d := c.dialect
+			if d == nil {
+				d = &dialect.PostgresqlDialect{}
+			}
+			parser, err := xsqlparser.NewParser(strings.NewReader(c.in), d)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			src := stmt.ToSQLString()
+
+			if out := sqlast.Redact(stmt).ToSQLString(); out != c.out {
+				t.Errorf("expected %s but %s", c.out, out)
+			}
+			if s := stmt.ToSQLString(); s != src {
+				t.Errorf("original must not be changed but %s", s)
+			}
+		})
+	}
+
+	t.Run("literal", func(t *testing.T) {
+		if out := sqlast.Redact(&sqlast.LongValue{Long: 1}).ToSQLString(); out != "?" {
+			t.Errorf("expected ? but %s", out)
+		}
+	})
+}
+
+func TestConvertPlaceholders(t *testing.T) {
+	cases := []struct {
+		name  string
+		in    string
+		style sqlast.PlaceholderStyle
+		out   string
+	}{
+		{
+			name:  "question to dollar",
+			in:    "SELECT a FROM t WHERE b = ? AND c IN (?, ?)",
+			style: sqlast.DollarPlaceholder,
+			out:   "SELECT a FROM t WHERE b = $1 AND c IN ($2, $3)",
+		},
+		{
+			name:  "dollar to question",
+			in:    "SELECT a FROM t WHERE b = $1 AND c IN ($2, $3)",
+			style: sqlast.QuestionPlaceholder,
+			out:   "SELECT a FROM t WHERE b = ? AND c IN (?, ?)",
+		},
+		{
+			name:  "named to dollar",
+			in:    "UPDATE t SET a = :a, b = :b WHERE a != :a",
+			style: sqlast.DollarPlaceholder,
+			out:   "UPDATE t SET a = $1, b = $2 WHERE a != $1",
+		},
+		{
+			name:  "keep numbers of dollar",
+			in:    "SELECT a FROM t WHERE b = $2 AND c = $1 AND d = ?",
+			style: sqlast.DollarPlaceholder,
+			out:   "SELECT a FROM t WHERE b = $2 AND c = $1 AND d = $3",
+		},
+		{
+			name:  "question to named",
+			in:    "INSERT INTO t (a, b, c) VALUES (?, :p1, ?)",
+			style: sqlast.NamedPlaceholder,
+			out:   "INSERT INTO t (a, b, c) VALUES (:p2, :p1, :p3)",
+		},
+		{
+			name:  "dollar to named with the same name",
+			in:    "SELECT a FROM t WHERE a = $1 AND b = :p1 AND c = $1 AND d = $2 AND e = ?",
+			style: sqlast.NamedPlaceholder,
+			out:   "SELECT a FROM t WHERE a = :p3 AND b = :p1 AND c = :p3 AND d = :p2 AND e = :p4",
+		},
+		{
+			name:  "searched case",
+			in:    "SELECT CASE WHEN a = ? THEN ? ELSE ? END FROM t",
+			style: sqlast.DollarPlaceholder,
+			out:   "SELECT CASE WHEN a = $1 THEN $2 ELSE $3 END FROM t",
+		},
+		{
+			name:  "case branches",
+			in:    "SELECT CASE $1 WHEN $2 THEN $3 ELSE $4 END FROM t",
+			style: sqlast.NamedPlaceholder,
+			out:   "SELECT CASE :p1 WHEN :p2 THEN :p3 ELSE :p4 END FROM t",
+		},
+		{
+			name:  "update without where",
+			in:    "UPDATE t SET a = ?, b = :b",
+			style: sqlast.DollarPlaceholder,
+			out:   "UPDATE t SET a = $1, b = $2",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := xsqlparser.NewParser(strings.NewReader(c.in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if out := sqlast.ConvertPlaceholders(stmt, c.style).ToSQLString(); out != c.out {
+				t.Errorf("expected %s but %s", c.out, out)
+			}
+			if s := stmt.ToSQLString(); s != c.in {
+				t.Errorf("original must not be changed but %s", s)
+			}
+		})
+	}
+
+	t.Run("placeholders in order of positions", func(t *testing.T) {
+		parser, err := xsqlparser.NewParser(strings.NewReader("SELECT :x FROM t WHERE a = $1 AND b = ?"), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		stmt, err := parser.ParseStatement()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+
+		var values []string
+		var styles []sqlast.PlaceholderStyle
+		for _, p := range sqlast.Placeholders(stmt) {
+			values = append(values, p.Value)
+			styles = append(styles, p.Style())
+		}
+		if s := strings.Join(values, ", "); s != ":x, $1, ?" {
+			t.Errorf("expected :x, $1, ? but %s", s)
+		}
+		expected := []sqlast.PlaceholderStyle{sqlast.NamedPlaceholder, sqlast.DollarPlaceholder, sqlast.QuestionPlaceholder}
+		if fmt.Sprint(styles) != fmt.Sprint(expected) {
+			t.Errorf("expected %v but %v", expected, styles)
+		}
+	})
+
+	t.Run("placeholders in case branches", func(t *testing.T) {
+		parser, err := xsqlparser.NewParser(strings.NewReader("SELECT CASE WHEN a = $1 THEN $2 ELSE $3 END FROM t"), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		stmt, err := parser.ParseStatement()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+
+		var values []string
+		for _, p := range sqlast.Placeholders(stmt) {
+			values = append(values, p.Value)
+		}
+		if s := strings.Join(values, ", "); s != "$1, $2, $3" {
+			t.Errorf("expected $1, $2, $3 but %s", s)
+		}
+	})
+}
+
+func TestComplexity(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  sqlast.Metrics
+	}{
+		{
+			name: "joins and nested subquery",
+			in: `SELECT u.name, count(o.id), max(p.price)
+FROM users AS u
+JOIN orders AS o ON o.user_id = u.id
+LEFT JOIN products AS p ON p.id = o.product_id
+WHERE u.id IN (SELECT user_id FROM vips WHERE level > (SELECT avg(level) FROM vips))
+GROUP BY u.name`,
+			out: sqlast.Metrics{Joins: 2, Subqueries: 2, Aggregates: 3, MaxDepth: 3},
+		},
+		{
+			name: "simple query",
+			in:   "SELECT a, lower(b) FROM t",
+			out:  sqlast.Metrics{MaxDepth: 1},
+		},
+		{
+			name: "CTE and ordered aggregate",
+			in:   "WITH c AS (SELECT a FROM t CROSS JOIN u) SELECT my_agg(DISTINCT a) FROM c",
+			out:  sqlast.Metrics{Joins: 1, Subqueries: 1, Aggregates: 1, MaxDepth: 2},
+		},
+		{
+			name: "insert from query",
+			in:   "INSERT INTO t (a) SELECT sum(a) FROM u",
+			out:  sqlast.Metrics{Aggregates: 1, MaxDepth: 1},
+		},
+		{
+			name: "subquery in case branch",
+			in:   "SELECT CASE WHEN a > 0 THEN (SELECT max(b) FROM u) ELSE 0 END FROM t",
+			out:  sqlast.Metrics{Subqueries: 1, Aggregates: 1, MaxDepth: 2},
+		},
+		{
+			name: "update without where",
+			in:   "UPDATE t SET a = CASE WHEN b > 0 THEN 1 END",
+			out:  sqlast.Metrics{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := xsqlparser.NewParser(strings.NewReader(c.in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if m := sqlast.Complexity(stmt); m != c.out {
+				t.Errorf("should be %+v but %+v", c.out, m)
+			}
+		})
+	}
+}
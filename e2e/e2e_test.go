@@ -28,6 +28,10 @@ func TestParseQuery(t *testing.T) {
 			name: "CREATE TABLE",
 			dir:  "create_table",
 		},
+		{
+			name: "CREATE SEQUENCE",
+			dir:  "create_sequence",
+		},
 		{
 			name: "ALTER TABLE",
 			dir:  "alter",
@@ -36,6 +40,10 @@ func TestParseQuery(t *testing.T) {
 			name: "DROP TABLE",
 			dir:  "drop_table",
 		},
+		{
+			name: "DROP VIEW",
+			dir:  "drop_view",
+		},
 		{
 			name: "CREATE INDEX",
 			dir:  "create_index",
@@ -48,6 +56,14 @@ func TestParseQuery(t *testing.T) {
 			name: "INSERT",
 			dir:  "insert",
 		},
+		{
+			name: "PREPARE",
+			dir:  "prepare",
+		},
+		{
+			name: "SET",
+			dir:  "set",
+		},
 	}
 
 	for _, c := range cases {
@@ -73,39 +89,42 @@ func TestParseQuery(t *testing.T) {
 						t.Fatalf("%+v", err)
 					}
 
-					orig, err := parser.ParseStatement()
+					stmts, err := parser.ParseSQL()
 					if err != nil {
 						t.Fatalf("%+v", err)
 					}
-					recovered := orig.ToSQLString()
+					for i, orig := range stmts {
+						recovered := orig.ToSQLString()
 
-					parser, err = xsqlparser.NewParser(bytes.NewBufferString(recovered), &dialect.GenericSQLDialect{})
-					if err != nil {
-						t.Log(recovered)
-						t.Fatalf("%+v", err)
-					}
+						parser, err = xsqlparser.NewParser(bytes.NewBufferString(recovered), &dialect.GenericSQLDialect{})
+						if err != nil {
+							t.Log(recovered)
+							t.Fatalf("statement %d: %+v", i+1, err)
+						}
 
-					stmt2, err := parser.ParseStatement()
-					if err != nil {
-						t.Fatalf("%+v", err)
-					}
+						stmt2, err := parser.ParseStatement()
+						if err != nil {
+							t.Log(recovered)
+							t.Fatalf("statement %d: %+v", i+1, err)
+						}
 
-					recovered2 := stmt2.ToSQLString()
+						recovered2 := stmt2.ToSQLString()
 
-					parser, err = xsqlparser.NewParser(bytes.NewBufferString(recovered2), &dialect.GenericSQLDialect{})
-					if err != nil {
-						t.Log(recovered)
-						t.Fatalf("%+v", err)
-					}
+						parser, err = xsqlparser.NewParser(bytes.NewBufferString(recovered2), &dialect.GenericSQLDialect{})
+						if err != nil {
+							t.Log(recovered)
+							t.Fatalf("statement %d: %+v", i+1, err)
+						}
 
-					stmt3, err := parser.ParseStatement()
-					if err != nil {
-						t.Fatalf("%+v", err)
-					}
+						stmt3, err := parser.ParseStatement()
+						if err != nil {
+							t.Fatalf("statement %d: %+v", i+1, err)
+						}
 
-					if astdiff := xsqlparser.CompareWithoutMarker(stmt2, stmt3); astdiff != "" {
-						t.Logf(recovered)
-						t.Errorf("should be same ast but diff:\n %s", astdiff)
+						if astdiff := xsqlparser.CompareWithoutMarker(stmt2, stmt3); astdiff != "" {
+							t.Logf(recovered)
+							t.Errorf("statement %d: should be same ast but diff:\n %s", i+1, astdiff)
+						}
 					}
 				})
 			}
@@ -0,0 +1 @@
+ALTER TABLE test1 ALTER COLUMN amount TYPE numeric(10,2) USING CAST(amount AS numeric(10,2));
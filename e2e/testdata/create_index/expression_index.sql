@@ -0,0 +1,2 @@
+CREATE INDEX users_lower_name_idx ON users (lower(name) text_pattern_ops, (score + bonus) DESC NULLS LAST);
+CREATE INDEX users_deleted_at_idx ON users (deleted_at NULLS FIRST);
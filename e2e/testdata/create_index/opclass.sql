@@ -0,0 +1,2 @@
+CREATE INDEX users_name_idx ON users (name text_pattern_ops);
+CREATE UNIQUE INDEX ON users USING btree (email varchar_pattern_ops DESC, id ASC);
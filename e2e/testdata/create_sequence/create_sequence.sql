@@ -0,0 +1,2 @@
+CREATE SEQUENCE user_id_seq START WITH 1 INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1;
+CREATE SEQUENCE order_seq MAXVALUE 1000 CYCLE OWNED BY orders.id;
@@ -0,0 +1 @@
+CREATE TABLE arrays (ids int[], matrix text[][], fixed int[3], grid int[2][2]);
@@ -0,0 +1 @@
+CREATE TABLE t (name character varying(10) COLLATE "C" NOT NULL);
@@ -0,0 +1,3 @@
+CREATE TABLE active_users AS SELECT id, name FROM users WHERE active = TRUE;
+CREATE TABLE IF NOT EXISTS user_ids (id) AS SELECT id FROM users WITH DATA;
+CREATE TABLE empty_users AS SELECT * FROM users WITH NO DATA;
@@ -0,0 +1,7 @@
+CREATE TABLE orders (
+    id int GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    seq bigint GENERATED BY DEFAULT AS IDENTITY (START WITH 100 INCREMENT BY 10 NO CYCLE),
+    price numeric NOT NULL,
+    quantity int NOT NULL,
+    total numeric GENERATED ALWAYS AS (price * quantity) STORED
+)
@@ -0,0 +1,6 @@
+CREATE TABLE orders (
+    id int primary key,
+    customer_id int references customers(id) match full on delete cascade on update set null,
+    warehouse_id int references warehouses on delete restrict,
+    coupon_id int references coupons(id) match simple on update no action on delete set default
+)
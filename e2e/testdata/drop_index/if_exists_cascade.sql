@@ -0,0 +1 @@
+DROP INDEX IF EXISTS public.title_idx CASCADE;
@@ -0,0 +1 @@
+DROP VIEW IF EXISTS v1, v2 CASCADE;
@@ -0,0 +1 @@
+DROP MATERIALIZED VIEW mv;
@@ -0,0 +1 @@
+INSERT INTO t VALUES (DEFAULT, NULL, 3);
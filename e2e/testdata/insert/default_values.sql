@@ -0,0 +1 @@
+INSERT INTO t DEFAULT VALUES;
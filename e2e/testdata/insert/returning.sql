@@ -0,0 +1,2 @@
+INSERT INTO users (name) VALUES ('alice') RETURNING id, name AS user_name;
+INSERT INTO users (name) SELECT name FROM staging RETURNING *;
@@ -0,0 +1 @@
+DEALLOCATE ALL;
@@ -0,0 +1 @@
+EXECUTE find_user (1, 'alice');
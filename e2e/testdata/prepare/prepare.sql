@@ -0,0 +1,2 @@
+PREPARE find_user (int, varchar(255)) AS
+SELECT id, name FROM users WHERE id = $1 AND name = $2;
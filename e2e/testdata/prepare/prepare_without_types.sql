@@ -0,0 +1 @@
+PREPARE all_users AS SELECT * FROM users;
@@ -0,0 +1,3 @@
+SELECT created_at AT TIME ZONE 'UTC' AT TIME ZONE 'Asia/Tokyo' AS local_time
+FROM events
+WHERE created_at AT TIME ZONE 'UTC' > '2019-01-01';
@@ -0,0 +1,2 @@
+SELECT id FROM users
+WHERE flags & 4 = 4 OR (flags | mask) >> 2 <> 0 OR flags ^ 1 << 3 > 0;
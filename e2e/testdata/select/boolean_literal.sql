@@ -0,0 +1 @@
+SELECT * FROM users WHERE active = TRUE AND deleted_at IS NULL AND verified <> FALSE AND flag IS UNKNOWN AND approved IS NOT FALSE;
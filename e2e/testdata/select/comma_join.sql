@@ -0,0 +1,3 @@
+SELECT * FROM orders o, customers AS c
+INNER JOIN addresses a ON a.customer_id = c.id
+WHERE o.customer_id = c.id;
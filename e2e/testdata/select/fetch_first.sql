@@ -0,0 +1 @@
+SELECT id FROM users ORDER BY id FETCH FIRST 10 ROWS ONLY;
@@ -0,0 +1,4 @@
+SELECT id, name FROM users
+ORDER BY score DESC
+OFFSET 5 ROWS
+FETCH NEXT 10 PERCENT ROWS WITH TIES;
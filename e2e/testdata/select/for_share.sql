@@ -0,0 +1 @@
+SELECT * FROM accounts a FOR NO KEY UPDATE NOWAIT FOR SHARE;
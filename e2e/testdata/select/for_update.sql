@@ -0,0 +1,5 @@
+SELECT id, payload FROM jobs
+WHERE status = 'queued'
+ORDER BY id
+LIMIT 10
+FOR UPDATE OF jobs SKIP LOCKED;
@@ -0,0 +1,6 @@
+SELECT * FROM generate_series(1, 3) AS g(n);
+SELECT * FROM f() AS t;
+SELECT * FROM f() t;
+SELECT * FROM f(1) AS t(a int, b text);
+SELECT * FROM json_to_record('{"a": 1}') AS (a int, b text);
+SELECT * FROM users AS u(id, name) JOIN unnest(u.tags) WITH ORDINALITY AS x(v, n) ON u.id = x.v;
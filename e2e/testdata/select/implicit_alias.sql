@@ -0,0 +1,2 @@
+SELECT o.id order_id, c.name AS customer FROM orders o
+INNER JOIN customers c ON o.customer_id = c.id;
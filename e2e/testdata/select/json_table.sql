@@ -0,0 +1,9 @@
+SELECT o.id, jt.*
+FROM orders AS o,
+  JSON_TABLE(o.doc, '$.items[*]' COLUMNS (
+    rn FOR ORDINALITY,
+    sku text PATH '$.sku',
+    qty int PATH '$.qty' DEFAULT 1 ON EMPTY NULL ON ERROR,
+    gift int EXISTS PATH '$.gift',
+    NESTED PATH '$.tags[*]' COLUMNS (tag text PATH '$')
+  )) AS jt;
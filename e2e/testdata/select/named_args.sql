@@ -0,0 +1 @@
+SELECT make_interval(days => 5, hours := 2) FROM t;
@@ -0,0 +1,5 @@
+SELECT id, name FROM users
+WHERE org_id = $1
+ORDER BY id
+OFFSET $2 ROWS
+FETCH NEXT $3 ROWS ONLY;
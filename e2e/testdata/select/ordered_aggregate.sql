@@ -0,0 +1 @@
+SELECT department, count(DISTINCT employee_id), string_agg(DISTINCT name, ',' ORDER BY name) FROM employees GROUP BY department;
@@ -0,0 +1,4 @@
+SELECT region, product, SUM(amount)
+FROM orders
+GROUP BY 1, 2
+ORDER BY 3 DESC, 1;
@@ -0,0 +1 @@
+SELECT * FROM reservations WHERE (starts_at, ends_at) OVERLAPS (TIMESTAMP '2020-01-01 10:00:00', TIMESTAMP '2020-01-01 12:00:00') AND room_id = 1;
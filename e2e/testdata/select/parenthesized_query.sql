@@ -0,0 +1,3 @@
+(SELECT 1) UNION (SELECT 2) ORDER BY 1;
+(SELECT a FROM t1 ORDER BY a LIMIT 10) UNION ALL (SELECT a FROM t2) LIMIT 5;
+SELECT a FROM t1 UNION (SELECT a FROM t2 INTERSECT SELECT a FROM t3);
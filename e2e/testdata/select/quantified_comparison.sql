@@ -0,0 +1,3 @@
+SELECT * FROM t WHERE x > ALL (SELECT y FROM u);
+SELECT * FROM t WHERE x = ANY (SELECT y FROM u) OR x <= SOME (SELECT z FROM v);
+SELECT * FROM t WHERE x = ANY (ids);
@@ -0,0 +1,2 @@
+SELECT id, name INTO TEMPORARY TABLE tmp_users FROM users WHERE active = TRUE;
+SELECT * INTO UNLOGGED archived FROM logs;
@@ -0,0 +1 @@
+SELECT TRIM(LEADING '0' FROM code), SUBSTRING(name FROM 2 FOR 3), EXTRACT(MONTH FROM created_at), POSITION('@' IN email) FROM users;
@@ -0,0 +1 @@
+SELECT first_name || ' ' || last_name AS full_name FROM users;
@@ -0,0 +1,3 @@
+TABLE t;
+TABLE public.users UNION ALL TABLE admins ORDER BY 1 LIMIT 10;
+WITH u AS (TABLE users) SELECT * FROM u;
@@ -0,0 +1,3 @@
+SELECT id, amount
+FROM orders AS o TABLESAMPLE BERNOULLI (2.5) REPEATABLE (7)
+WHERE amount > 0;
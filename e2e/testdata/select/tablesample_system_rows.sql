@@ -0,0 +1 @@
+SELECT * FROM users TABLESAMPLE SYSTEM_ROWS (100);
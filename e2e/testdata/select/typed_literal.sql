@@ -0,0 +1,2 @@
+SELECT * FROM orders
+WHERE ordered_at >= TIMESTAMP '2020-01-01 00:00:00' AND shipped_on < DATE '2020-02-01' AND cutoff = TIME '12:00';
@@ -0,0 +1 @@
+SELECT * EXCLUDE (password, token) REPLACE (lower(email) AS email, id * 2 AS id), o.* EXCLUDE (user_id) FROM users AS u JOIN orders AS o ON u.id = o.user_id;
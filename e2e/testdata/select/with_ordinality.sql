@@ -0,0 +1,2 @@
+SELECT t.val, t.idx FROM unnest(tags) WITH ORDINALITY AS t WHERE t.idx > 1;
+SELECT * FROM generate_series(1, 10) WITH ORDINALITY s JOIN items ON items.id = s.generate_series;
@@ -0,0 +1 @@
+SHOW search_path;
@@ -0,0 +1 @@
+SET TIME ZONE 'Asia/Tokyo';
@@ -16,27 +16,57 @@ import (
 )
 
 type Parser struct {
-	tokens       []*sqltoken.Token
-	index        uint
-	comments     map[sqltoken.Pos]*sqlast.CommentGroup
-	parseComment bool
+	dialect            dialect.Dialect
+	tokens             []*sqltoken.Token
+	index              uint
+	comments           map[sqltoken.Pos]*sqlast.CommentGroup
+	parseComment       bool
+	allowTrailingComma bool
+	doubleQuoteStrings bool
 }
 
+// ParserOption configures a Parser created by NewParser.
 type ParserOption func(*Parser)
 
+// ParseComment makes the parser collect comments for ParseFile.
+// Comments are skipped by default.
 func ParseComment(p *Parser) {
 	p.parseComment = true
 	p.comments = make(map[sqltoken.Pos]*sqlast.CommentGroup)
 }
 
-func NewParser(src io.Reader, dialect dialect.Dialect, opts ...ParserOption) (*Parser, error) {
-	tokenizer := sqltoken.NewTokenizer(src, dialect)
+// AllowTrailingComma accepts a comma after the last item of
+// select lists and parenthesized expression lists, e.g. `SELECT a, b, FROM t`.
+// Trailing commas are rejected by default.
+func AllowTrailingComma(allow bool) ParserOption {
+	return func(p *Parser) {
+		p.allowTrailingComma = allow
+	}
+}
+
+// AllowDoubleQuoteStrings parses double-quoted words in expressions as
+// string literals like MySQL without ANSI_QUOTES mode does.
+// They are parsed as identifiers by default.
+// MySQLDialect tokenizes them as string literals already unless ANSIQuotes is set.
+func AllowDoubleQuoteStrings(allow bool) ParserOption {
+	return func(p *Parser) {
+		p.doubleQuoteStrings = allow
+	}
+}
+
+// NewParser tokenizes src and creates a Parser.
+// GenericSQLDialect is used if d is nil.
+func NewParser(src io.Reader, d dialect.Dialect, opts ...ParserOption) (*Parser, error) {
+	if d == nil {
+		d = &dialect.GenericSQLDialect{}
+	}
+	tokenizer := sqltoken.NewTokenizer(src, d)
 	set, err := tokenizer.Tokenize()
 	if err != nil {
 		return nil, errors.Errorf("tokenize err failed: %w", err)
 	}
 
-	parser := &Parser{tokens: set, index: 0}
+	parser := &Parser{dialect: d, tokens: set, index: 0}
 
 	for _, o := range opts {
 		o(parser)
@@ -72,8 +102,13 @@ func (p *Parser) ParseSQL() ([]sqlast.Stmt, error) {
 	var expectingDelimiter bool
 
 	for {
-		ok, _ := p.consumeToken(sqltoken.Semicolon)
-		if !ok && expectingDelimiter {
+		ok, err := p.consumeToken(sqltoken.Semicolon)
+		if err != nil && err != EOF {
+			return nil, err
+		}
+		// the semicolon after the last statement can be omitted, and the
+		// comments after it are still collected below before EOF breaks
+		if !ok && expectingDelimiter && err != EOF {
 			tok, _ := p.peekToken()
 			return nil, errors.Errorf("expect semicolon but %+v", tok)
 		}
@@ -110,18 +145,94 @@ func (p *Parser) ParseSQL() ([]sqlast.Stmt, error) {
 	return stmts, nil
 }
 
+// ParseStatement parses a single statement. If the input ends in the middle
+// of the statement, the returned error matches ErrUnexpectedEOF via errors.Is,
+// so that callers reading input incrementally can ask for more.
 func (p *Parser) ParseStatement() (sqlast.Stmt, error) {
 	tok, err := p.nextToken()
 	if err != nil {
 		return nil, err
 	}
+	p.prevToken()
+
+	if err := p.checkBrackets(); err != nil {
+		return nil, err
+	}
+
+	stmt, err := p.parseStatement()
+	if err != nil {
+		if errors.Is(err, EOF) && !errors.Is(err, ErrUnexpectedEOF) {
+			return nil, errors.Errorf("statement starting at %+v is incomplete: %v: %w", tok.From, err, ErrUnexpectedEOF)
+		}
+		return nil, err
+	}
+
+	return stmt, nil
+}
+
+var bracketNames = map[sqltoken.Kind]string{
+	sqltoken.LParen:   "parenthesis",
+	sqltoken.RParen:   "parenthesis",
+	sqltoken.LBracket: "bracket",
+	sqltoken.RBracket: "bracket",
+}
+
+// checkBrackets checks that parentheses and brackets of the statement starting
+// at the current index are balanced, so that an unclosed one is reported at
+// the position where it is opened instead of where the parser gives up.
+// The statement ends at a semicolon or at the end of tokens.
+func (p *Parser) checkBrackets() error {
+	var stack []*sqltoken.Token
+
+	for _, tok := range p.tokens[p.index:] {
+		switch tok.Kind {
+		case sqltoken.LParen, sqltoken.LBracket:
+			stack = append(stack, tok)
+			continue
+		case sqltoken.RParen, sqltoken.RBracket:
+		case sqltoken.Semicolon:
+			if len(stack) > 0 {
+				open := stack[len(stack)-1]
+				return errors.Errorf("unclosed %s opened at %d:%d", bracketNames[open.Kind], open.From.Line, open.From.Col)
+			}
+			return nil
+		default:
+			continue
+		}
+
+		if len(stack) == 0 {
+			return errors.Errorf("unmatched closing %s at %d:%d", bracketNames[tok.Kind], tok.From.Line, tok.From.Col)
+		}
+		open := stack[len(stack)-1]
+		if (open.Kind == sqltoken.LParen) != (tok.Kind == sqltoken.RParen) {
+			return errors.Errorf("unclosed %s opened at %d:%d", bracketNames[open.Kind], open.From.Line, open.From.Col)
+		}
+		stack = stack[:len(stack)-1]
+	}
+
+	if len(stack) > 0 {
+		open := stack[len(stack)-1]
+		return errors.Errorf("unclosed %s opened at %d:%d: %w", bracketNames[open.Kind], open.From.Line, open.From.Col, ErrUnexpectedEOF)
+	}
+	return nil
+}
+
+func (p *Parser) parseStatement() (sqlast.Stmt, error) {
+	tok, err := p.nextToken()
+	if err != nil {
+		return nil, err
+	}
+	if tok.Kind == sqltoken.LParen {
+		p.prevToken()
+		return p.parseQuery()
+	}
 	word, ok := tok.Value.(*sqltoken.SQLWord)
 	if !ok {
 		return nil, errors.Errorf("a keyword at the beginning of statement %s", tok.Value)
 	}
 
 	switch word.Keyword {
-	case "SELECT", "WITH":
+	case "SELECT", "WITH", "TABLE":
 		p.prevToken()
 		return p.parseQuery()
 	case "CREATE":
@@ -142,6 +253,72 @@ func (p *Parser) ParseStatement() (sqlast.Stmt, error) {
 	case "DROP":
 		p.prevToken()
 		return p.parseDrop()
+	case "COPY":
+		if !p.dialect.Supports(dialect.CopyStatement) {
+			return nil, errors.Errorf("COPY is only supported in PostgreSQL dialect")
+		}
+		p.prevToken()
+		return p.parseCopy()
+	case "PREPARE":
+		p.prevToken()
+		return p.parsePrepare()
+	case "EXECUTE":
+		p.prevToken()
+		return p.parseExecute()
+	case "DEALLOCATE":
+		p.prevToken()
+		return p.parseDeallocate()
+	case "SET":
+		p.prevToken()
+		return p.parseSet()
+	case "SHOW":
+		p.prevToken()
+		return p.parseShow()
+	case "RESET":
+		if !p.dialect.Supports(dialect.ResetStatement) {
+			return nil, errors.Errorf("RESET is only supported in PostgreSQL dialect")
+		}
+		p.prevToken()
+		return p.parseReset()
+	case "DO":
+		if !p.dialect.Supports(dialect.DoStatement) {
+			return nil, errors.Errorf("DO is only supported in PostgreSQL dialect")
+		}
+		p.prevToken()
+		return p.parseDo()
+	case "COMMENT":
+		if !p.dialect.Supports(dialect.CommentStatement) {
+			return nil, errors.Errorf("COMMENT is only supported in PostgreSQL dialect")
+		}
+		p.prevToken()
+		return p.parseCommentOn()
+	case "DECLARE", "FETCH", "MOVE", "CLOSE":
+		if !p.dialect.Supports(dialect.Cursors) {
+			return nil, errors.Errorf("%s is only supported in PostgreSQL dialect", word.Keyword)
+		}
+		p.prevToken()
+		switch word.Keyword {
+		case "DECLARE":
+			return p.parseDeclareCursor()
+		case "CLOSE":
+			return p.parseClose()
+		}
+		return p.parseFetchCursor()
+	case "BEGIN", "START":
+		p.prevToken()
+		return p.parseBegin()
+	case "COMMIT":
+		p.prevToken()
+		return p.parseCommit()
+	case "ROLLBACK":
+		p.prevToken()
+		return p.parseRollback()
+	case "SAVEPOINT":
+		p.prevToken()
+		return p.parseSavepoint()
+	case "RELEASE":
+		p.prevToken()
+		return p.parseReleaseSavepoint()
 	case "EXPLAIN":
 		stmt, err := p.ParseStatement()
 		if err != nil {
@@ -154,6 +331,39 @@ func (p *Parser) ParseStatement() (sqlast.Stmt, error) {
 }
 
 func (p *Parser) ParseDataType() (sqlast.Type, error) {
+	tp, err := p.parseDataType()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.dialect.Supports(dialect.ArrayTypes) {
+		if ok, _ := p.consumeToken(sqltoken.LBracket); !ok {
+			break
+		}
+		var size *uint
+		if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.Number {
+			n, _, err := p.parseLiteralInt()
+			if err != nil {
+				return nil, errors.Errorf("parseLiteralInt failed: %w", err)
+			}
+			i := uint(n)
+			size = &i
+		}
+		r, _ := p.nextToken()
+		if r == nil || r.Kind != sqltoken.RBracket {
+			return nil, errors.Errorf("expected RBracket but %+v", r)
+		}
+		tp = &sqlast.Array{
+			Ty:     tp,
+			Size:   size,
+			RParen: r.To,
+		}
+	}
+
+	return tp, nil
+}
+
+func (p *Parser) parseDataType() (sqlast.Type, error) {
 	tok, err := p.nextToken()
 	if err != nil {
 		return nil, errors.Errorf("nextToken failed: %w", err)
@@ -180,7 +390,10 @@ func (p *Parser) ParseDataType() (sqlast.Type, error) {
 		unsigned, pos := p.parseMyUnsigned()
 		return &sqlast.Real{From: tok.From, To: tok.To, IsUnsigned: unsigned, Unsigned: pos}, nil
 	case "DOUBLE":
-		p := p.expectKeyword("PRECISION")
+		p, err := p.requireKeyword("PRECISION")
+		if err != nil {
+			return nil, err
+		}
 		return &sqlast.Double{From: tok.From, To: p.To}, nil
 	case "SMALLINT":
 		unsigned, pos := p.parseMyUnsigned()
@@ -219,32 +432,32 @@ func (p *Parser) ParseDataType() (sqlast.Type, error) {
 	case "TIMESTAMP":
 		wok, _, _ := p.parseKeyword("WITH")
 		ook, _, _ := p.parseKeyword("WITHOUT")
+		var zone sqltoken.Pos
 		if wok || ook {
-			p.expectKeyword("TIME")
-			p.expectKeyword("ZONE")
+			z, err := p.parseTimeZoneKeywords()
+			if err != nil {
+				return nil, err
+			}
+			zone = z.To
 		}
 		return &sqlast.Timestamp{
 			Timestamp:    tok.From,
 			WithTimeZone: wok,
+			Zone:         zone,
 		}, nil
 	case "TIME":
 		wok, _, _ := p.parseKeyword("WITH")
 		ook, _, _ := p.parseKeyword("WITHOUT")
 		if wok || ook {
-			p.expectKeyword("TIME")
-			p.expectKeyword("ZONE")
+			if _, err := p.parseTimeZoneKeywords(); err != nil {
+				return nil, err
+			}
 		}
 		return &sqlast.Time{}, nil
 	case "REGCLASS":
 		return &sqlast.Regclass{}, nil
 	case "TEXT":
-		if ok, _ := p.consumeToken(sqltoken.LBracket); ok {
-			p.expectToken(sqltoken.RBracket)
-			return &sqlast.Array{
-				Ty: &sqlast.Text{},
-			}, nil
-		}
-		return &sqlast.Text{}, nil
+		return &sqlast.Text{From: tok.From, To: tok.To}, nil
 	case "BYTEA":
 		return &sqlast.Bytea{}, nil
 	case "NUMERIC":
@@ -252,10 +465,10 @@ func (p *Parser) ParseDataType() (sqlast.Type, error) {
 		if err != nil {
 			return nil, errors.Errorf("parseOptionalPrecisionScale failed: %w", err)
 		}
-		p.prevToken()
-		r, _ := p.nextToken()
-		if r.Kind != sqltoken.RParen {
-			return nil, errors.Errorf("expected RParen but %s", r)
+		rparen := tok.To
+		if precision != nil {
+			p.prevToken()
+			rparen = p.mustNextToken().To
 		}
 
 		unsigned, pos := p.parseMyUnsigned()
@@ -263,7 +476,7 @@ func (p *Parser) ParseDataType() (sqlast.Type, error) {
 			Precision:  precision,
 			Scale:      scale,
 			Numeric:    tok.From,
-			RParen:     r.To,
+			RParen:     rparen,
 			IsUnsigned: unsigned,
 			Unsigned:   pos,
 		}, nil
@@ -280,6 +493,59 @@ func (p *Parser) ParseDataType() (sqlast.Type, error) {
 	}
 }
 
+// parseTimeZoneKeywords parses TIME ZONE after WITH or WITHOUT of a time type
+// and returns the ZONE keyword.
+func (p *Parser) parseTimeZoneKeywords() (*sqltoken.Token, error) {
+	if _, err := p.requireKeyword("TIME"); err != nil {
+		return nil, err
+	}
+	return p.requireKeyword("ZONE")
+}
+
+// ParseType parses src as a single data type such as `numeric(10,2)[]` or
+// `timestamp with time zone`. It fails if any tokens are left after the type.
+func ParseType(src string, d dialect.Dialect) (sqlast.Type, error) {
+	parser, err := NewParser(strings.NewReader(src), d)
+	if err != nil {
+		return nil, errors.Errorf("NewParser failed: %w", err)
+	}
+
+	tp, err := parser.ParseDataType()
+	if err != nil {
+		return nil, errors.Errorf("ParseDataType failed: %w", err)
+	}
+
+	if t, err := parser.peekToken(); err != EOF {
+		return nil, errors.Errorf("unexpected token after data type: %+v", t)
+	}
+
+	return tp, nil
+}
+
+// ParseExpr parses src as a single expression such as a WHERE clause predicate.
+// It fails if any tokens are left after the expression.
+func ParseExpr(src string, d dialect.Dialect) (sqlast.Node, error) {
+	parser, err := NewParser(strings.NewReader(src), d)
+	if err != nil {
+		return nil, errors.Errorf("NewParser failed: %w", err)
+	}
+
+	if err := parser.checkBrackets(); err != nil {
+		return nil, err
+	}
+
+	expr, err := parser.ParseExpr()
+	if err != nil {
+		return nil, errors.Errorf("ParseExpr failed: %w", err)
+	}
+
+	if t, err := parser.peekToken(); err != EOF {
+		return nil, errors.Errorf("unexpected token after expression: %+v", t)
+	}
+
+	return expr, nil
+}
+
 func (p *Parser) ParseExpr() (sqlast.Node, error) {
 	return p.parseSubexpr(0)
 }
@@ -318,14 +584,170 @@ func (p *Parser) parseQuery() (*sqlast.QueryStmt, error) {
 		limit = l
 	}
 
+	var offset *sqlast.OffsetExpr
+	if ok, tok, _ := p.parseKeyword("OFFSET"); ok {
+		o, err := p.parseOffset(tok)
+		if err != nil {
+			return nil, errors.Errorf("invalid offset expression: %w", err)
+		}
+		offset = o
+	}
+
+	var fetch *sqlast.FetchExpr
+	if ok, tok, _ := p.parseKeyword("FETCH"); ok {
+		f, err := p.parseFetch(tok)
+		if err != nil {
+			return nil, errors.Errorf("invalid fetch expression: %w", err)
+		}
+		if f.WithTies && len(orderBy) == 0 {
+			return nil, errors.Errorf("WITH TIES cannot be specified without ORDER BY")
+		}
+		fetch = f
+	}
+
+	locks, err := p.parseLockingClauses()
+	if err != nil {
+		return nil, errors.Errorf("parseLockingClauses failed: %w", err)
+	}
+
 	return &sqlast.QueryStmt{
 		CTEs:    ctes,
 		Body:    body,
 		Limit:   limit,
+		Offset:  offset,
+		Fetch:   fetch,
 		OrderBy: orderBy,
+		Locks:   locks,
 	}, nil
 }
 
+func (p *Parser) parseOffset(offsetTok *sqltoken.Token) (*sqlast.OffsetExpr, error) {
+	v, err := p.parsePrefix()
+	if err != nil {
+		return nil, errors.Errorf("parsePrefix failed: %w", err)
+	}
+
+	offset := &sqlast.OffsetExpr{
+		Offset: offsetTok.From,
+		Value:  v,
+		To:     v.End(),
+	}
+
+	if ok, t, _ := p.parseKeyword("ROW"); ok {
+		offset.Unit = sqlast.FetchUnitRow
+		offset.To = t.To
+	} else if ok, t, _ := p.parseKeyword("ROWS"); ok {
+		offset.Unit = sqlast.FetchUnitRows
+		offset.To = t.To
+	}
+
+	return offset, nil
+}
+
+func (p *Parser) parseFetch(fetchTok *sqltoken.Token) (*sqlast.FetchExpr, error) {
+	fetch := &sqlast.FetchExpr{
+		Fetch: fetchTok.From,
+	}
+
+	if ok, _, _ := p.parseKeyword("NEXT"); ok {
+		fetch.Next = true
+	} else if ok, _, _ := p.parseKeyword("FIRST"); !ok {
+		return nil, p.unexpectedToken("FIRST or NEXT after FETCH")
+	}
+
+	if ok, _, _ := p.parseKeyword("ROW"); ok {
+		fetch.Unit = sqlast.FetchUnitRow
+	} else if ok, _, _ := p.parseKeyword("ROWS"); ok {
+		fetch.Unit = sqlast.FetchUnitRows
+	} else {
+		q, err := p.parsePrefix()
+		if err != nil {
+			return nil, errors.Errorf("parsePrefix failed: %w", err)
+		}
+		fetch.Quantity = q
+
+		if ok, _, _ := p.parseKeyword("PERCENT"); ok {
+			fetch.Percent = true
+		}
+
+		if ok, _, _ := p.parseKeyword("ROW"); ok {
+			fetch.Unit = sqlast.FetchUnitRow
+		} else if ok, _, _ := p.parseKeyword("ROWS"); ok {
+			fetch.Unit = sqlast.FetchUnitRows
+		} else {
+			return nil, p.unexpectedToken("ROW or ROWS")
+		}
+	}
+
+	if ok, toks, _ := p.parseKeywords("WITH", "TIES"); ok {
+		fetch.WithTies = true
+		fetch.To = toks[1].To
+		return fetch, nil
+	}
+
+	ok, t, _ := p.parseKeyword("ONLY")
+	if !ok {
+		return nil, p.unexpectedToken("ONLY or WITH TIES")
+	}
+	fetch.To = t.To
+
+	return fetch, nil
+}
+
+func (p *Parser) parseLockingClauses() ([]*sqlast.LockingClause, error) {
+	var locks []*sqlast.LockingClause
+
+	for {
+		ok, f, _ := p.parseKeyword("FOR")
+		if !ok {
+			break
+		}
+		lock := &sqlast.LockingClause{For: f.From}
+
+		if ok, t, _ := p.parseKeyword("UPDATE"); ok {
+			lock.Strength = sqlast.ForUpdate
+			lock.To = t.To
+		} else if ok, toks, _ := p.parseKeywords("NO", "KEY", "UPDATE"); ok {
+			lock.Strength = sqlast.ForNoKeyUpdate
+			lock.To = toks[2].To
+		} else if ok, t, _ := p.parseKeyword("SHARE"); ok {
+			lock.Strength = sqlast.ForShare
+			lock.To = t.To
+		} else if ok, toks, _ := p.parseKeywords("KEY", "SHARE"); ok {
+			lock.Strength = sqlast.ForKeyShare
+			lock.To = toks[1].To
+		} else {
+			return nil, p.unexpectedToken("UPDATE, NO KEY UPDATE, SHARE or KEY SHARE after FOR")
+		}
+
+		if ok, _, _ := p.parseKeyword("OF"); ok {
+			for {
+				name, err := p.parseObjectName()
+				if err != nil {
+					return nil, errors.Errorf("parseObjectName failed: %w", err)
+				}
+				lock.Tables = append(lock.Tables, name)
+				lock.To = name.End()
+				if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+					break
+				}
+			}
+		}
+
+		if ok, t, _ := p.parseKeyword("NOWAIT"); ok {
+			lock.WaitPolicy = sqlast.NoWait
+			lock.To = t.To
+		} else if ok, toks, _ := p.parseKeywords("SKIP", "LOCKED"); ok {
+			lock.WaitPolicy = sqlast.SkipLocked
+			lock.To = toks[1].To
+		}
+
+		locks = append(locks, lock)
+	}
+
+	return locks, nil
+}
+
 func (p *Parser) parseQueryBody(precedence uint8) (sqlast.SQLSetExpr, error) {
 	var expr sqlast.SQLSetExpr
 	if ok, tok, _ := p.parseKeyword("SELECT"); ok {
@@ -335,17 +757,32 @@ func (p *Parser) parseQueryBody(precedence uint8) (sqlast.SQLSetExpr, error) {
 		}
 		s.Select = tok.From
 		expr = s
-	} else if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+	} else if ok, tok, _ := p.parseKeyword("TABLE"); ok {
+		name, err := p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
+		}
+		expr = &sqlast.TableQueryExpr{
+			Table: tok.From,
+			Name:  name,
+		}
+	} else if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.LParen {
+		lparen := p.mustNextToken()
 		subquery, err := p.parseQuery()
 		if err != nil {
 			return nil, errors.Errorf("parseQuery failed: %w", err)
 		}
-		p.expectToken(sqltoken.RParen)
+		if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.RParen {
+			return nil, p.unexpectedToken("RParen")
+		}
+		rparen := p.mustNextToken()
 		expr = &sqlast.QueryExpr{
-			Query: subquery,
+			LParen: lparen.From,
+			RParen: rparen.To,
+			Query:  subquery,
 		}
 	} else {
-		log.Fatal("expect SELECT or subquery in the query body")
+		return nil, p.unexpectedToken("SELECT, TABLE or subquery in the query body")
 	}
 BODY_LOOP:
 	for {
@@ -391,11 +828,11 @@ func (p *Parser) parseSetOperator(token *sqltoken.Token) sqlast.SQLSetOperator {
 	word := token.Value.(*sqltoken.SQLWord)
 	switch word.Keyword {
 	case "UNION":
-		return &sqlast.UnionOperator{}
+		return &sqlast.UnionOperator{From: token.From, To: token.To}
 	case "EXCEPT":
-		return &sqlast.ExceptOperator{}
+		return &sqlast.ExceptOperator{From: token.From, To: token.To}
 	case "INTERSECT":
-		return &sqlast.IntersectOperator{}
+		return &sqlast.IntersectOperator{From: token.From, To: token.To}
 	}
 
 	return nil
@@ -407,10 +844,32 @@ func (p *Parser) parseSelect() (*sqlast.SQLSelect, error) {
 	if err != nil {
 		return nil, errors.Errorf("parseKeyword failed: %w", err)
 	}
+	var distinctOn []sqlast.Node
+	if distinct {
+		if ok, _, _ := p.parseKeyword("ON"); ok {
+			if _, err := p.requireToken(sqltoken.LParen); err != nil {
+				return nil, errors.Errorf("DISTINCT ON: %w", err)
+			}
+			exprs, err := p.parseExprList()
+			if err != nil {
+				return nil, errors.Errorf("parseExprList failed: %w", err)
+			}
+			if _, err := p.requireToken(sqltoken.RParen); err != nil {
+				return nil, errors.Errorf("DISTINCT ON: %w", err)
+			}
+			distinctOn = exprs
+		}
+	}
 	projection, err := p.parseSelectList()
 	if err != nil {
 		return nil, errors.Errorf("parseSelectList failed: %w", err)
 	}
+
+	into, err := p.parseSelectInto()
+	if err != nil {
+		return nil, errors.Errorf("parseSelectInto failed: %w", err)
+	}
+
 	var tableRefs []sqlast.TableReference
 
 	if ok, _, _ := p.parseKeyword("FROM"); ok {
@@ -449,7 +908,9 @@ func (p *Parser) parseSelect() (*sqlast.SQLSelect, error) {
 
 	return &sqlast.SQLSelect{
 		Distinct:      distinct,
+		DistinctOn:    distinctOn,
 		Projection:    projection,
+		Into:          into,
 		WhereClause:   selection,
 		FromClause:    tableRefs,
 		GroupByClause: groupBy,
@@ -458,6 +919,32 @@ func (p *Parser) parseSelect() (*sqlast.SQLSelect, error) {
 
 }
 
+// parseSelectInto parses optional `INTO [ TEMPORARY | TEMP | UNLOGGED ] [ TABLE ] Name` of SELECT.
+func (p *Parser) parseSelectInto() (*sqlast.SelectInto, error) {
+	ok, into, _ := p.parseKeyword("INTO")
+	if !ok {
+		return nil, nil
+	}
+
+	s := &sqlast.SelectInto{Into: into.From}
+	if ok, _, _ := p.parseKeyword("TEMPORARY"); ok {
+		s.Temporary = true
+	} else if ok, _, _ := p.parseKeyword("TEMP"); ok {
+		s.Temporary = true
+	} else if ok, _, _ := p.parseKeyword("UNLOGGED"); ok {
+		s.Unlogged = true
+	}
+	s.Table, _, _ = p.parseKeyword("TABLE")
+
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+	s.Name = name
+
+	return s, nil
+}
+
 func (p *Parser) parseSelectList() ([]sqlast.SQLSelectItem, error) {
 	var projections []sqlast.SQLSelectItem
 
@@ -466,21 +953,46 @@ func (p *Parser) parseSelectList() ([]sqlast.SQLSelectItem, error) {
 		if err != nil {
 			return nil, errors.Errorf("ParseExpr failed: %w", err)
 		}
-		if w, ok := expr.(*sqlast.WildcardSelectItem); ok {
-			projections = append(projections, w)
+		if w, ok := expr.(*sqlast.Wildcard); ok {
+			exclude, replace, err := p.parseWildcardModifiers()
+			if err != nil {
+				return nil, errors.Errorf("parseWildcardModifiers failed: %w", err)
+			}
+			if exclude == nil && replace == nil {
+				projections = append(projections, &sqlast.UnnamedSelectItem{
+					Node: w,
+				})
+			} else {
+				projections = append(projections, &sqlast.WildcardSelectItem{
+					From:    w.Pos(),
+					To:      w.End(),
+					Exclude: exclude,
+					Replace: replace,
+				})
+			}
 		} else if q, ok := expr.(*sqlast.QualifiedWildcard); ok {
+			exclude, replace, err := p.parseWildcardModifiers()
+			if err != nil {
+				return nil, errors.Errorf("parseWildcardModifiers failed: %w", err)
+			}
 			projections = append(projections, &sqlast.QualifiedWildcardSelectItem{
 				Prefix: &sqlast.ObjectName{
 					Idents: q.Idents,
 				},
+				Exclude: exclude,
+				Replace: replace,
 			})
 		} else {
-			alias := p.parseOptionalAlias(dialect.ReservedForColumnAlias)
+			alias, implicit, err := p.parseOptionalAlias(dialect.ReservedForColumnAlias)
+			if err != nil {
+				return nil, errors.Errorf("parseOptionalAlias failed: %w", err)
+			}
 
 			if alias != nil {
 				projections = append(projections, &sqlast.AliasSelectItem{
-					Expr:  expr,
-					Alias: alias,
+					Expr:          expr,
+					Alias:         alias,
+					ImplicitAlias: implicit,
 				})
 			} else {
 				projections = append(projections, &sqlast.UnnamedSelectItem{
@@ -489,8 +1001,11 @@ func (p *Parser) parseSelectList() ([]sqlast.SQLSelectItem, error) {
 			}
 		}
 
-		if t, _ := p.peekToken(); t.Kind == sqltoken.Comma {
+		if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.Comma {
 			p.mustNextToken()
+			if p.trailingComma() {
+				break
+			}
 		} else {
 			break
 		}
@@ -498,33 +1013,238 @@ func (p *Parser) parseSelectList() ([]sqlast.SQLSelectItem, error) {
 	return projections, nil
 }
 
-func (p *Parser) parseCreate() (sqlast.Stmt, error) {
-	ok, t, _ := p.parseKeyword("CREATE")
-	if !ok {
-		return nil, errors.Errorf("expect CREATE but %+v", t)
-	}
-	if ok, _, _ := p.parseKeyword("TABLE"); ok {
-		return p.parseCreateTable(t)
+// parseWildcardModifiers parses optional `EXCLUDE (col, ...)` and
+// `REPLACE (expr AS col, ...)` after a wildcard in the select list.
+func (p *Parser) parseWildcardModifiers() (*sqlast.ExcludeColumns, *sqlast.ReplaceColumns, error) {
+	var exclude *sqlast.ExcludeColumns
+	var replace *sqlast.ReplaceColumns
+
+	if ok, tok, _ := p.parseKeyword("EXCLUDE"); ok {
+		if !p.dialect.Supports(dialect.WildcardModifiers) {
+			return nil, nil, errors.Errorf("EXCLUDE of wildcard is only supported in generic SQL dialect")
+		}
+		if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.LParen {
+			return nil, nil, p.unexpectedToken("LParen after EXCLUDE")
+		}
+		p.mustNextToken()
+		columns, err := p.parseColumnNames()
+		if err != nil {
+			return nil, nil, errors.Errorf("parseColumnNames failed: %w", err)
+		}
+		r, _ := p.peekToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, nil, p.unexpectedToken("RParen")
+		}
+		p.mustNextToken()
+		exclude = &sqlast.ExcludeColumns{
+			Exclude: tok.From,
+			Columns: columns,
+			RParen:  r.To,
+		}
 	}
 
-	mok, _, _ := p.parseKeyword("MATERIALIZED")
-	vok, _, _ := p.parseKeyword("VIEW")
+	if ok, tok, _ := p.parseKeyword("REPLACE"); ok {
+		if !p.dialect.Supports(dialect.WildcardModifiers) {
+			return nil, nil, errors.Errorf("REPLACE of wildcard is only supported in generic SQL dialect")
+		}
+		if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.LParen {
+			return nil, nil, p.unexpectedToken("LParen after REPLACE")
+		}
+		p.mustNextToken()
+
+		var items []*sqlast.AliasSelectItem
+		for {
+			expr, err := p.ParseExpr()
+			if err != nil {
+				return nil, nil, errors.Errorf("ParseExpr failed: %w", err)
+			}
+			if ok, t, _ := p.parseKeyword("AS"); !ok {
+				return nil, nil, errors.Errorf("expected AS in REPLACE but %v", t)
+			}
+			alias, err := p.parseIdentifier()
+			if err != nil {
+				return nil, nil, errors.Errorf("parseIdentifier failed: %w", err)
+			}
+			items = append(items, &sqlast.AliasSelectItem{
+				Expr:  expr,
+				Alias: alias,
+			})
+			if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+				break
+			}
+		}
+		r, _ := p.peekToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, nil, p.unexpectedToken("RParen")
+		}
+		p.mustNextToken()
+		replace = &sqlast.ReplaceColumns{
+			Replace: tok.From,
+			Items:   items,
+			RParen:  r.To,
+		}
+	}
+
+	return exclude, replace, nil
+}
+
+func (p *Parser) parseCreate() (sqlast.Stmt, error) {
+	ok, t, _ := p.parseKeyword("CREATE")
+	if !ok {
+		return nil, errors.Errorf("expect CREATE but %+v", t)
+	}
+	if ok, _, _ := p.parseKeyword("TABLE"); ok {
+		return p.parseCreateTable(t)
+	}
 
-	if mok || vok {
+	if ok, _, _ := p.parseKeyword("MATERIALIZED"); ok {
 		p.prevToken()
 		return p.parseCreateView(t)
 	}
+	if ok, _, _ := p.parseKeyword("VIEW"); ok {
+		p.prevToken()
+		return p.parseCreateView(t)
+	}
+
+	if ok, _, _ := p.parseKeyword("SEQUENCE"); ok {
+		return p.parseCreateSequence(t)
+	}
 
 	iok, _, _ := p.parseKeyword("INDEX")
 	uiok, _, _ := p.parseKeywords("UNIQUE", "INDEX")
 
 	if iok || uiok {
-		return p.parseCreateIndex(uiok)
+		return p.parseCreateIndex(t, uiok)
+	}
+
+	tok, err := p.peekToken()
+	if err != nil {
+		return nil, errors.Errorf("expected TABLE, VIEW, SEQUENCE or INDEX after CREATE: %w", err)
 	}
+	return nil, errors.Errorf("expected TABLE, VIEW, SEQUENCE or INDEX after CREATE but %+v", tok)
+}
 
-	log.Fatal("TABLE or VIEW or UNIQUE INDEX or INDEX after create")
+func (p *Parser) parseCreateSequence(create *sqltoken.Token) (sqlast.Stmt, error) {
+	notExists, _, _ := p.parseKeywords("IF", "NOT", "EXISTS")
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
 
-	return nil, nil
+	var options []*sqlast.SequenceOption
+	for {
+		opt, err := p.parseSequenceOption()
+		if err != nil {
+			return nil, errors.Errorf("parseSequenceOption failed: %w", err)
+		}
+		if opt == nil {
+			break
+		}
+		options = append(options, opt)
+	}
+
+	return &sqlast.CreateSequenceStmt{
+		Create:    create.From,
+		NotExists: notExists,
+		Name:      name,
+		Options:   options,
+	}, nil
+}
+
+// parseSequenceOption returns nil if no option follows.
+func (p *Parser) parseSequenceOption() (*sqlast.SequenceOption, error) {
+	tok, _ := p.peekToken()
+	if tok == nil || tok.Kind != sqltoken.SQLKeyword {
+		return nil, nil
+	}
+
+	var kind sqlast.SequenceOptionKind
+	switch tok.Value.(*sqltoken.SQLWord).Keyword {
+	case "START":
+		p.mustNextToken()
+		p.parseKeyword("WITH")
+		kind = sqlast.SequenceStart
+	case "INCREMENT":
+		p.mustNextToken()
+		p.parseKeyword("BY")
+		kind = sqlast.SequenceIncrement
+	case "MINVALUE":
+		p.mustNextToken()
+		kind = sqlast.SequenceMinValue
+	case "MAXVALUE":
+		p.mustNextToken()
+		kind = sqlast.SequenceMaxValue
+	case "CACHE":
+		p.mustNextToken()
+		kind = sqlast.SequenceCache
+	case "CYCLE":
+		p.mustNextToken()
+		return &sqlast.SequenceOption{
+			Kind: sqlast.SequenceCycle,
+			From: tok.From,
+			To:   tok.To,
+		}, nil
+	case "NO":
+		p.mustNextToken()
+		n, err := p.nextToken()
+		if err != nil {
+			return nil, errors.Errorf("nextToken failed: %w", err)
+		}
+		w, ok := n.Value.(*sqltoken.SQLWord)
+		if !ok {
+			return nil, errors.Errorf("expected MINVALUE, MAXVALUE or CYCLE after NO but %+v", n)
+		}
+		switch w.Keyword {
+		case "MINVALUE":
+			kind = sqlast.SequenceMinValue
+		case "MAXVALUE":
+			kind = sqlast.SequenceMaxValue
+		case "CYCLE":
+			kind = sqlast.SequenceNoCycle
+		default:
+			return nil, errors.Errorf("expected MINVALUE, MAXVALUE or CYCLE after NO but %+v", n)
+		}
+		return &sqlast.SequenceOption{
+			Kind: kind,
+			From: tok.From,
+			To:   n.To,
+		}, nil
+	case "OWNED":
+		p.mustNextToken()
+		if _, err := p.requireKeyword("BY"); err != nil {
+			return nil, errors.Errorf("OWNED: %w", err)
+		}
+		if ok, none, _ := p.parseKeyword("NONE"); ok {
+			return &sqlast.SequenceOption{
+				Kind: sqlast.SequenceOwnedBy,
+				From: tok.From,
+				To:   none.To,
+			}, nil
+		}
+		column, err := p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
+		}
+		return &sqlast.SequenceOption{
+			Kind:  sqlast.SequenceOwnedBy,
+			Value: column,
+			From:  tok.From,
+			To:    column.End(),
+		}, nil
+	default:
+		return nil, nil
+	}
+
+	value, err := p.ParseExpr()
+	if err != nil {
+		return nil, errors.Errorf("ParseExpr failed: %w", err)
+	}
+	return &sqlast.SequenceOption{
+		Kind:  kind,
+		Value: value,
+		From:  tok.From,
+		To:    value.End(),
+	}, nil
 }
 
 func (p *Parser) parseCreateTable(create *sqltoken.Token) (sqlast.Stmt, error) {
@@ -534,6 +1254,17 @@ func (p *Parser) parseCreateTable(create *sqltoken.Token) (sqlast.Stmt, error) {
 		return nil, errors.Errorf("parseObjectName failed: %w", err)
 	}
 
+	if columns, ok := p.parseCreateTableAsColumns(); ok {
+		stmt, err := p.parseCreateTableAs(columns)
+		if err != nil {
+			return nil, errors.Errorf("parseCreateTableAs failed: %w", err)
+		}
+		stmt.Create = create.From
+		stmt.NotExists = notExists
+		stmt.Name = name
+		return stmt, nil
+	}
+
 	elements, err := p.parseElements()
 	if err != nil {
 		return nil, errors.Errorf("parseElements failed: %w", err)
@@ -553,29 +1284,154 @@ func (p *Parser) parseCreateTable(create *sqltoken.Token) (sqlast.Stmt, error) {
 	}, nil
 }
 
+// parseCreateTableAsColumns reads the optional column names and AS of CREATE TABLE AS.
+// It reports false without consuming tokens if the statement is not CREATE TABLE AS.
+func (p *Parser) parseCreateTableAsColumns() ([]*sqlast.Ident, bool) {
+	idx := p.index
+
+	var columns []*sqlast.Ident
+	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		c, err := p.parseColumnNames()
+		if err != nil {
+			p.index = idx
+			return nil, false
+		}
+		if ok, _ := p.consumeToken(sqltoken.RParen); !ok {
+			p.index = idx
+			return nil, false
+		}
+		columns = c
+	}
+	if ok, _, _ := p.parseKeyword("AS"); !ok {
+		p.index = idx
+		return nil, false
+	}
+	return columns, true
+}
+
+// parseCreateTableAs parses `Query [ WITH [ NO ] DATA ]` after AS of CREATE TABLE AS.
+func (p *Parser) parseCreateTableAs(columns []*sqlast.Ident) (*sqlast.CreateTableStmt, error) {
+	q, err := p.parseQuery()
+	if err != nil {
+		return nil, errors.Errorf("parseQuery failed: %w", err)
+	}
+	stmt := &sqlast.CreateTableStmt{
+		Columns: columns,
+		Query:   q,
+	}
+
+	if ok, toks, _ := p.parseKeywords("WITH", "DATA"); ok {
+		withData := true
+		stmt.WithData = &withData
+		stmt.DataEnd = toks[1].To
+	} else if ok, toks, _ := p.parseKeywords("WITH", "NO", "DATA"); ok {
+		withData := false
+		stmt.WithData = &withData
+		stmt.DataEnd = toks[2].To
+	}
+
+	return stmt, nil
+}
+
 func (p *Parser) parseCreateView(create *sqltoken.Token) (sqlast.Stmt, error) {
 	materialized, _, _ := p.parseKeyword("MATERIALIZED")
-	p.expectKeyword("VIEW")
+	if _, err := p.requireKeyword("VIEW"); err != nil {
+		return nil, err
+	}
 	name, err := p.parseObjectName()
 	if err != nil {
 		return nil, errors.Errorf("parseObjectName failed: %w", err)
 	}
-	p.expectKeyword("AS")
+
+	var options []*sqlast.ViewOption
+	if ok, _, _ := p.parseKeyword("WITH"); ok {
+		if !p.dialect.Supports(dialect.ViewOptions) {
+			return nil, errors.Errorf("options of view are only supported in PostgreSQL dialect")
+		}
+		o, err := p.parseViewOptions()
+		if err != nil {
+			return nil, errors.Errorf("parseViewOptions failed: %w", err)
+		}
+		options = o
+	}
+
+	if _, err := p.requireKeyword("AS"); err != nil {
+		return nil, err
+	}
 	q, err := p.parseQuery()
 	if err != nil {
 		return nil, errors.Errorf("parseQuery failed: %w", err)
 	}
 
+	checkOption := sqlast.ViewCheckOptionNone
+	var checkOptionEnd sqltoken.Pos
+	if ok, _, _ := p.parseKeyword("WITH"); ok {
+		checkOption = sqlast.ViewCheckOptionDefault
+		if ok, _, _ := p.parseKeyword("CASCADED"); ok {
+			checkOption = sqlast.ViewCheckOptionCascaded
+		} else if ok, _, _ := p.parseKeyword("LOCAL"); ok {
+			checkOption = sqlast.ViewCheckOptionLocal
+		}
+		ok, toks, _ := p.parseKeywords("CHECK", "OPTION")
+		if !ok {
+			return nil, p.unexpectedToken("CHECK OPTION")
+		}
+		if materialized {
+			return nil, errors.Errorf("CHECK OPTION is not allowed for materialized views")
+		}
+		checkOptionEnd = toks[1].To
+	}
+
 	return &sqlast.CreateViewStmt{
-		Create:       create.From,
-		Materialized: materialized,
-		Name:         name,
-		Query:        q,
+		Create:         create.From,
+		Materialized:   materialized,
+		Name:           name,
+		Options:        options,
+		Query:          q,
+		CheckOption:    checkOption,
+		CheckOptionEnd: checkOptionEnd,
 	}, nil
 
 }
 
-func (p *Parser) parseCreateIndex(unique bool) (sqlast.Stmt, error) {
+// parseViewOptions parses ( name [ = value ], ... ) after WITH of CREATE VIEW.
+func (p *Parser) parseViewOptions() ([]*sqlast.ViewOption, error) {
+	if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.LParen {
+		return nil, p.unexpectedToken("LParen after WITH")
+	}
+	p.mustNextToken()
+
+	var options []*sqlast.ViewOption
+	for {
+		name, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		option := &sqlast.ViewOption{
+			Name: name,
+		}
+		if ok, _ := p.consumeToken(sqltoken.Eq); ok {
+			v, err := p.ParseExpr()
+			if err != nil {
+				return nil, errors.Errorf("ParseExpr failed: %w", err)
+			}
+			option.Value = v
+		}
+		options = append(options, option)
+
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			break
+		}
+	}
+
+	if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.RParen {
+		return nil, p.unexpectedToken("RParen")
+	}
+	p.mustNextToken()
+	return options, nil
+}
+
+func (p *Parser) parseCreateIndex(create *sqltoken.Token, unique bool) (sqlast.Stmt, error) {
 	var indexName *sqlast.Ident
 	ok, _, _ := p.parseKeyword("ON")
 	if !ok {
@@ -584,7 +1440,9 @@ func (p *Parser) parseCreateIndex(unique bool) (sqlast.Stmt, error) {
 		} else {
 			indexName = n
 		}
-		p.expectKeyword("ON")
+		if _, err := p.requireKeyword("ON"); err != nil {
+			return nil, err
+		}
 	}
 
 	tableName, err := p.parseObjectName()
@@ -601,13 +1459,17 @@ func (p *Parser) parseCreateIndex(unique bool) (sqlast.Stmt, error) {
 		methodName = m
 	}
 
-	var columns []*sqlast.Ident
+	var columns []*sqlast.IndexColumn
+	var rparen sqltoken.Pos
 	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
-		columns, err = p.parseColumnNames()
+		columns, err = p.parseIndexColumns()
 		if err != nil {
-			return nil, errors.Errorf("parseColumnNames failed: %w", err)
+			return nil, errors.Errorf("parseIndexColumns failed: %w", err)
 		}
-		p.expectToken(sqltoken.RParen)
+		if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.RParen {
+			return nil, p.unexpectedToken("RParen")
+		}
+		rparen = p.mustNextToken().To
 	}
 
 	var selection sqlast.Node
@@ -620,15 +1482,102 @@ func (p *Parser) parseCreateIndex(unique bool) (sqlast.Stmt, error) {
 	}
 
 	return &sqlast.CreateIndexStmt{
-		IsUnique:    unique,
-		IndexName:   indexName,
-		TableName:   tableName,
-		MethodName:  methodName,
-		ColumnNames: columns,
-		Selection:   selection,
+		Create:     create.From,
+		IsUnique:   unique,
+		IndexName:  indexName,
+		TableName:  tableName,
+		MethodName: methodName,
+		Columns:    columns,
+		RParen:     rparen,
+		Selection:  selection,
 	}, nil
 }
 
+// parseIndexColumns parses `{ Name | Expr } [ OpClass ] [ ASC | DESC ] [ NULLS { FIRST | LAST } ], ...`
+// of CREATE INDEX, where Expr is a function call or an expression in parentheses.
+func (p *Parser) parseIndexColumns() ([]*sqlast.IndexColumn, error) {
+	var columns []*sqlast.IndexColumn
+	for {
+		if t, _ := p.peekToken(); t == nil || (t.Kind != sqltoken.SQLKeyword && t.Kind != sqltoken.LParen) {
+			return nil, p.unexpectedToken("column or expression of index")
+		}
+		expr, err := p.parsePrefix()
+		if err != nil {
+			return nil, errors.Errorf("parsePrefix failed: %w", err)
+		}
+		column := &sqlast.IndexColumn{}
+		switch e := expr.(type) {
+		case *sqlast.Ident:
+			column.Name = e
+		case *sqlast.Function, *sqlast.Nested:
+			column.Expr = e
+		default:
+			return nil, errors.Errorf("expected column, function call or expression in parentheses of index but %s", expr.ToSQLString())
+		}
+
+		column.OpClass = p.parseIndexOpClass()
+
+		if ok, tok, _ := p.parseKeyword("ASC"); ok {
+			b := true
+			column.ASC = &b
+			column.OrderingPos = tok.To
+		} else if ok, tok, _ := p.parseKeyword("DESC"); ok {
+			b := false
+			column.ASC = &b
+			column.OrderingPos = tok.To
+		}
+
+		if ok, _, _ := p.parseKeyword("NULLS"); ok {
+			var first bool
+			if ok, tok, _ := p.parseKeyword("FIRST"); ok {
+				first = true
+				column.NullsPos = tok.To
+			} else if ok, tok, _ := p.parseKeyword("LAST"); ok {
+				column.NullsPos = tok.To
+			} else {
+				return nil, p.unexpectedToken("FIRST or LAST after NULLS")
+			}
+			column.NullsFirst = &first
+		}
+		columns = append(columns, column)
+
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			return columns, nil
+		}
+	}
+}
+
+// parseIndexOpClass parses the operator class after a column of CREATE INDEX.
+// A name is taken as an operator class only if it is followed by ASC, DESC,
+// NULLS, `,` or `)`, and nil is returned without consuming tokens otherwise.
+func (p *Parser) parseIndexOpClass() *sqlast.ObjectName {
+	t, _ := p.peekToken()
+	if t == nil || t.Kind != sqltoken.SQLKeyword {
+		return nil
+	}
+	switch t.Value.(*sqltoken.SQLWord).Keyword {
+	case "ASC", "DESC", "NULLS":
+		return nil
+	}
+
+	idx := p.index
+	opClass, err := p.parseObjectName()
+	if err == nil {
+		next, _ := p.peekToken()
+		switch {
+		case next != nil && (next.Kind == sqltoken.Comma || next.Kind == sqltoken.RParen):
+			return opClass
+		case next != nil && next.Kind == sqltoken.SQLKeyword:
+			switch next.Value.(*sqltoken.SQLWord).Keyword {
+			case "ASC", "DESC", "NULLS":
+				return opClass
+			}
+		}
+	}
+	p.index = idx
+	return nil
+}
+
 func (p *Parser) parseElements() ([]sqlast.TableElement, error) {
 	var elements []sqlast.TableElement
 	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
@@ -636,8 +1585,11 @@ func (p *Parser) parseElements() ([]sqlast.TableElement, error) {
 	}
 
 	for {
-		tok, _ := p.nextToken()
-		if tok == nil || tok.Kind != sqltoken.SQLKeyword {
+		tok, err := p.nextToken()
+		if err != nil {
+			return nil, errors.Errorf("expected column definition: %w", err)
+		}
+		if tok.Kind != sqltoken.SQLKeyword {
 			return nil, errors.Errorf("parse error after column def %+v", tok)
 		}
 
@@ -661,9 +1613,12 @@ func (p *Parser) parseElements() ([]sqlast.TableElement, error) {
 			elements = append(elements, def)
 		}
 
-		t, _ := p.nextToken()
-		if t == nil || (t.Kind != sqltoken.Comma && t.Kind != sqltoken.RParen) {
-			log.Fatalf("Expected ',' or ')' after column definition but %v", t)
+		t, err := p.nextToken()
+		if err != nil {
+			return nil, errors.Errorf("expected ',' or ')' after column definition: %w", err)
+		}
+		if t.Kind != sqltoken.Comma && t.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected ',' or ')' after column definition but %+v", t)
 		} else if t.Kind == sqltoken.RParen {
 			break
 		}
@@ -673,36 +1628,66 @@ func (p *Parser) parseElements() ([]sqlast.TableElement, error) {
 }
 
 func (p *Parser) parseColumnDef() (*sqlast.ColumnDef, error) {
-	tok := p.mustNextToken()
-	columnName := tok.Value.(*sqltoken.SQLWord)
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
 
 	dataType, err := p.ParseDataType()
 	if err != nil {
 		return nil, errors.Errorf("ParseDataType failed: %w", err)
 	}
 
-	def, specs, decorates, err := p.parseColumnDefinition()
-	if err != nil {
+	col := &sqlast.ColumnDef{
+		Name:     name,
+		DataType: dataType,
+	}
+	if err := p.parseColumnDefinition(col); err != nil {
 		return nil, errors.Errorf("parseColumnDefinition: %w", err)
 	}
 
-	return &sqlast.ColumnDef{
-		Constraints: specs,
-		Name: &sqlast.Ident{
-			From:  tok.From,
-			To:    tok.To,
-			Value: columnName.String(),
-		},
-		MyDataTypeDecoration: decorates,
-		DataType:             dataType,
-		Default:              def,
-	}, nil
+	return col, nil
+}
+
+// parseColumnCharset parses `CHARACTER SET name` or `CHARSET name` after
+// the data type of a column. It returns nil if neither of them follows.
+func (p *Parser) parseColumnCharset() (*sqlast.ColumnCharset, error) {
+	tok, _ := p.peekToken()
+	if tok == nil {
+		return nil, nil
+	}
+	word, ok := tok.Value.(*sqltoken.SQLWord)
+	if !ok || word.QuoteStyle != 0 || (word.Keyword != "CHARACTER" && word.Keyword != "CHARSET") {
+		return nil, nil
+	}
+	if !p.dialect.Supports(dialect.ColumnCharset) {
+		return nil, errors.Errorf("%s of column is only supported in MySQL dialect", word.Keyword)
+	}
+	p.mustNextToken()
+
+	charset := &sqlast.ColumnCharset{
+		Character: tok.From,
+		Short:     word.Keyword == "CHARSET",
+	}
+	if !charset.Short {
+		if ok, t, _ := p.parseKeyword("SET"); !ok {
+			return nil, errors.Errorf("expected SET but %v", t)
+		}
+	}
+
+	n, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+	charset.Name = n
+
+	return charset, nil
 }
 
 func (p *Parser) parseTableConstraints() (*sqlast.TableConstraint, error) {
 	tok, _ := p.peekToken()
 	if tok == nil || tok.Kind != sqltoken.SQLKeyword {
-		return nil, errors.Errorf("parse error after column def")
+		return nil, p.unexpectedToken("table constraint")
 	}
 
 	word, ok := tok.Value.(*sqltoken.SQLWord)
@@ -729,7 +1714,9 @@ func (p *Parser) parseTableConstraints() (*sqlast.TableConstraint, error) {
 		if _, _, err := p.parseKeyword("KEY"); err != nil {
 			return nil, errors.Errorf("parseKeyword failed: %w", err)
 		}
-		p.expectToken(sqltoken.LParen)
+		if _, err := p.requireToken(sqltoken.LParen); err != nil {
+			return nil, err
+		}
 		columns, err := p.parseColumnNames()
 		if err != nil {
 			return nil, errors.Errorf("parseColumnNames failed: %w", err)
@@ -745,8 +1732,12 @@ func (p *Parser) parseTableConstraints() (*sqlast.TableConstraint, error) {
 		}
 	case "PRIMARY":
 		p.mustNextToken()
-		p.expectKeyword("KEY")
-		p.expectToken(sqltoken.LParen)
+		if _, err := p.requireKeyword("KEY"); err != nil {
+			return nil, err
+		}
+		if _, err := p.requireToken(sqltoken.LParen); err != nil {
+			return nil, err
+		}
 		columns, err := p.parseColumnNames()
 		if err != nil {
 			return nil, errors.Errorf("parseColumnNames failed: %w", err)
@@ -763,18 +1754,28 @@ func (p *Parser) parseTableConstraints() (*sqlast.TableConstraint, error) {
 		}
 	case "FOREIGN":
 		p.mustNextToken()
-		p.expectKeyword("KEY")
-		p.expectToken(sqltoken.LParen)
+		if _, err := p.requireKeyword("KEY"); err != nil {
+			return nil, err
+		}
+		if _, err := p.requireToken(sqltoken.LParen); err != nil {
+			return nil, err
+		}
 		columns, err := p.parseColumnNames()
 		if err != nil {
 			return nil, errors.Errorf("parseColumnNames failed: %w", err)
 		}
-		p.expectToken(sqltoken.RParen)
-		p.expectKeyword("REFERENCES")
+		if _, err := p.requireToken(sqltoken.RParen); err != nil {
+			return nil, err
+		}
+		if _, err := p.requireKeyword("REFERENCES"); err != nil {
+			return nil, err
+		}
 
 		t, _ := p.nextToken()
 		w := t.Value.(*sqltoken.SQLWord)
-		p.expectToken(sqltoken.LParen)
+		if _, err := p.requireToken(sqltoken.LParen); err != nil {
+			return nil, err
+		}
 		refcolumns, err := p.parseColumnNames()
 		r, _ := p.nextToken()
 		if r.Kind != sqltoken.RParen {
@@ -797,7 +1798,9 @@ func (p *Parser) parseTableConstraints() (*sqlast.TableConstraint, error) {
 		}
 	case "CHECK":
 		p.mustNextToken()
-		p.expectToken(sqltoken.LParen)
+		if _, err := p.requireToken(sqltoken.LParen); err != nil {
+			return nil, err
+		}
 		expr, err := p.ParseExpr()
 		if err != nil {
 			return nil, errors.Errorf("ParseExpr failed: %w", err)
@@ -823,11 +1826,9 @@ func (p *Parser) parseTableConstraints() (*sqlast.TableConstraint, error) {
 }
 
 // TODO rethink mysql create table AST
-func (p *Parser) parseColumnDefinition() (sqlast.Node, []*sqlast.ColumnConstraint, []sqlast.MyDataTypeDecoration, error) {
-	var specs []*sqlast.ColumnConstraint
-	var def sqlast.Node
-	var decorates []sqlast.MyDataTypeDecoration
-
+// parseColumnDefinition parses the attributes of col after its data type,
+// which may be written in any order.
+func (p *Parser) parseColumnDefinition(col *sqlast.ColumnDef) error {
 COLUMN_DEF_LOOP:
 	for {
 		t, _ := p.peekToken()
@@ -842,20 +1843,45 @@ COLUMN_DEF_LOOP:
 			if ok, _, _ := p.parseKeyword("DEFAULT"); ok {
 				d, err := p.parseDefaultExpr(0)
 				if err != nil {
-					return nil, nil, nil, errors.Errorf("parseDefaultExpr failed: %w", err)
+					return errors.Errorf("parseDefaultExpr failed: %w", err)
 				}
-				def = d
+				col.Default = d
 				continue
 			}
-		case "CONSTRAINT", "NOT", "UNIQUE", "PRIMARY", "REFERENCES", "CHECK":
+		case "CHARACTER", "CHARSET":
+			if col.Charset != nil {
+				return errors.Errorf("character set of column %s is specified twice at %v", col.Name.ToSQLString(), t.From)
+			}
+			charset, err := p.parseColumnCharset()
+			if err != nil {
+				return errors.Errorf("parseColumnCharset failed: %w", err)
+			}
+			if charset == nil {
+				break COLUMN_DEF_LOOP
+			}
+			col.Charset = charset
+		case "COLLATE":
+			if col.Collation != nil {
+				return errors.Errorf("collation of column %s is specified twice at %v", col.Name.ToSQLString(), t.From)
+			}
+			p.mustNextToken()
+			n, err := p.parseIdentifier()
+			if err != nil {
+				return errors.Errorf("parseIdentifier failed: %w", err)
+			}
+			col.Collation = &sqlast.ColumnCollation{
+				Collate: t.From,
+				Name:    n,
+			}
+		case "CONSTRAINT", "NOT", "UNIQUE", "PRIMARY", "REFERENCES", "CHECK", "GENERATED":
 			s, err := p.parseColumnConstraints()
 			if err != nil {
-				return nil, nil, nil, errors.Errorf("parseColumnConstraints failed: %w", err)
+				return errors.Errorf("parseColumnConstraints failed: %w", err)
 			}
-			specs = s
+			col.Constraints = append(col.Constraints, s...)
 		case "AUTO_INCREMENT":
 			p.mustNextToken()
-			decorates = append(decorates, &sqlast.AutoIncrement{
+			col.MyDataTypeDecoration = append(col.MyDataTypeDecoration, &sqlast.AutoIncrement{
 				Auto:      t.From,
 				Increment: t.To,
 			})
@@ -863,7 +1889,7 @@ COLUMN_DEF_LOOP:
 			break COLUMN_DEF_LOOP
 		}
 	}
-	return def, specs, decorates, nil
+	return nil
 }
 
 func (p *Parser) parseColumnConstraints() ([]*sqlast.ColumnConstraint, error) {
@@ -903,7 +1929,7 @@ CONSTRAINT_LOOP:
 			p.mustNextToken()
 			ok, ntok, _ := p.parseKeyword("NULL")
 			if !ok {
-				return nil, errors.Errorf("expected NULL but +%v", ntok)
+				return nil, p.unexpectedToken("NULL after NOT")
 			}
 			spec = &sqlast.NotNullColumnSpec{
 				Not:  tok.From,
@@ -927,24 +1953,33 @@ CONSTRAINT_LOOP:
 			if err != nil {
 				return nil, errors.Errorf("parseObjectName failed: %w", err)
 			}
-			p.expectToken(sqltoken.LParen)
-			columns, err := p.parseColumnNames()
-			if err != nil {
-				return nil, errors.Errorf("parseColumnNames failed: %w", err)
-			}
-			r, _ := p.nextToken()
-			if r.Kind != sqltoken.RParen {
-				return nil, errors.Errorf("expected RParen but %+v", r)
-			}
-			spec = &sqlast.ReferencesColumnSpec{
+			ref := &sqlast.ReferencesColumnSpec{
 				TableName:  tname,
-				Columns:    columns,
 				References: tok.From,
-				RParen:     r.To,
+				To:         tname.End(),
+			}
+			if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+				columns, err := p.parseColumnNames()
+				if err != nil {
+					return nil, errors.Errorf("parseColumnNames failed: %w", err)
+				}
+				r, _ := p.nextToken()
+				if r.Kind != sqltoken.RParen {
+					return nil, errors.Errorf("expected RParen but %+v", r)
+				}
+				ref.Columns = columns
+				ref.RParen = r.To
+				ref.To = r.To
 			}
+			if err := p.parseReferentialOptions(ref); err != nil {
+				return nil, errors.Errorf("parseReferentialOptions failed: %w", err)
+			}
+			spec = ref
 		case "CHECK":
 			p.mustNextToken()
-			p.expectToken(sqltoken.LParen)
+			if _, err := p.requireToken(sqltoken.LParen); err != nil {
+				return nil, err
+			}
 			expr, err := p.ParseExpr()
 			if err != nil {
 				return nil, errors.Errorf("ParseExpr failed: %w", err)
@@ -958,6 +1993,13 @@ CONSTRAINT_LOOP:
 				Expr:   expr,
 				RParen: r.To,
 			}
+		case "GENERATED":
+			p.mustNextToken()
+			g, err := p.parseGeneratedColumnSpec(tok)
+			if err != nil {
+				return nil, errors.Errorf("parseGeneratedColumnSpec failed: %w", err)
+			}
+			spec = g
 		default:
 			break CONSTRAINT_LOOP
 		}
@@ -971,6 +2013,140 @@ CONSTRAINT_LOOP:
 	return constraints, nil
 }
 
+// parseGeneratedColumnSpec parses the rest of a generated column
+// `GENERATED ALWAYS AS (expr) [STORED | VIRTUAL]` or an identity column
+// `GENERATED { ALWAYS | BY DEFAULT } AS IDENTITY [(sequence options)]`.
+func (p *Parser) parseGeneratedColumnSpec(generated *sqltoken.Token) (sqlast.ColumnConstraintSpec, error) {
+	var byDefault bool
+	if ok, _, _ := p.parseKeywords("BY", "DEFAULT"); ok {
+		byDefault = true
+	} else if ok, t, _ := p.parseKeyword("ALWAYS"); !ok {
+		return nil, errors.Errorf("expected ALWAYS or BY DEFAULT after GENERATED but %v", t)
+	}
+	if ok, t, _ := p.parseKeyword("AS"); !ok {
+		return nil, errors.Errorf("expected AS but %v", t)
+	}
+
+	if ok, identity, _ := p.parseKeyword("IDENTITY"); ok {
+		spec := &sqlast.IdentityColumnSpec{
+			Generated: generated.From,
+			ByDefault: byDefault,
+			To:        identity.To,
+		}
+		if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+			return spec, nil
+		}
+		for {
+			opt, err := p.parseSequenceOption()
+			if err != nil {
+				return nil, errors.Errorf("parseSequenceOption failed: %w", err)
+			}
+			if opt == nil {
+				break
+			}
+			spec.Options = append(spec.Options, opt)
+		}
+		if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.RParen {
+			return nil, p.unexpectedToken("RParen")
+		}
+		spec.To = p.mustNextToken().To
+		return spec, nil
+	}
+	if byDefault {
+		return nil, p.unexpectedToken("IDENTITY after GENERATED BY DEFAULT AS")
+	}
+
+	if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.LParen {
+		return nil, p.unexpectedToken("LParen")
+	}
+	p.mustNextToken()
+	expr, err := p.ParseExpr()
+	if err != nil {
+		return nil, errors.Errorf("ParseExpr failed: %w", err)
+	}
+	r, _ := p.peekToken()
+	if r == nil || r.Kind != sqltoken.RParen {
+		return nil, p.unexpectedToken("RParen")
+	}
+	p.mustNextToken()
+
+	spec := &sqlast.GeneratedColumnSpec{
+		Generated: generated.From,
+		Expr:      expr,
+		RParen:    r.To,
+		To:        r.To,
+	}
+	if ok, t, _ := p.parseKeyword("STORED"); ok {
+		spec.Storage = sqlast.GeneratedStored
+		spec.To = t.To
+	} else if ok, t, _ := p.parseKeyword("VIRTUAL"); ok {
+		spec.Storage = sqlast.GeneratedVirtual
+		spec.To = t.To
+	}
+	return spec, nil
+}
+
+func (p *Parser) parseReferentialOptions(ref *sqlast.ReferencesColumnSpec) error {
+	if ok, _, _ := p.parseKeyword("MATCH"); ok {
+		if ok, t, _ := p.parseKeyword("FULL"); ok {
+			ref.Match = sqlast.MatchFull
+			ref.To = t.To
+		} else if ok, t, _ := p.parseKeyword("PARTIAL"); ok {
+			ref.Match = sqlast.MatchPartial
+			ref.To = t.To
+		} else if ok, t, _ := p.parseKeyword("SIMPLE"); ok {
+			ref.Match = sqlast.MatchSimple
+			ref.To = t.To
+		} else {
+			t, _ := p.peekToken()
+			return errors.Errorf("expected FULL, PARTIAL or SIMPLE after MATCH but %+v", t)
+		}
+	}
+
+	for {
+		if ok, _, _ := p.parseKeywords("ON", "DELETE"); ok {
+			action, to, err := p.parseReferentialAction()
+			if err != nil {
+				return errors.Errorf("parseReferentialAction failed: %w", err)
+			}
+			ref.OnDelete = action
+			ref.To = to
+		} else if ok, _, _ := p.parseKeywords("ON", "UPDATE"); ok {
+			action, to, err := p.parseReferentialAction()
+			if err != nil {
+				return errors.Errorf("parseReferentialAction failed: %w", err)
+			}
+			ref.OnUpdate = action
+			ref.To = to
+		} else {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (p *Parser) parseReferentialAction() (sqlast.ReferentialAction, sqltoken.Pos, error) {
+	if ok, t, _ := p.parseKeyword("CASCADE"); ok {
+		return sqlast.ReferentialCascade, t.To, nil
+	}
+	if ok, t, _ := p.parseKeyword("RESTRICT"); ok {
+		return sqlast.ReferentialRestrict, t.To, nil
+	}
+	if ok, toks, _ := p.parseKeywords("NO", "ACTION"); ok {
+		return sqlast.ReferentialNoAction, toks[1].To, nil
+	}
+	if ok, toks, _ := p.parseKeywords("SET", "NULL"); ok {
+		return sqlast.ReferentialSetNull, toks[1].To, nil
+	}
+	if ok, toks, _ := p.parseKeywords("SET", "DEFAULT"); ok {
+		return sqlast.ReferentialSetDefault, toks[1].To, nil
+	}
+
+	t, _ := p.peekToken()
+	return sqlast.NoReferentialAction, sqltoken.Pos{}, errors.Errorf("expected CASCADE, RESTRICT, NO ACTION, SET NULL or SET DEFAULT but %+v", t)
+}
+
 func (p *Parser) parseTableOptions() ([]sqlast.TableOption, error) {
 	var opts []sqlast.TableOption
 
@@ -1082,24 +2258,42 @@ func (p *Parser) parseDelete() (sqlast.Stmt, error) {
 		return nil, errors.Errorf("expect DELETE but %+v", d)
 	}
 
-	p.expectKeyword("FROM")
+	if ok, _, _ := p.parseKeyword("FROM"); !ok {
+		return nil, p.unexpectedToken("FROM")
+	}
 	tableName, err := p.parseObjectName()
 	if err != nil {
 		return nil, errors.Errorf("parseObjectName failed: %w", err)
 	}
 
+	var using []sqlast.TableReference
+	if ok, _, _ := p.parseKeyword("USING"); ok {
+		using, err = p.parseFromClause()
+		if err != nil {
+			return nil, errors.Errorf("parseFromClause failed: %w", err)
+		}
+	}
+
 	var selection sqlast.Node
 	if ok, _, _ := p.parseKeyword("WHERE"); ok {
-		selection, err = p.ParseExpr()
+		selection, err = p.parsePositionedSelection()
 		if err != nil {
-			return nil, errors.Errorf("ParseExpr failed: %w", err)
+			return nil, errors.Errorf("parsePositionedSelection failed: %w", err)
 		}
 	}
 
+	returning, returningInto, err := p.parseReturning()
+	if err != nil {
+		return nil, errors.Errorf("parseReturning failed: %w", err)
+	}
+
 	return &sqlast.DeleteStmt{
-		Delete:    d.From,
-		TableName: tableName,
-		Selection: selection,
+		Delete:        d.From,
+		TableName:     tableName,
+		Using:         using,
+		Selection:     selection,
+		Returning:     returning,
+		ReturningInto: returningInto,
 	}, nil
 }
 
@@ -1112,7 +2306,9 @@ func (p *Parser) parseUpdate() (sqlast.Stmt, error) {
 	if err != nil {
 		return nil, errors.Errorf("parseObjectName failed: %w", err)
 	}
-	p.expectKeyword("SET")
+	if _, err := p.requireKeyword("SET"); err != nil {
+		return nil, err
+	}
 
 	assignments, err := p.parseAssignments()
 	if err != nil {
@@ -1121,33 +2317,59 @@ func (p *Parser) parseUpdate() (sqlast.Stmt, error) {
 
 	var selection sqlast.Node
 	if ok, _, _ := p.parseKeyword("WHERE"); ok {
-		selection, err = p.ParseExpr()
+		selection, err = p.parsePositionedSelection()
 		if err != nil {
-			return nil, errors.Errorf("ParseExpr failed: %w", err)
+			return nil, errors.Errorf("parsePositionedSelection failed: %w", err)
 		}
 	}
 
+	returning, returningInto, err := p.parseReturning()
+	if err != nil {
+		return nil, errors.Errorf("parseReturning failed: %w", err)
+	}
+
 	return &sqlast.UpdateStmt{
-		Update:      u.From,
-		TableName:   tableName,
-		Assignments: assignments,
-		Selection:   selection,
+		Update:        u.From,
+		TableName:     tableName,
+		Assignments:   assignments,
+		Selection:     selection,
+		Returning:     returning,
+		ReturningInto: returningInto,
 	}, nil
 
 }
 
+// parsePositionedSelection parses the condition of UPDATE and DELETE,
+// which may be CURRENT OF cursor in PostgreSQL.
+func (p *Parser) parsePositionedSelection() (sqlast.Node, error) {
+	if p.dialect.Supports(dialect.PositionedUpdate) {
+		if ok, toks, _ := p.parseKeywords("CURRENT", "OF"); ok {
+			cursor, err := p.parseIdentifier()
+			if err != nil {
+				return nil, errors.Errorf("parseIdentifier failed: %w", err)
+			}
+			return &sqlast.CurrentOf{
+				Current: toks[0].From,
+				Cursor:  cursor,
+			}, nil
+		}
+	}
+
+	return p.ParseExpr()
+}
+
 func (p *Parser) parseAssignments() ([]*sqlast.Assignment, error) {
 	var assignments []*sqlast.Assignment
 
 	for {
-		tok, _ := p.nextToken()
-		if tok.Kind != sqltoken.SQLKeyword {
-			return nil, errors.Errorf("should be sqlkeyword but %v", tok)
+		id, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
 		}
 
-		word := tok.Value.(*sqltoken.SQLWord)
-
-		p.expectToken(sqltoken.Eq)
+		if _, err := p.requireToken(sqltoken.Eq); err != nil {
+			return nil, err
+		}
 
 		val, err := p.ParseExpr()
 		if err != nil {
@@ -1155,7 +2377,7 @@ func (p *Parser) parseAssignments() ([]*sqlast.Assignment, error) {
 		}
 
 		assignments = append(assignments, &sqlast.Assignment{
-			ID:    sqlast.NewIdentWithPos(word.String(), tok.From, tok.To),
+			ID:    id,
 			Value: val,
 		})
 
@@ -1173,7 +2395,14 @@ func (p *Parser) parseInsert() (sqlast.Stmt, error) {
 		return nil, errors.Errorf("expected INSERT but %+v", i)
 	}
 
-	p.expectKeyword("INTO")
+	// INTO is optional in MySQL
+	var implicitInto bool
+	if ok, _, _ := p.parseKeyword("INTO"); !ok {
+		if !p.dialect.Supports(dialect.OptionalInsertInto) {
+			return nil, p.unexpectedToken("INTO")
+		}
+		implicitInto = true
+	}
 	tableName, err := p.parseObjectName()
 
 	if err != nil {
@@ -1186,11 +2415,18 @@ func (p *Parser) parseInsert() (sqlast.Stmt, error) {
 		if err != nil {
 			return nil, errors.Errorf("invalid column names: %w", err)
 		}
-		p.expectToken(sqltoken.RParen)
+		if _, err := p.requireToken(sqltoken.RParen); err != nil {
+			return nil, err
+		}
 	}
 
 	var insertSrc sqlast.InsertSource
-	if ok, _, _ := p.parseKeyword("VALUES"); !ok {
+	if ok, toks, _ := p.parseKeywords("DEFAULT", "VALUES"); ok {
+		insertSrc = &sqlast.DefaultValuesSource{
+			Default: toks[0].From,
+			Values:  toks[1].To,
+		}
+	} else if ok, _, _ := p.parseKeyword("VALUES"); !ok {
 		q, err := p.parseQuery()
 		if err != nil {
 			return nil, errors.Errorf("invalid select source: expected query: %w", err)
@@ -1201,16 +2437,16 @@ func (p *Parser) parseInsert() (sqlast.Stmt, error) {
 	} else {
 		var constSrc sqlast.ConstructorSource
 		for {
-			l, _ := p.nextToken()
-			if l.Kind != sqltoken.LParen {
-				return nil, errors.Errorf("expected LParen but %+v", l)
+			l, err := p.requireToken(sqltoken.LParen)
+			if err != nil {
+				return nil, errors.Errorf("invalid insert values: %w", err)
 			}
 			v, err := p.parseExprList()
 			if err != nil {
 				return nil, errors.Errorf("invalid insert value assign: %w", err)
 			}
 			r, _ := p.nextToken()
-			if r.Kind != sqltoken.RParen {
+			if r == nil || r.Kind != sqltoken.RParen {
 				return nil, errors.Errorf("expected RParen but %+v", r)
 			}
 			constSrc.Rows = append(constSrc.Rows, &sqlast.RowValueExpr{
@@ -1235,22 +2471,88 @@ func (p *Parser) parseInsert() (sqlast.Stmt, error) {
 		assigns = assignments
 	}
 
+	returning, returningInto, err := p.parseReturning()
+	if err != nil {
+		return nil, errors.Errorf("parseReturning failed: %w", err)
+	}
+
 	return &sqlast.InsertStmt{
 		Insert:            i.From,
+		ImplicitInto:      implicitInto,
 		TableName:         tableName,
 		Columns:           columns,
 		Source:            insertSrc,
 		UpdateAssignments: assigns,
+		Returning:         returning,
+		ReturningInto:     returningInto,
+	}, nil
+}
+
+// parseReturning parses optional RETURNING clause of INSERT, UPDATE and DELETE,
+// and `INTO variables` after it which is used in PL/pgSQL and PL/SQL.
+func (p *Parser) parseReturning() ([]sqlast.SQLSelectItem, *sqlast.ReturningInto, error) {
+	if ok, _, _ := p.parseKeyword("RETURNING"); !ok {
+		return nil, nil, nil
+	}
+
+	items, err := p.parseSelectList()
+	if err != nil {
+		return nil, nil, errors.Errorf("parseSelectList failed: %w", err)
+	}
+
+	ok, into, _ := p.parseKeyword("INTO")
+	if !ok {
+		return items, nil, nil
+	}
+	if !p.dialect.Supports(dialect.ReturningInto) {
+		return nil, nil, errors.Errorf("RETURNING ... INTO is only supported in PostgreSQL and Oracle dialects")
+	}
+	strict, _, _ := p.parseKeyword("STRICT")
+
+	var targets []sqlast.Node
+	for {
+		target, err := p.parseReturningTarget()
+		if err != nil {
+			return nil, nil, errors.Errorf("parseReturningTarget failed: %w", err)
+		}
+		targets = append(targets, target)
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			break
+		}
+	}
+	return items, &sqlast.ReturningInto{
+		Into:    into.From,
+		Strict:  strict,
+		Targets: targets,
 	}, nil
 }
 
+// parseReturningTarget parses a variable after RETURNING ... INTO, which is
+// an identifier, a field of a record such as `r.x`, or a bind variable such as `:v`.
+func (p *Parser) parseReturningTarget() (sqlast.Node, error) {
+	if t, _ := p.peekToken(); t == nil || (t.Kind != sqltoken.SQLKeyword && t.Kind != sqltoken.Colon) {
+		return nil, p.unexpectedToken("variable after INTO")
+	}
+	target, err := p.parsePrefix()
+	if err != nil {
+		return nil, errors.Errorf("parsePrefix failed: %w", err)
+	}
+	switch target.(type) {
+	case *sqlast.Ident, *sqlast.CompoundIdent, *sqlast.Placeholder:
+		return target, nil
+	}
+	return nil, errors.Errorf("expected variable after INTO but %s", target.ToSQLString())
+}
+
 func (p *Parser) parseAlter() (sqlast.Stmt, error) {
 	ok, tok, _ := p.parseKeyword("ALTER")
 	if !ok {
 		return nil, errors.Errorf("expected ALTER but %s", tok)
 	}
 
-	p.expectKeyword("TABLE")
+	if _, err := p.requireKeyword("TABLE"); err != nil {
+		return nil, err
+	}
 
 	tableName, err := p.parseObjectName()
 	if err != nil {
@@ -1349,92 +2651,924 @@ func (p *Parser) parseAlter() (sqlast.Stmt, error) {
 
 	}
 
-	t, _ := p.peekToken()
-	return nil, errors.Errorf("unknown alter operation %v", t)
-}
+	if p.dialect.Supports(dialect.AttachPartition) {
+		if ok, toks, _ := p.parseKeywords("ATTACH", "PARTITION"); ok {
+			name, err := p.parseObjectName()
+			if err != nil {
+				return nil, errors.Errorf("parseObjectName failed: %w", err)
+			}
+			bound, err := p.parsePartitionBound()
+			if err != nil {
+				return nil, errors.Errorf("parsePartitionBound failed: %w", err)
+			}
 
-func (p *Parser) parseDrop() (sqlast.Stmt, error) {
-	ok, tok, _ := p.parseKeyword("DROP")
-	if !ok {
-		return nil, errors.Errorf("expected DROP but %s", tok)
+			return &sqlast.AlterTableStmt{
+				Alter:     tok.From,
+				TableName: tableName,
+				Action: &sqlast.PGAttachPartitionTableAction{
+					Attach: toks[0].From,
+					Name:   name,
+					Bound:  bound,
+				},
+			}, nil
+		}
+
+		if ok, toks, _ := p.parseKeywords("DETACH", "PARTITION"); ok {
+			name, err := p.parseObjectName()
+			if err != nil {
+				return nil, errors.Errorf("parseObjectName failed: %w", err)
+			}
+
+			return &sqlast.AlterTableStmt{
+				Alter:     tok.From,
+				TableName: tableName,
+				Action: &sqlast.PGDetachPartitionTableAction{
+					Detach: toks[0].From,
+					Name:   name,
+				},
+			}, nil
+		}
 	}
 
-	ok, _, _ = p.parseKeyword("TABLE")
+	return nil, p.unexpectedToken("alter operation")
+}
+
+func (p *Parser) parsePartitionBound() (*sqlast.PartitionBound, error) {
+	if ok, tok, _ := p.parseKeyword("DEFAULT"); ok {
+		return &sqlast.PartitionBound{
+			From:    tok.From,
+			To:      tok.To,
+			Default: true,
+		}, nil
+	}
 
+	ok, toks, _ := p.parseKeywords("FOR", "VALUES")
 	if !ok {
-		p.expectKeyword("INDEX")
-		idents, err := p.parseColumnNames()
+		return nil, p.unexpectedToken("FOR VALUES or DEFAULT")
+	}
+	bound := &sqlast.PartitionBound{From: toks[0].From}
+
+	if ok, _, _ := p.parseKeyword("IN"); ok {
+		list, rparen, err := p.parseParenthesizedExprList()
 		if err != nil {
-			return nil, errors.Errorf("parseColumnNames of DROP INDEX failed: %w", err)
+			return nil, errors.Errorf("parseParenthesizedExprList failed: %w", err)
 		}
+		bound.In = list
+		bound.To = rparen.To
+		return bound, nil
+	}
 
-		return &sqlast.DropIndexStmt{
-			Drop:       tok.From,
-			IndexNames: idents,
-		}, nil
+	if ok, _, _ := p.parseKeyword("FROM"); !ok {
+		return nil, p.unexpectedToken("IN or FROM")
 	}
-	exists, _, _ := p.parseKeywords("IF", "EXISTS")
-	tableName, err := p.parseObjectName()
+	lower, _, err := p.parseParenthesizedExprList()
 	if err != nil {
-		return nil, errors.Errorf("parseObjectName failed: %w", err)
+		return nil, errors.Errorf("parseParenthesizedExprList failed: %w", err)
 	}
-
-	var caspos sqltoken.Pos
-	cascade, t, _ := p.parseKeyword("CASCADE")
-	if cascade {
-		caspos = t.To
+	if ok, _, _ := p.parseKeyword("TO"); !ok {
+		return nil, p.unexpectedToken("TO")
+	}
+	upper, rparen, err := p.parseParenthesizedExprList()
+	if err != nil {
+		return nil, errors.Errorf("parseParenthesizedExprList failed: %w", err)
 	}
+	bound.Lower = lower
+	bound.Upper = upper
+	bound.To = rparen.To
 
-	return &sqlast.DropTableStmt{
-		Drop:       tok.From,
-		TableNames: []*sqlast.ObjectName{tableName},
-		Cascade:    cascade,
-		IfExists:   exists,
-		CascadePos: caspos,
-	}, nil
+	return bound, nil
 }
 
-func (p *Parser) parseAlterColumn(alt *sqltoken.Token) (*sqlast.AlterColumnTableAction, error) {
-	columnName, err := p.parseIdentifier()
+// parseParenthesizedExprList parses `(expr, ...)` and returns the list with the closing parenthesis.
+func (p *Parser) parseParenthesizedExprList() ([]sqlast.Node, *sqltoken.Token, error) {
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		t, _ := p.peekToken()
+		return nil, nil, errors.Errorf("expected %s but %v", sqltoken.LParen, t)
+	}
+	list, err := p.parseExprList()
 	if err != nil {
-		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		return nil, nil, errors.Errorf("parseExprList failed: %w", err)
+	}
+	if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.RParen {
+		return nil, nil, p.unexpectedToken("RParen")
 	}
 
-	tok := p.mustNextToken()
-	if tok.Kind != sqltoken.SQLKeyword {
-		return nil, errors.Errorf("must be SQLKeyword but: %v", tok)
+	return list, p.mustNextToken(), nil
+}
+
+func (p *Parser) parseCopy() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("COPY")
+	if !ok {
+		return nil, errors.Errorf("expected COPY but %s", tok)
 	}
 
-	word := tok.Value.(*sqltoken.SQLWord)
+	stmt := &sqlast.CopyStmt{
+		Copy: tok.From,
+	}
 
-	switch word.Keyword {
-	case "SET":
-		if ok, _, _ := p.parseKeyword("DEFAULT"); ok {
-			def, err := p.parseDefaultExpr(0)
+	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		q, err := p.parseQuery()
+		if err != nil {
+			return nil, errors.Errorf("parseQuery failed: %w", err)
+		}
+		if _, err := p.requireToken(sqltoken.RParen); err != nil {
+			return nil, err
+		}
+		stmt.Query = q
+	} else {
+		name, err := p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
+		}
+		stmt.TableName = name
+		if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+			columns, err := p.parseColumnNames()
 			if err != nil {
-				return nil, errors.Errorf("parseDefaultExpr failed: %w", err)
+				return nil, errors.Errorf("parseColumnNames failed: %w", err)
 			}
-			return &sqlast.AlterColumnTableAction{
-				ColumnName: columnName,
-				Alter:      alt.From,
-				Action: &sqlast.SetDefaultColumnAction{
-					Set:     tok.From,
-					Default: def,
-				},
-			}, nil
-		}
-		if ok, toks, _ := p.parseKeywords("NOT", "NULL"); ok {
-			return &sqlast.AlterColumnTableAction{
-				ColumnName: columnName,
-				Alter:      alt.From,
-				Action: &sqlast.PGSetNotNullColumnAction{
-					Set:  tok.From,
-					Null: toks[1].To,
-				},
-			}, nil
+			if _, err := p.requireToken(sqltoken.RParen); err != nil {
+				return nil, err
+			}
+			stmt.Columns = columns
 		}
+	}
 
-		return nil, errors.Errorf("unknown SET action")
+	if ok, _, _ := p.parseKeyword("TO"); ok {
+		stmt.To = true
+	} else if ok, _, _ := p.parseKeyword("FROM"); !ok {
+		return nil, p.unexpectedToken("FROM or TO")
+	}
+
+	t, err := p.nextToken()
+	if err != nil {
+		return nil, errors.Errorf("nextToken failed: %w", err)
+	}
+	switch t.Kind {
+	case sqltoken.SingleQuotedString:
+		stmt.Target = &sqlast.SingleQuotedString{
+			From:   t.From,
+			To:     t.To,
+			String: t.Value.(string),
+		}
+	case sqltoken.SQLKeyword:
+		word := t.Value.(*sqltoken.SQLWord)
+		if word.Keyword != "STDIN" && word.Keyword != "STDOUT" {
+			return nil, errors.Errorf("expected STDIN, STDOUT or file name but %+v", t)
+		}
+		stmt.Target = &sqlast.Ident{
+			Value: word.String(),
+			From:  t.From,
+			To:    t.To,
+		}
+	default:
+		return nil, errors.Errorf("expected STDIN, STDOUT or file name but %+v", t)
+	}
+
+	p.parseKeyword("WITH")
+	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		for {
+			name, err := p.parseIdentifier()
+			if err != nil {
+				return nil, errors.Errorf("parseIdentifier failed: %w", err)
+			}
+			opt := &sqlast.CopyOption{Name: name}
+			if t, _ := p.peekToken(); t != nil && t.Kind != sqltoken.Comma && t.Kind != sqltoken.RParen {
+				v, err := p.parsePrefix()
+				if err != nil {
+					return nil, errors.Errorf("parsePrefix failed: %w", err)
+				}
+				opt.Value = v
+			}
+			stmt.Options = append(stmt.Options, opt)
+
+			if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+				break
+			}
+		}
+		r, _ := p.nextToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", r)
+		}
+		stmt.RParen = r.To
+	}
+
+	return stmt, nil
+}
+
+func (p *Parser) parsePrepare() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("PREPARE")
+	if !ok {
+		return nil, errors.Errorf("expected PREPARE but %s", tok)
+	}
+
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+
+	var types []sqlast.Type
+	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		for {
+			t, err := p.ParseDataType()
+			if err != nil {
+				return nil, errors.Errorf("ParseDataType failed: %w", err)
+			}
+			types = append(types, t)
+			if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+				break
+			}
+		}
+		if _, err := p.requireToken(sqltoken.RParen); err != nil {
+			return nil, errors.Errorf("PREPARE: %w", err)
+		}
+	}
+
+	if _, err := p.requireKeyword("AS"); err != nil {
+		return nil, errors.Errorf("PREPARE: %w", err)
+	}
+
+	stmt, err := p.ParseStatement()
+	if err != nil {
+		return nil, errors.Errorf("ParseStatement failed: %w", err)
+	}
+
+	return &sqlast.PrepareStmt{
+		Prepare:    tok.From,
+		Name:       name,
+		ParamTypes: types,
+		Stmt:       stmt,
+	}, nil
+}
+
+func (p *Parser) parseExecute() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("EXECUTE")
+	if !ok {
+		return nil, errors.Errorf("expected EXECUTE but %s", tok)
+	}
+
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+
+	stmt := &sqlast.ExecuteStmt{
+		Execute: tok.From,
+		Name:    name,
+	}
+
+	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		args, err := p.parseOptionalArgs()
+		if err != nil {
+			return nil, errors.Errorf("parseOptionalArgs failed: %w", err)
+		}
+		r, _ := p.nextToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", r)
+		}
+		stmt.Args = args
+		stmt.RParen = r.To
+	}
+
+	return stmt, nil
+}
+
+func (p *Parser) parseDeallocate() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("DEALLOCATE")
+	if !ok {
+		return nil, errors.Errorf("expected DEALLOCATE but %s", tok)
+	}
+	p.parseKeyword("PREPARE")
+
+	if ok, all, _ := p.parseKeyword("ALL"); ok {
+		return &sqlast.DeallocateStmt{
+			Deallocate: tok.From,
+			All:        true,
+			AllPos:     all.To,
+		}, nil
+	}
+
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+
+	return &sqlast.DeallocateStmt{
+		Deallocate: tok.From,
+		Name:       name,
+	}, nil
+}
+
+func (p *Parser) parseDeclareCursor() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("DECLARE")
+	if !ok {
+		return nil, errors.Errorf("expected DECLARE but %s", tok)
+	}
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+
+	stmt := &sqlast.DeclareCursorStmt{
+		Declare: tok.From,
+		Name:    name,
+	}
+	stmt.Binary, _, _ = p.parseKeyword("BINARY")
+	if ok, _, _ := p.parseKeyword("ASENSITIVE"); ok {
+		stmt.Asensitive = true
+	} else if ok, _, _ := p.parseKeyword("INSENSITIVE"); ok {
+		stmt.Insensitive = true
+	}
+	if ok, _, _ := p.parseKeywords("NO", "SCROLL"); ok {
+		scroll := false
+		stmt.Scroll = &scroll
+	} else if ok, _, _ := p.parseKeyword("SCROLL"); ok {
+		scroll := true
+		stmt.Scroll = &scroll
+	}
+
+	if ok, t, _ := p.parseKeyword("CURSOR"); !ok {
+		return nil, errors.Errorf("expected CURSOR but %+v", t)
+	}
+	if ok, _, _ := p.parseKeywords("WITH", "HOLD"); ok {
+		hold := true
+		stmt.Hold = &hold
+	} else if ok, _, _ := p.parseKeywords("WITHOUT", "HOLD"); ok {
+		hold := false
+		stmt.Hold = &hold
+	}
+	if ok, t, _ := p.parseKeyword("FOR"); !ok {
+		return nil, errors.Errorf("expected FOR but %+v", t)
+	}
+
+	q, err := p.parseQuery()
+	if err != nil {
+		return nil, errors.Errorf("parseQuery failed: %w", err)
+	}
+	stmt.Query = q
+
+	return stmt, nil
+}
+
+var cursorDirections = map[string]sqlast.CursorDirection{
+	"NEXT":     sqlast.CursorNext,
+	"PRIOR":    sqlast.CursorPrior,
+	"FIRST":    sqlast.CursorFirst,
+	"LAST":     sqlast.CursorLast,
+	"ABSOLUTE": sqlast.CursorAbsolute,
+	"RELATIVE": sqlast.CursorRelative,
+	"ALL":      sqlast.CursorAll,
+	"FORWARD":  sqlast.CursorForward,
+	"BACKWARD": sqlast.CursorBackward,
+}
+
+// parseFetchCursor parses FETCH and MOVE, which differ only in the keyword.
+func (p *Parser) parseFetchCursor() (sqlast.Stmt, error) {
+	tok := p.mustNextToken()
+	stmt := &sqlast.FetchStmt{
+		Fetch: tok.From,
+		Move:  tok.Value.(*sqltoken.SQLWord).Keyword == "MOVE",
+	}
+
+	t, _ := p.peekToken()
+	if t == nil {
+		return nil, p.unexpectedToken("cursor name")
+	}
+	switch t.Kind {
+	case sqltoken.Number, sqltoken.Minus:
+		count, err := p.parseCursorCount()
+		if err != nil {
+			return nil, errors.Errorf("parseCursorCount failed: %w", err)
+		}
+		stmt.Direction = sqlast.CursorCount
+		stmt.Count = count
+	case sqltoken.SQLKeyword:
+		if d, ok := cursorDirections[t.Value.(*sqltoken.SQLWord).Keyword]; ok {
+			p.mustNextToken()
+			stmt.Direction = d
+		}
+	}
+
+	switch stmt.Direction {
+	case sqlast.CursorAbsolute, sqlast.CursorRelative:
+		count, err := p.parseCursorCount()
+		if err != nil {
+			return nil, errors.Errorf("parseCursorCount failed: %w", err)
+		}
+		stmt.Count = count
+	case sqlast.CursorForward, sqlast.CursorBackward:
+		if ok, _, _ := p.parseKeyword("ALL"); ok {
+			stmt.All = true
+		} else if t, _ := p.peekToken(); t != nil && (t.Kind == sqltoken.Number || t.Kind == sqltoken.Minus) {
+			count, err := p.parseCursorCount()
+			if err != nil {
+				return nil, errors.Errorf("parseCursorCount failed: %w", err)
+			}
+			stmt.Count = count
+		}
+	}
+
+	if ok, _, _ := p.parseKeyword("FROM"); !ok {
+		p.parseKeyword("IN")
+	}
+
+	cursor, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+	stmt.Cursor = cursor
+
+	return stmt, nil
+}
+
+// parseCursorCount parses a signed integer of FETCH and MOVE.
+func (p *Parser) parseCursorCount() (sqlast.Node, error) {
+	if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.Minus {
+		minus := p.mustNextToken()
+		v, err := p.parseValue()
+		if err != nil {
+			return nil, errors.Errorf("parseValue failed: %w", err)
+		}
+		return &sqlast.UnaryExpr{
+			From: minus.From,
+			Op:   &sqlast.Operator{Type: sqlast.Minus, From: minus.From, To: minus.To},
+			Expr: v,
+		}, nil
+	}
+	v, err := p.parseValue()
+	if err != nil {
+		return nil, errors.Errorf("parseValue failed: %w", err)
+	}
+	return v, nil
+}
+
+func (p *Parser) parseClose() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("CLOSE")
+	if !ok {
+		return nil, errors.Errorf("expected CLOSE but %s", tok)
+	}
+
+	if ok, all, _ := p.parseKeyword("ALL"); ok {
+		return &sqlast.CloseStmt{
+			Close:  tok.From,
+			All:    true,
+			AllPos: all.To,
+		}, nil
+	}
+
+	cursor, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+
+	return &sqlast.CloseStmt{
+		Close:  tok.From,
+		Cursor: cursor,
+	}, nil
+}
+
+func (p *Parser) parseBegin() (sqlast.Stmt, error) {
+	stmt := &sqlast.BeginStmt{}
+	if ok, toks, _ := p.parseKeywords("START", "TRANSACTION"); ok {
+		stmt.Begin = toks[0].From
+		stmt.Start = true
+		stmt.To = toks[1].To
+	} else if ok, tok, _ := p.parseKeyword("BEGIN"); ok {
+		stmt.Begin = tok.From
+		stmt.Word, stmt.To = p.parseTransactionWord(tok.To)
+	} else {
+		return nil, errors.Errorf("expected BEGIN or START TRANSACTION but %v", tok)
+	}
+
+	// modes are separated by commas, or by spaces in PostgreSQL's old syntax
+	var comma bool
+	for {
+		mode, err := p.parseTransactionMode()
+		if err != nil {
+			return nil, errors.Errorf("parseTransactionMode failed: %w", err)
+		}
+		if mode == nil {
+			if comma {
+				return nil, p.unexpectedToken("transaction mode after comma")
+			}
+			break
+		}
+		stmt.Modes = append(stmt.Modes, mode)
+		comma, _ = p.consumeToken(sqltoken.Comma)
+	}
+
+	return stmt, nil
+}
+
+// parseTransactionWord parses optional WORK or TRANSACTION keyword,
+// and returns the last position of it or to if it is omitted.
+func (p *Parser) parseTransactionWord(to sqltoken.Pos) (sqlast.TransactionWord, sqltoken.Pos) {
+	if ok, t, _ := p.parseKeyword("WORK"); ok {
+		return sqlast.TransactionWordWork, t.To
+	}
+	if ok, t, _ := p.parseKeyword("TRANSACTION"); ok {
+		return sqlast.TransactionWordTransaction, t.To
+	}
+	return sqlast.TransactionWordNone, to
+}
+
+var isolationLevels = []struct {
+	keywords []string
+	level    sqlast.IsolationLevel
+}{
+	{keywords: []string{"SERIALIZABLE"}, level: sqlast.Serializable},
+	{keywords: []string{"REPEATABLE", "READ"}, level: sqlast.RepeatableRead},
+	{keywords: []string{"READ", "COMMITTED"}, level: sqlast.ReadCommitted},
+	{keywords: []string{"READ", "UNCOMMITTED"}, level: sqlast.ReadUncommitted},
+}
+
+// parseTransactionMode parses a transaction mode of BEGIN and START TRANSACTION.
+// It returns nil if no mode follows.
+func (p *Parser) parseTransactionMode() (*sqlast.TransactionMode, error) {
+	if ok, toks, _ := p.parseKeywords("ISOLATION", "LEVEL"); ok {
+		for _, l := range isolationLevels {
+			if ok, ls, _ := p.parseKeywords(l.keywords...); ok {
+				return &sqlast.TransactionMode{
+					Kind:  sqlast.IsolationLevelMode,
+					Level: l.level,
+					From:  toks[0].From,
+					To:    ls[len(ls)-1].To,
+				}, nil
+			}
+		}
+		return nil, p.unexpectedToken("isolation level")
+	}
+	if ok, toks, _ := p.parseKeywords("READ", "ONLY"); ok {
+		return &sqlast.TransactionMode{Kind: sqlast.ReadOnlyMode, From: toks[0].From, To: toks[1].To}, nil
+	}
+	if ok, toks, _ := p.parseKeywords("READ", "WRITE"); ok {
+		return &sqlast.TransactionMode{Kind: sqlast.ReadWriteMode, From: toks[0].From, To: toks[1].To}, nil
+	}
+	if ok, tok, _ := p.parseKeyword("DEFERRABLE"); ok {
+		return &sqlast.TransactionMode{Kind: sqlast.DeferrableMode, From: tok.From, To: tok.To}, nil
+	}
+	if ok, toks, _ := p.parseKeywords("NOT", "DEFERRABLE"); ok {
+		return &sqlast.TransactionMode{Kind: sqlast.NotDeferrableMode, From: toks[0].From, To: toks[1].To}, nil
+	}
+	return nil, nil
+}
+
+func (p *Parser) parseCommit() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("COMMIT")
+	if !ok {
+		return nil, errors.Errorf("expected COMMIT but %s", tok)
+	}
+	stmt := &sqlast.CommitStmt{Commit: tok.From}
+	stmt.Word, stmt.To = p.parseTransactionWord(tok.To)
+
+	return stmt, nil
+}
+
+func (p *Parser) parseRollback() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("ROLLBACK")
+	if !ok {
+		return nil, errors.Errorf("expected ROLLBACK but %s", tok)
+	}
+	stmt := &sqlast.RollbackStmt{Rollback: tok.From}
+	stmt.Word, stmt.To = p.parseTransactionWord(tok.To)
+
+	if ok, _, _ := p.parseKeyword("TO"); !ok {
+		return stmt, nil
+	}
+	if ok, _, _ := p.parseKeyword("SAVEPOINT"); ok {
+		stmt.SavepointKeyword = true
+	}
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+	stmt.Savepoint = name
+
+	return stmt, nil
+}
+
+func (p *Parser) parseSavepoint() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("SAVEPOINT")
+	if !ok {
+		return nil, errors.Errorf("expected SAVEPOINT but %s", tok)
+	}
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+
+	return &sqlast.SavepointStmt{
+		Savepoint: tok.From,
+		Name:      name,
+	}, nil
+}
+
+func (p *Parser) parseReleaseSavepoint() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("RELEASE")
+	if !ok {
+		return nil, errors.Errorf("expected RELEASE but %s", tok)
+	}
+	stmt := &sqlast.ReleaseSavepointStmt{Release: tok.From}
+	if ok, _, _ := p.parseKeyword("SAVEPOINT"); ok {
+		stmt.SavepointKeyword = true
+	}
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+	stmt.Name = name
+
+	return stmt, nil
+}
+
+func (p *Parser) parseSet() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("SET")
+	if !ok {
+		return nil, errors.Errorf("expected SET but %s", tok)
+	}
+	stmt := &sqlast.SetStmt{Set: tok.From}
+
+	if ok, _, _ := p.parseKeywords("TIME", "ZONE"); ok {
+		stmt.TimeZone = true
+	} else {
+		name, err := p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
+		}
+		stmt.Name = name
+
+		if ok, _ := p.consumeToken(sqltoken.Eq); ok {
+			stmt.Eq = true
+		} else if ok, _, _ := p.parseKeyword("TO"); !ok {
+			return nil, p.unexpectedToken("TO or =")
+		}
+	}
+
+	if ok, def, _ := p.parseKeyword("DEFAULT"); ok {
+		stmt.Default = true
+		stmt.DefaultPos = def.To
+		return stmt, nil
+	}
+
+	values, err := p.parseExprList()
+	if err != nil {
+		return nil, errors.Errorf("parseExprList failed: %w", err)
+	}
+	stmt.Values = values
+
+	return stmt, nil
+}
+
+func (p *Parser) parseShow() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("SHOW")
+	if !ok {
+		return nil, errors.Errorf("expected SHOW but %s", tok)
+	}
+
+	if ok, toks, _ := p.parseKeywords("TIME", "ZONE"); ok {
+		return &sqlast.ShowStmt{
+			Show:     tok.From,
+			TimeZone: true,
+			ZonePos:  toks[1].To,
+		}, nil
+	}
+
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+
+	return &sqlast.ShowStmt{
+		Show: tok.From,
+		Name: name,
+	}, nil
+}
+
+var commentObjectKinds = map[string]sqlast.CommentObjectKind{
+	"TABLE":  sqlast.CommentOnTable,
+	"COLUMN": sqlast.CommentOnColumn,
+	"INDEX":  sqlast.CommentOnIndex,
+	"VIEW":   sqlast.CommentOnView,
+}
+
+func (p *Parser) parseCommentOn() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("COMMENT")
+	if !ok {
+		return nil, p.unexpectedToken("COMMENT")
+	}
+	if ok, _, _ := p.parseKeyword("ON"); !ok {
+		return nil, p.unexpectedToken("ON after COMMENT")
+	}
+
+	if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.SQLKeyword {
+		return nil, p.unexpectedToken("object kind after COMMENT ON")
+	}
+	t := p.mustNextToken()
+	word := t.Value.(*sqltoken.SQLWord)
+	kind, ok := commentObjectKinds[word.Keyword]
+	if !ok || word.QuoteStyle != 0 {
+		return nil, errors.Errorf("unsupported object kind of COMMENT ON: %s", word.Value)
+	}
+
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+	if ok, _, _ := p.parseKeyword("IS"); !ok {
+		return nil, p.unexpectedToken("IS")
+	}
+
+	stmt := &sqlast.CommentStmt{
+		Comment:    tok.From,
+		ObjectKind: kind,
+		Name:       name,
+	}
+	if ok, null, _ := p.parseKeyword("NULL"); ok {
+		stmt.NullPos = null.To
+		return stmt, nil
+	}
+
+	if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.SingleQuotedString {
+		return nil, p.unexpectedToken("comment string or NULL after IS")
+	}
+	t = p.mustNextToken()
+	stmt.Text = &sqlast.SingleQuotedString{
+		From:   t.From,
+		To:     t.To,
+		String: t.Value.(string),
+	}
+
+	return stmt, nil
+}
+
+func (p *Parser) parseReset() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("RESET")
+	if !ok {
+		return nil, errors.Errorf("expected RESET but %s", tok)
+	}
+
+	if ok, toks, _ := p.parseKeywords("TIME", "ZONE"); ok {
+		return &sqlast.ResetStmt{
+			Reset:    tok.From,
+			TimeZone: true,
+			ZonePos:  toks[1].To,
+		}, nil
+	}
+
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+
+	return &sqlast.ResetStmt{
+		Reset: tok.From,
+		Name:  name,
+	}, nil
+}
+
+func (p *Parser) parseDo() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("DO")
+	if !ok {
+		return nil, errors.Errorf("expected DO but %s", tok)
+	}
+	stmt := &sqlast.DoStmt{
+		Do: tok.From,
+	}
+
+	if ok, _, _ := p.parseKeyword("LANGUAGE"); ok {
+		lang, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		stmt.Language = lang
+		stmt.LanguageFirst = true
+	}
+
+	body, err := p.peekToken()
+	if err != nil {
+		return nil, errors.Errorf("expected body of DO: %w", err)
+	}
+	if body.Kind != sqltoken.DollarQuotedString && body.Kind != sqltoken.SingleQuotedString {
+		return nil, errors.Errorf("expected string literal as body of DO but %+v", body)
+	}
+	v, err := p.parseValue()
+	if err != nil {
+		return nil, errors.Errorf("parseValue failed: %w", err)
+	}
+	stmt.Body = v
+
+	if stmt.Language == nil {
+		if ok, _, _ := p.parseKeyword("LANGUAGE"); ok {
+			lang, err := p.parseIdentifier()
+			if err != nil {
+				return nil, errors.Errorf("parseIdentifier failed: %w", err)
+			}
+			stmt.Language = lang
+		}
+	}
+
+	return stmt, nil
+}
+
+func (p *Parser) parseDrop() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("DROP")
+	if !ok {
+		return nil, errors.Errorf("expected DROP but %s", tok)
+	}
+
+	var kind sqlast.DropObjectKind
+	if ok, _, _ := p.parseKeyword("TABLE"); ok {
+		kind = sqlast.DropTable
+	} else if ok, _, _ := p.parseKeyword("VIEW"); ok {
+		kind = sqlast.DropView
+	} else if ok, _, _ := p.parseKeyword("MATERIALIZED"); ok {
+		if _, err := p.requireKeyword("VIEW"); err != nil {
+			return nil, err
+		}
+		kind = sqlast.DropMaterializedView
+	} else if ok, _, _ := p.parseKeyword("INDEX"); ok {
+		kind = sqlast.DropIndex
+	} else {
+		return nil, p.unexpectedToken("TABLE, VIEW, MATERIALIZED VIEW or INDEX after DROP")
+	}
+
+	exists, _, _ := p.parseKeywords("IF", "EXISTS")
+
+	var names []*sqlast.ObjectName
+	for {
+		name, err := p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
+		}
+		names = append(names, name)
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			break
+		}
+	}
+
+	behavior := sqlast.DropBehaviorNone
+	var behaviorPos sqltoken.Pos
+	if ok, t, _ := p.parseKeyword("CASCADE"); ok {
+		behavior = sqlast.DropCascade
+		behaviorPos = t.To
+	} else if ok, t, _ := p.parseKeyword("RESTRICT"); ok {
+		behavior = sqlast.DropRestrict
+		behaviorPos = t.To
+	}
+
+	return &sqlast.DropStmt{
+		Drop:        tok.From,
+		Kind:        kind,
+		IfExists:    exists,
+		Names:       names,
+		Behavior:    behavior,
+		BehaviorPos: behaviorPos,
+	}, nil
+}
+
+func (p *Parser) parseAlterColumn(alt *sqltoken.Token) (*sqlast.AlterColumnTableAction, error) {
+	columnName, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+
+	tok, err := p.nextToken()
+	if err != nil {
+		return nil, errors.Errorf("expected ALTER COLUMN action: %w", err)
+	}
+	if tok.Kind != sqltoken.SQLKeyword {
+		return nil, errors.Errorf("must be SQLKeyword but: %v", tok)
+	}
+
+	word := tok.Value.(*sqltoken.SQLWord)
+
+	switch word.Keyword {
+	case "SET":
+		if ok, _, _ := p.parseKeyword("DEFAULT"); ok {
+			def, err := p.parseDefaultExpr(0)
+			if err != nil {
+				return nil, errors.Errorf("parseDefaultExpr failed: %w", err)
+			}
+			return &sqlast.AlterColumnTableAction{
+				ColumnName: columnName,
+				Alter:      alt.From,
+				Action: &sqlast.SetDefaultColumnAction{
+					Set:     tok.From,
+					Default: def,
+				},
+			}, nil
+		}
+		if ok, toks, _ := p.parseKeywords("NOT", "NULL"); ok {
+			return &sqlast.AlterColumnTableAction{
+				ColumnName: columnName,
+				Alter:      alt.From,
+				Action: &sqlast.PGSetNotNullColumnAction{
+					Set:  tok.From,
+					Null: toks[1].To,
+				},
+			}, nil
+		}
+
+		return nil, p.unexpectedToken("DEFAULT or NOT NULL after SET")
 	case "DROP":
 		if ok, deftok, _ := p.parseKeyword("DEFAULT"); ok {
 			return &sqlast.AlterColumnTableAction{
@@ -1456,19 +3590,29 @@ func (p *Parser) parseAlterColumn(alt *sqltoken.Token) (*sqlast.AlterColumnTable
 				},
 			}, nil
 		}
-		return nil, errors.Errorf("unknown DROP action")
+		return nil, p.unexpectedToken("DEFAULT or NOT NULL after DROP")
 	case "TYPE":
 		tp, err := p.ParseDataType()
 		if err != nil {
 			return nil, errors.Errorf("ParseDataType failed: %w", err)
 		}
 
+		var using sqlast.Node
+		if ok, _, _ := p.parseKeyword("USING"); ok {
+			u, err := p.ParseExpr()
+			if err != nil {
+				return nil, errors.Errorf("ParseExpr failed: %w", err)
+			}
+			using = u
+		}
+
 		return &sqlast.AlterColumnTableAction{
 			ColumnName: columnName,
 			Alter:      alt.From,
 			Action: &sqlast.PGAlterDataTypeColumnAction{
 				Type:     tok.From,
 				DataType: tp,
+				Using:    using,
 			},
 		}, nil
 	default:
@@ -1505,30 +3649,36 @@ func (p *Parser) parseDefaultExpr(precedence uint) (sqlast.Node, error) {
 	return expr, nil
 }
 
-func (p *Parser) parseOptionalAlias(reservedKeywords map[string]struct{}) *sqlast.Ident {
+// parseOptionalAlias parses `[AS] alias` and reports whether AS is omitted.
+// reservedKeywords and reserved keywords of the dialect can't be an alias
+// without AS, but any keyword can be an alias after AS.
+func (p *Parser) parseOptionalAlias(reservedKeywords map[string]struct{}) (*sqlast.Ident, bool, error) {
 	afterAs, _, _ := p.parseKeyword("AS")
 	maybeAlias, _ := p.nextToken()
 
 	if maybeAlias == nil {
-		return nil
+		if afterAs {
+			return nil, false, errors.Errorf("expected an identifier after AS: %w", EOF)
+		}
+		return nil, false, nil
 	}
 
 	if maybeAlias.Kind == sqltoken.SQLKeyword {
-
 		word := maybeAlias.Value.(*sqltoken.SQLWord)
-		if afterAs || !containsStr(reservedKeywords, word.Keyword) {
+		reserved := word.QuoteStyle == 0 && p.dialect.IsReservedKeyword(word.Keyword)
+		if afterAs || (!reserved && !containsStr(reservedKeywords, word.Keyword)) {
 			return &sqlast.Ident{
 				Value: word.String(),
 				From:  maybeAlias.From,
 				To:    maybeAlias.To,
-			}
+			}, !afterAs, nil
 		}
 	}
 	if afterAs {
-		log.Fatalf("expected an identifier after AS")
+		return nil, false, errors.Errorf("expected an identifier after AS but %+v", maybeAlias)
 	}
 	p.prevToken()
-	return nil
+	return nil, false, nil
 }
 
 func (p *Parser) parseCTEList() ([]*sqlast.CTE, error) {
@@ -1539,17 +3689,41 @@ func (p *Parser) parseCTEList() ([]*sqlast.CTE, error) {
 		if err != nil {
 			return nil, errors.Errorf("parseIdentifier failed: %w", err)
 		}
-		p.expectKeyword("AS")
-		p.expectToken(sqltoken.LParen)
-		q, err := p.parseQuery()
-		if err != nil {
-			return nil, errors.Errorf("parseQuery failed: %w", err)
+		if _, err := p.requireKeyword("AS"); err != nil {
+			return nil, err
+		}
+		if _, err := p.requireToken(sqltoken.LParen); err != nil {
+			return nil, err
 		}
-		ctes = append(ctes, &sqlast.CTE{
+
+		cte := &sqlast.CTE{
 			Alias: alias,
-			Query: q,
-		})
-		p.expectToken(sqltoken.RParen)
+		}
+		if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.SQLKeyword && isDataModifyingKeyword(t.Value.(*sqltoken.SQLWord).Keyword) {
+			if !p.dialect.Supports(dialect.DataModifyingCTE) {
+				return nil, errors.Errorf("data-modifying statements in WITH are only supported in PostgreSQL dialect")
+			}
+			stmt, err := p.parseStatement()
+			if err != nil {
+				return nil, errors.Errorf("parseStatement failed: %w", err)
+			}
+			cte.Stmt = stmt
+		} else {
+			q, err := p.parseQuery()
+			if err != nil {
+				return nil, errors.Errorf("parseQuery failed: %w", err)
+			}
+			cte.Query = q
+		}
+
+		r, _ := p.peekToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, p.unexpectedToken("RParen")
+		}
+		p.mustNextToken()
+		cte.RParen = r.To
+		ctes = append(ctes, cte)
+
 		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
 			break
 		}
@@ -1557,6 +3731,16 @@ func (p *Parser) parseCTEList() ([]*sqlast.CTE, error) {
 	return ctes, nil
 }
 
+// isDataModifyingKeyword reports whether keyword starts a statement
+// which can be the body of a data-modifying CTE.
+func isDataModifyingKeyword(keyword string) bool {
+	switch keyword {
+	case "INSERT", "UPDATE", "DELETE":
+		return true
+	}
+	return false
+}
+
 func (p *Parser) parseFromClause() ([]sqlast.TableReference, error) {
 	var res []sqlast.TableReference
 
@@ -1644,7 +3828,9 @@ func (p *Parser) parseTableReferenceRight() (sqlast.TableReference, error) {
 		if err != nil {
 			return nil, errors.Errorf("parse natural join type failed: %w", err)
 		}
-		p.expectKeyword("JOIN")
+		if _, err := p.requireKeyword("JOIN"); err != nil {
+			return nil, err
+		}
 		rightElem, err := p.parseTableReference()
 		if err != nil {
 			return nil, errors.Errorf("parse natural join right element failed: %w", err)
@@ -1657,7 +3843,9 @@ func (p *Parser) parseTableReferenceRight() (sqlast.TableReference, error) {
 			},
 		}, nil
 	case "CROSS":
-		p.expectKeyword("JOIN")
+		if _, err := p.requireKeyword("JOIN"); err != nil {
+			return nil, err
+		}
 		rightElem, err := p.parseTableFactor()
 		if err != nil {
 			return nil, errors.Errorf("parse cross join right element failed: %w", err)
@@ -1667,7 +3855,9 @@ func (p *Parser) parseTableReferenceRight() (sqlast.TableReference, error) {
 			Factor: rightElem,
 		}, nil
 	case "INNER":
-		p.expectKeyword("JOIN")
+		if _, err := p.requireKeyword("JOIN"); err != nil {
+			return nil, err
+		}
 		ref, err := p.parseTableReference()
 		if err != nil {
 			return nil, errors.Errorf("parse inner join right elem filed: %w", err)
@@ -1690,107 +3880,327 @@ func (p *Parser) parseTableReferenceRight() (sqlast.TableReference, error) {
 		if err != nil {
 			return nil, errors.Errorf("parse qualified join type failed: %w", err)
 		}
-		p.expectKeyword("JOIN")
-		ref, err := p.parseTableReference()
+		if _, err := p.requireKeyword("JOIN"); err != nil {
+			return nil, err
+		}
+		ref, err := p.parseTableReference()
+		if err != nil {
+			return nil, errors.Errorf("parse qualified join right elem failed: %w", err)
+		}
+
+		spec, err := p.parseJoinSpec()
+		if err != nil {
+			return nil, errors.Errorf("parse qualified join spec failed: %w", err)
+		}
+
+		return &sqlast.QualifiedJoin{
+			RightElement: &sqlast.TableJoinElement{
+				Ref: ref,
+			},
+			Type: tp,
+			Spec: spec,
+		}, nil
+
+	default:
+		p.prevToken()
+		return nil, nil
+	}
+}
+
+func (p *Parser) parseJoinType() (*sqlast.JoinType, error) {
+	tok, err := p.nextToken()
+	if err != nil {
+		return nil, errors.Errorf("expected join type: %w", err)
+	}
+	word, ok := tok.Value.(*sqltoken.SQLWord)
+	if !ok {
+		return nil, errors.Errorf("unknown join type %v", tok)
+	}
+
+	switch word.Keyword {
+	case "INNER":
+		return &sqlast.JoinType{
+			Condition: sqlast.INNER,
+			From:      tok.From,
+			To:        tok.To,
+		}, nil
+	case "LEFT":
+		outer, _, _ := p.parseKeyword("OUTER")
+		if outer {
+			return &sqlast.JoinType{
+				Condition: sqlast.LEFTOUTER,
+				From:      tok.From,
+				To:        tok.To,
+			}, nil
+		}
+		return &sqlast.JoinType{
+			Condition: sqlast.LEFT,
+			From:      tok.From,
+			To:        tok.To,
+		}, nil
+	case "RIGHT":
+		outer, _, _ := p.parseKeyword("OUTER")
+		if outer {
+			return &sqlast.JoinType{Condition: sqlast.RIGHTOUTER}, nil
+		}
+		return &sqlast.JoinType{Condition: sqlast.RIGHT}, nil
+	case "FULL":
+		outer, _, _ := p.parseKeyword("OUTER")
+		if outer {
+			return &sqlast.JoinType{Condition: sqlast.FULLOUTER}, nil
+		}
+		return &sqlast.JoinType{Condition: sqlast.FULL}, nil
+	case "JOIN":
+		p.prevToken()
+		return &sqlast.JoinType{Condition: sqlast.IMPLICIT}, nil
+	default:
+		return nil, errors.Errorf("unknown join type: %v", word)
+	}
+}
+
+func (p *Parser) parseJoinSpec() (sqlast.JoinSpec, error) {
+	if ok, tok, _ := p.parseKeyword("ON"); ok {
+		expr, err := p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("parse join condition failed: %w", err)
+		}
+		return &sqlast.JoinCondition{
+			SearchCondition: expr,
+			On:              tok.From,
+		}, nil
+	}
+
+	ok, _, _ := p.parseKeyword("USING")
+	if !ok {
+		return nil, p.unexpectedToken("USING or ON of join spec")
+	}
+
+	if _, err := p.requireToken(sqltoken.LParen); err != nil {
+		return nil, err
+	}
+	idents, err := p.parseListOfIds(sqltoken.Comma)
+	if err != nil {
+		return nil, errors.Errorf("parse named columns join list failed: %w", err)
+	}
+	if _, err := p.requireToken(sqltoken.RParen); err != nil {
+		return nil, err
+	}
+
+	return &sqlast.NamedColumnsJoin{
+		ColumnList: idents,
+	}, nil
+}
+
+// isJSONTable reports whether the next tokens are `JSON_TABLE (`.
+func (p *Parser) isJSONTable() bool {
+	idx := p.index
+	defer func() { p.index = idx }()
+
+	tok, _ := p.nextToken()
+	if tok == nil {
+		return false
+	}
+	if w, ok := tok.Value.(*sqltoken.SQLWord); !ok || w.QuoteStyle != 0 || w.Keyword != "JSON_TABLE" {
+		return false
+	}
+	t, _ := p.peekToken()
+	return t != nil && t.Kind == sqltoken.LParen
+}
+
+func (p *Parser) parseJSONTable() (*sqlast.JSONTable, error) {
+	tok := p.mustNextToken()
+	p.mustNextToken() // (
+
+	expr, err := p.ParseExpr()
+	if err != nil {
+		return nil, errors.Errorf("ParseExpr failed: %w", err)
+	}
+	if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected %s but %v", sqltoken.Comma, t)
+	}
+	path, err := p.parseJSONPath()
+	if err != nil {
+		return nil, errors.Errorf("parseJSONPath failed: %w", err)
+	}
+	columns, _, err := p.parseJSONTableColumns()
+	if err != nil {
+		return nil, errors.Errorf("parseJSONTableColumns failed: %w", err)
+	}
+	r, _ := p.peekToken()
+	if r == nil || r.Kind != sqltoken.RParen {
+		return nil, p.unexpectedToken("RParen")
+	}
+	p.mustNextToken()
+
+	alias, implicit, err := p.parseOptionalAlias(dialect.ReservedForTableAlias)
+	if err != nil {
+		return nil, errors.Errorf("parseOptionalAlias failed: %w", err)
+	}
+
+	return &sqlast.JSONTable{
+		JSONTable:     tok.From,
+		Expr:          expr,
+		Path:          path,
+		Columns:       columns,
+		RParen:        r.To,
+		Alias:         alias,
+		ImplicitAlias: implicit,
+	}, nil
+}
+
+func (p *Parser) parseJSONPath() (*sqlast.SingleQuotedString, error) {
+	t, _ := p.nextToken()
+	if t == nil || t.Kind != sqltoken.SingleQuotedString {
+		return nil, errors.Errorf("expected JSON path string but %v", t)
+	}
+	return &sqlast.SingleQuotedString{
+		From:   t.From,
+		To:     t.To,
+		String: t.Value.(string),
+	}, nil
+}
+
+// parseJSONTableColumns parses `COLUMNS (Columns...)` of JSON_TABLE and
+// returns the columns and the last position of the clause.
+func (p *Parser) parseJSONTableColumns() ([]sqlast.JSONTableColumn, sqltoken.Pos, error) {
+	if ok, t, _ := p.parseKeyword("COLUMNS"); !ok {
+		return nil, sqltoken.Pos{}, errors.Errorf("expected COLUMNS but %v", t)
+	}
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		t, _ := p.peekToken()
+		return nil, sqltoken.Pos{}, errors.Errorf("expected %s but %v", sqltoken.LParen, t)
+	}
+
+	var columns []sqlast.JSONTableColumn
+	for {
+		c, err := p.parseJSONTableColumn()
+		if err != nil {
+			return nil, sqltoken.Pos{}, errors.Errorf("parseJSONTableColumn failed: %w", err)
+		}
+		columns = append(columns, c)
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			break
+		}
+	}
+
+	r, _ := p.peekToken()
+	if r == nil || r.Kind != sqltoken.RParen {
+		return nil, sqltoken.Pos{}, errors.Errorf("expected %s but %v", sqltoken.RParen, r)
+	}
+	p.mustNextToken()
+
+	return columns, r.To, nil
+}
+
+func (p *Parser) parseJSONTableColumn() (sqlast.JSONTableColumn, error) {
+	if ok, nested, _ := p.parseKeyword("NESTED"); ok {
+		column := &sqlast.JSONTableNestedColumn{Nested: nested.From}
+		if ok, _, _ := p.parseKeyword("PATH"); ok {
+			column.PathKeyword = true
+		}
+		path, err := p.parseJSONPath()
 		if err != nil {
-			return nil, errors.Errorf("parse qualified join right elem failed: %w", err)
+			return nil, errors.Errorf("parseJSONPath failed: %w", err)
 		}
-
-		spec, err := p.parseJoinSpec()
+		column.Path = path
+		column.Columns, column.RParen, err = p.parseJSONTableColumns()
 		if err != nil {
-			return nil, errors.Errorf("parse qualified join spec failed: %w", err)
+			return nil, errors.Errorf("parseJSONTableColumns failed: %w", err)
 		}
+		return column, nil
+	}
 
-		return &sqlast.QualifiedJoin{
-			RightElement: &sqlast.TableJoinElement{
-				Ref: ref,
-			},
-			Type: tp,
-			Spec: spec,
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+	if ok, toks, _ := p.parseKeywords("FOR", "ORDINALITY"); ok {
+		return &sqlast.JSONTableOrdinalityColumn{
+			Name: name,
+			To:   toks[1].To,
 		}, nil
-
-	default:
-		p.prevToken()
-		return nil, nil
 	}
-}
 
-func (p *Parser) parseJoinType() (*sqlast.JoinType, error) {
-	tok, _ := p.nextToken()
-	word, ok := tok.Value.(*sqltoken.SQLWord)
-	if !ok {
-		return nil, errors.Errorf("unknown join type %v", tok)
+	dataType, err := p.ParseDataType()
+	if err != nil {
+		return nil, errors.Errorf("ParseDataType failed: %w", err)
+	}
+	column := &sqlast.JSONTablePathColumn{
+		Name:     name,
+		DataType: dataType,
+	}
+	if ok, _, _ := p.parseKeyword("EXISTS"); ok {
+		column.Exists = true
+	}
+	if ok, t, _ := p.parseKeyword("PATH"); !ok {
+		return nil, errors.Errorf("expected PATH but %v", t)
+	}
+	if column.Path, err = p.parseJSONPath(); err != nil {
+		return nil, errors.Errorf("parseJSONPath failed: %w", err)
 	}
 
-	switch word.Keyword {
-	case "INNER":
-		return &sqlast.JoinType{
-			Condition: sqlast.INNER,
-			From:      tok.From,
-			To:        tok.To,
-		}, nil
-	case "LEFT":
-		outer, _, _ := p.parseKeyword("OUTER")
-		if outer {
-			return &sqlast.JoinType{
-				Condition: sqlast.LEFTOUTER,
-				From:      tok.From,
-				To:        tok.To,
-			}, nil
+	for {
+		b, err := p.parseJSONBehavior()
+		if err != nil {
+			return nil, errors.Errorf("parseJSONBehavior failed: %w", err)
 		}
-		return &sqlast.JoinType{
-			Condition: sqlast.LEFT,
-			From:      tok.From,
-			To:        tok.To,
-		}, nil
-	case "RIGHT":
-		outer, _, _ := p.parseKeyword("OUTER")
-		if outer {
-			return &sqlast.JoinType{Condition: sqlast.RIGHTOUTER}, nil
+		if b == nil {
+			break
 		}
-		return &sqlast.JoinType{Condition: sqlast.RIGHT}, nil
-	case "FULL":
-		outer, _, _ := p.parseKeyword("OUTER")
-		if outer {
-			return &sqlast.JoinType{Condition: sqlast.FULLOUTER}, nil
+		if b.OnError {
+			if column.OnError != nil {
+				return nil, errors.Errorf("ON ERROR is specified twice at %s", b.Pos())
+			}
+			column.OnError = b
+		} else {
+			if column.OnEmpty != nil || column.OnError != nil {
+				return nil, errors.Errorf("unexpected ON EMPTY at %s", b.Pos())
+			}
+			column.OnEmpty = b
 		}
-		return &sqlast.JoinType{Condition: sqlast.FULL}, nil
-	case "JOIN":
-		p.prevToken()
-		return &sqlast.JoinType{Condition: sqlast.IMPLICIT}, nil
-	default:
-		return nil, errors.Errorf("unknown join type: %v", word)
 	}
+
+	return column, nil
 }
 
-func (p *Parser) parseJoinSpec() (sqlast.JoinSpec, error) {
-	if ok, tok, _ := p.parseKeyword("ON"); ok {
-		expr, err := p.ParseExpr()
+// parseJSONBehavior parses `{ NULL | ERROR | DEFAULT expr } ON { EMPTY | ERROR }`.
+// It returns nil if no behavior follows.
+func (p *Parser) parseJSONBehavior() (*sqlast.JSONBehavior, error) {
+	idx := p.index
+	tok, _ := p.peekToken()
+	if tok == nil {
+		return nil, nil
+	}
+
+	b := &sqlast.JSONBehavior{From: tok.From}
+	if ok, _, _ := p.parseKeyword("NULL"); ok {
+		b.Kind = sqlast.JSONBehaviorNull
+	} else if ok, _, _ := p.parseKeyword("ERROR"); ok {
+		b.Kind = sqlast.JSONBehaviorError
+	} else if ok, _, _ := p.parseKeyword("DEFAULT"); ok {
+		b.Kind = sqlast.JSONBehaviorDefault
+		d, err := p.parsePrefix()
 		if err != nil {
-			return nil, errors.Errorf("parse join condition failed: %w", err)
+			return nil, errors.Errorf("parsePrefix failed: %w", err)
 		}
-		return &sqlast.JoinCondition{
-			SearchCondition: expr,
-			On:              tok.From,
-		}, nil
+		b.Default = d
+	} else {
+		return nil, nil
 	}
 
-	ok, _, _ := p.parseKeyword("USING")
-	if !ok {
-		tok, _ := p.nextToken()
-		return nil, errors.Errorf("unknown join spec need USING or ON but: %v", tok)
+	if ok, toks, _ := p.parseKeywords("ON", "EMPTY"); ok {
+		b.To = toks[1].To
+		return b, nil
 	}
-
-	p.expectToken(sqltoken.LParen)
-	idents, err := p.parseListOfIds(sqltoken.Comma)
-	if err != nil {
-		return nil, errors.Errorf("parse named columns join list failed: %w", err)
+	if ok, toks, _ := p.parseKeywords("ON", "ERROR"); ok {
+		b.OnError = true
+		b.To = toks[1].To
+		return b, nil
 	}
-	p.expectToken(sqltoken.RParen)
-
-	return &sqlast.NamedColumnsJoin{
-		ColumnList: idents,
-	}, nil
+	p.index = idx
+	t, _ := p.peekToken()
+	return nil, errors.Errorf("expected ON EMPTY or ON ERROR after %v", t)
 }
 
 func (p *Parser) parseTableFactor() (sqlast.TableFactor, error) {
@@ -1800,31 +4210,120 @@ func (p *Parser) parseTableFactor() (sqlast.TableFactor, error) {
 		if err != nil {
 			return nil, errors.Errorf("parseQuery failed: %w", err)
 		}
-		p.expectToken(sqltoken.RParen)
-		alias := p.parseOptionalAlias(dialect.ReservedForTableAlias)
+		if _, err := p.requireToken(sqltoken.RParen); err != nil {
+			return nil, err
+		}
+		alias, implicit, err := p.parseOptionalAlias(dialect.ReservedForTableAlias)
+		if err != nil {
+			return nil, errors.Errorf("parseOptionalAlias failed: %w", err)
+		}
 		return &sqlast.Derived{
-			Lateral:  isLateral,
-			SubQuery: subquery,
-			Alias:    alias,
+			Lateral:       isLateral,
+			SubQuery:      subquery,
+			Alias:         alias,
+			ImplicitAlias: implicit,
 		}, nil
 	} else if isLateral && !ok {
-		t, _ := p.nextToken()
-		return nil, errors.Errorf("after lateral expected %s but %+v", sqltoken.LParen, t)
+		return nil, p.unexpectedToken("LParen after LATERAL")
+	}
+
+	if p.isJSONTable() {
+		return p.parseJSONTable()
+	}
+
+	// ONLY and the trailing `*` select whether inherited tables are scanned
+	inheritance := p.dialect.Supports(dialect.TableInheritance)
+
+	var onlyTok *sqltoken.Token
+	if inheritance {
+		if ok, tok, _ := p.parseKeyword("ONLY"); ok {
+			onlyTok = tok
+		}
 	}
 
 	name, err := p.parseObjectName()
 	if err != nil {
 		return nil, errors.Errorf("parseObjectName failed: %w", err)
 	}
+
+	var starTok *sqltoken.Token
+	if inheritance {
+		if tok, _ := p.peekToken(); tok != nil && tok.Kind == sqltoken.Mult {
+			starTok = p.mustNextToken()
+		}
+	}
+
 	var args []sqlast.Node
+	var argsRParen sqltoken.Pos
+	var withOrdinality bool
+	var ordinalityEnd sqltoken.Pos
 	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
 		a, err := p.parseOptionalArgs()
 		if err != nil {
 			return nil, errors.Errorf("parseOptionalArgs failed: %w", err)
 		}
+		if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.RParen {
+			return nil, p.unexpectedToken("RParen")
+		}
 		args = a
+		argsRParen = p.mustNextToken().To
+
+		if ok, toks, _ := p.parseKeywords("WITH", "ORDINALITY"); ok {
+			withOrdinality = true
+			ordinalityEnd = toks[1].To
+		}
+	}
+	table := &sqlast.Table{
+		Name:           name,
+		Args:           args,
+		ArgsRParen:     argsRParen,
+		WithOrdinality: withOrdinality,
+		OrdinalityEnd:  ordinalityEnd,
+	}
+
+	// AS (a int, ...) of a function returning record has no alias name
+	var noAliasName bool
+	if argsRParen != (sqltoken.Pos{}) {
+		idx := p.index
+		if ok, _, _ := p.parseKeyword("AS"); ok {
+			t, _ := p.peekToken()
+			noAliasName = t != nil && t.Kind == sqltoken.LParen
+		}
+		if !noAliasName {
+			p.index = idx
+		}
+	}
+	if !noAliasName && !p.isIndexHint() {
+		table.Alias, table.ImplicitAlias, err = p.parseOptionalAlias(dialect.ReservedForTableAlias)
+		if err != nil {
+			return nil, errors.Errorf("parseOptionalAlias failed: %w", err)
+		}
+	}
+	if t, _ := p.peekToken(); (noAliasName || table.Alias != nil) && t != nil && t.Kind == sqltoken.LParen {
+		if err := p.parseTableAliasColumns(table); err != nil {
+			return nil, errors.Errorf("parseTableAliasColumns failed: %w", err)
+		}
+		if noAliasName && len(table.ColumnDefs) == 0 {
+			return nil, errors.Errorf("expected column definitions after AS but column aliases at %+v", table.AliasColumns[0].Pos())
+		}
+	}
+
+	for p.isIndexHint() {
+		h, err := p.parseIndexHint()
+		if err != nil {
+			return nil, errors.Errorf("parseIndexHint failed: %w", err)
+		}
+		table.IndexHints = append(table.IndexHints, h)
+	}
+
+	var sample *sqlast.TableSample
+	if ok, tok, _ := p.parseKeyword("TABLESAMPLE"); ok {
+		s, err := p.parseTableSample(tok)
+		if err != nil {
+			return nil, errors.Errorf("parseTableSample failed: %w", err)
+		}
+		sample = s
 	}
-	alias := p.parseOptionalAlias(dialect.ReservedForTableAlias)
 
 	var withHints []sqlast.Node
 	if ok, _, _ := p.parseKeyword("WITH"); ok {
@@ -1834,19 +4333,197 @@ func (p *Parser) parseTableFactor() (sqlast.TableFactor, error) {
 				return nil, errors.Errorf("parseExprList failed: %w", err)
 			}
 			withHints = h
-			p.expectToken(sqltoken.RParen)
+			if _, err := p.requireToken(sqltoken.RParen); err != nil {
+				return nil, err
+			}
 		} else {
 			p.prevToken()
 		}
 	}
 
-	return &sqlast.Table{
-		Name:      name,
-		Args:      args,
-		Alias:     alias,
-		WithHints: withHints,
-	}, nil
+	table.WithHints = withHints
+	table.Sample = sample
+	if onlyTok != nil {
+		table.Only = true
+		table.OnlyPos = onlyTok.From
+	}
+	if starTok != nil {
+		table.Descendants = true
+		table.DescendantsPos = starTok.To
+	}
+
+	return table, nil
+
+}
+
+// parseTableAliasColumns parses the column aliases `(a, b)` or the column definitions
+// `(a int, b text)` after the alias of table.
+func (p *Parser) parseTableAliasColumns(table *sqlast.Table) error {
+	p.mustNextToken()
+
+	// column definitions have a data type after the first name
+	idx := p.index
+	var typed bool
+	if _, err := p.parseIdentifier(); err == nil {
+		t, _ := p.peekToken()
+		typed = t != nil && t.Kind != sqltoken.Comma && t.Kind != sqltoken.RParen
+	}
+	p.index = idx
+
+	for {
+		if typed {
+			def, err := p.parseColumnDef()
+			if err != nil {
+				return errors.Errorf("parseColumnDef failed: %w", err)
+			}
+			table.ColumnDefs = append(table.ColumnDefs, def)
+		} else {
+			ident, err := p.parseIdentifier()
+			if err != nil {
+				return errors.Errorf("parseIdentifier failed: %w", err)
+			}
+			table.AliasColumns = append(table.AliasColumns, ident)
+		}
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			break
+		}
+	}
+
+	if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.RParen {
+		return p.unexpectedToken("RParen")
+	}
+	table.AliasRParen = p.mustNextToken().To
+
+	return nil
+}
+
+// isIndexHint reports whether the next tokens start an index hint of MySQL,
+// i.e. USE, IGNORE or FORCE followed by INDEX or KEY.
+func (p *Parser) isIndexHint() bool {
+	if !p.dialect.Supports(dialect.IndexHints) {
+		return false
+	}
+
+	idx := p.index
+	defer func() {
+		p.index = idx
+	}()
+
+	tok, err := p.nextToken()
+	if err != nil {
+		return false
+	}
+	word, ok := tok.Value.(*sqltoken.SQLWord)
+	if !ok {
+		return false
+	}
+	switch strings.ToUpper(word.Value) {
+	case "USE", "IGNORE", "FORCE":
+	default:
+		return false
+	}
+	if ok, _, _ := p.parseKeyword("INDEX"); ok {
+		return true
+	}
+	ok, _, _ = p.parseKeyword("KEY")
+	return ok
+}
+
+func (p *Parser) parseIndexHint() (*sqlast.IndexHint, error) {
+	tok := p.mustNextToken()
+	hint := &sqlast.IndexHint{
+		TypePos: tok.From,
+	}
+	switch strings.ToUpper(tok.Value.(*sqltoken.SQLWord).Value) {
+	case "IGNORE":
+		hint.Type = sqlast.IgnoreIndex
+	case "FORCE":
+		hint.Type = sqlast.ForceIndex
+	default:
+		hint.Type = sqlast.UseIndex
+	}
+	if ok, _, _ := p.parseKeyword("INDEX"); !ok {
+		p.mustNextToken()
+		hint.Key = true
+	}
+
+	if ok, _, _ := p.parseKeyword("FOR"); ok {
+		if ok, _, _ := p.parseKeyword("JOIN"); ok {
+			hint.For = sqlast.IndexHintForJoin
+		} else if ok, _, _ := p.parseKeywords("ORDER", "BY"); ok {
+			hint.For = sqlast.IndexHintForOrderBy
+		} else if ok, _, _ := p.parseKeywords("GROUP", "BY"); ok {
+			hint.For = sqlast.IndexHintForGroupBy
+		} else {
+			return nil, p.unexpectedToken("JOIN, ORDER BY or GROUP BY after FOR")
+		}
+	}
+
+	if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.LParen {
+		return nil, p.unexpectedToken("LParen")
+	}
+	p.mustNextToken()
+	if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.RParen {
+		indexes, err := p.parseColumnNames()
+		if err != nil {
+			return nil, errors.Errorf("parseColumnNames failed: %w", err)
+		}
+		hint.Indexes = indexes
+	}
+	r, _ := p.peekToken()
+	if r == nil || r.Kind != sqltoken.RParen {
+		return nil, p.unexpectedToken("RParen")
+	}
+	p.mustNextToken()
+	hint.RParen = r.To
+	if len(hint.Indexes) == 0 && hint.Type != sqlast.UseIndex {
+		return nil, errors.Errorf("%s requires index names", hint.Type.ToSQLString())
+	}
+
+	return hint, nil
+}
+
+func (p *Parser) parseTableSample(tableSampleTok *sqltoken.Token) (*sqlast.TableSample, error) {
+	method, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+	if _, err := p.requireToken(sqltoken.LParen); err != nil {
+		return nil, errors.Errorf("TABLESAMPLE: %w", err)
+	}
+	args, err := p.parseOptionalArgs()
+	if err != nil {
+		return nil, errors.Errorf("parseOptionalArgs failed: %w", err)
+	}
+	r, err := p.requireToken(sqltoken.RParen)
+	if err != nil {
+		return nil, errors.Errorf("TABLESAMPLE: %w", err)
+	}
+
+	sample := &sqlast.TableSample{
+		TableSample: tableSampleTok.From,
+		Method:      method,
+		Args:        args,
+		RParen:      r.To,
+	}
+
+	if ok, _, _ := p.parseKeyword("REPEATABLE"); ok {
+		if _, err := p.requireToken(sqltoken.LParen); err != nil {
+			return nil, errors.Errorf("REPEATABLE: %w", err)
+		}
+		seed, err := p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		r, err := p.requireToken(sqltoken.RParen)
+		if err != nil {
+			return nil, errors.Errorf("REPEATABLE: %w", err)
+		}
+		sample.Seed = seed
+		sample.SeedRParen = r.To
+	}
 
+	return sample, nil
 }
 
 func (p *Parser) parseLimit() (*sqlast.LimitExpr, error) {
@@ -1859,27 +4536,48 @@ func (p *Parser) parseLimit() (*sqlast.LimitExpr, error) {
 		return nil, errors.Errorf("invalid limit value: %w", err)
 	}
 
-	var offset *sqlast.LongValue
-	if ok, tok, _ := p.parseKeyword("OFFSET"); ok {
-		o, _, err := p.parseLiteralInt()
-		if err != nil {
-			return nil, errors.Errorf("invalid offset value: %w", err)
+	if p.dialect.Supports(dialect.LimitComma) {
+		if ok, _ := p.consumeToken(sqltoken.Comma); ok {
+			c, ctok, err := p.parseLiteralInt()
+			if err != nil {
+				return nil, errors.Errorf("invalid limit value: %w", err)
+			}
+			return &sqlast.LimitExpr{
+				LimitValue: &sqlast.LongValue{
+					Long: int64(c),
+					From: ctok.From,
+					To:   ctok.To,
+				},
+				OffsetValue: &sqlast.LongValue{
+					Long: int64(i),
+					From: tok.From,
+					To:   tok.To,
+				},
+				OffsetComma: true,
+			}, nil
 		}
-		offset = &sqlast.LongValue{
-			Long: int64(o),
+	}
+
+	limit := &sqlast.LimitExpr{
+		LimitValue: &sqlast.LongValue{
+			Long: int64(i),
 			From: tok.From,
 			To:   tok.To,
+		},
+	}
+	if ok, _, _ := p.parseKeyword("OFFSET"); ok {
+		o, otok, err := p.parseLiteralInt()
+		if err != nil {
+			return nil, errors.Errorf("invalid offset value: %w", err)
+		}
+		limit.OffsetValue = &sqlast.LongValue{
+			Long: int64(o),
+			From: otok.From,
+			To:   otok.To,
 		}
 	}
 
-	return &sqlast.LimitExpr{
-		LimitValue: &sqlast.LongValue{
-			Long: int64(i),
-			From: tok.From,
-			To:   tok.To,
-		},
-		OffsetValue: offset,
-	}, nil
+	return limit, nil
 }
 
 func (p *Parser) parseIdentifier() (*sqlast.Ident, error) {
@@ -1891,6 +4589,9 @@ func (p *Parser) parseIdentifier() (*sqlast.Ident, error) {
 	if !ok {
 		return nil, errors.Errorf("expected identifier but %+v", tok)
 	}
+	if word.QuoteStyle == 0 && p.dialect.IsReservedKeyword(word.Keyword) {
+		return nil, errors.Errorf("expected identifier but reserved keyword %s at %d:%d", word.Value, tok.From.Line, tok.From.Col)
+	}
 
 	return &sqlast.Ident{
 		From:  tok.From,
@@ -1910,6 +4611,9 @@ func (p *Parser) parseExprList() ([]sqlast.Node, error) {
 		exprList = append(exprList, expr)
 		if tok, _ := p.peekToken(); tok != nil && tok.Kind == sqltoken.Comma {
 			p.mustNextToken()
+			if p.trailingComma() {
+				break
+			}
 		} else {
 			break
 		}
@@ -1918,6 +4622,27 @@ func (p *Parser) parseExprList() ([]sqlast.Node, error) {
 	return exprList, nil
 }
 
+// trailingComma reports whether the comma just consumed is the trailing one of a list.
+// It is always false unless AllowTrailingComma is set.
+func (p *Parser) trailingComma() bool {
+	if !p.allowTrailingComma {
+		return false
+	}
+
+	t, _ := p.peekToken()
+	if t == nil {
+		return true
+	}
+	switch t.Kind {
+	case sqltoken.RParen, sqltoken.Semicolon:
+		return true
+	case sqltoken.SQLKeyword:
+		w := t.Value.(*sqltoken.SQLWord)
+		return w.QuoteStyle == 0 && containsStr(dialect.ReservedForColumnAlias, w.Keyword)
+	}
+	return false
+}
+
 func (p *Parser) parseColumnNames() ([]*sqlast.Ident, error) {
 	return p.parseListOfIds(sqltoken.Comma)
 }
@@ -1946,64 +4671,70 @@ func (p *Parser) parseSubexpr(precedence uint) (sqlast.Node, error) {
 	return expr, nil
 }
 
+var comparisonQuantifiers = map[string]sqlast.ComparisonQuantifier{
+	"ALL":  sqlast.QuantifierAll,
+	"ANY":  sqlast.QuantifierAny,
+	"SOME": sqlast.QuantifierSome,
+}
+
+// peekComparisonQuantifier reports whether ALL, ANY or SOME followed by ( comes
+// after the comparison operator.
+func (p *Parser) peekComparisonQuantifier(operator sqlast.OperatorType) (sqlast.ComparisonQuantifier, bool) {
+	switch operator {
+	case sqlast.Eq, sqlast.NotEq, sqlast.Gt, sqlast.GtEq, sqlast.Lt, sqlast.LtEq:
+	default:
+		return 0, false
+	}
+	tok, _ := p.peekToken()
+	if tok == nil || tok.Kind != sqltoken.SQLKeyword {
+		return 0, false
+	}
+	word := tok.Value.(*sqltoken.SQLWord)
+	q, ok := comparisonQuantifiers[word.Keyword]
+	if !ok || word.QuoteStyle != 0 {
+		return 0, false
+	}
+
+	idx := p.index
+	defer func() { p.index = idx }()
+	p.mustNextToken()
+	next, _ := p.peekToken()
+	return q, next != nil && next.Kind == sqltoken.LParen
+}
+
+// parseQuantifiedComparison parses `ALL|ANY|SOME (subquery or array)` after the comparison operator,
+// where the array is an expression such as a column or ARRAY[...].
+func (p *Parser) parseQuantifiedComparison(left sqlast.Node, op *sqlast.Operator, q sqlast.ComparisonQuantifier) (sqlast.Node, error) {
+	quantifier := p.mustNextToken()
+	right, err := p.parsePrefix()
+	if err != nil {
+		return nil, errors.Errorf("parsePrefix failed: %w", err)
+	}
+
+	return &sqlast.QuantifiedComparison{
+		Left:          left,
+		Op:            op,
+		Quantifier:    q,
+		QuantifierPos: quantifier.From,
+		Right:         right,
+	}, nil
+}
+
 func (p *Parser) parseInfix(expr sqlast.Node, precedence uint) (sqlast.Node, error) {
-	operator := sqlast.None
 	tok, err := p.nextToken()
 	if err != nil {
 		return nil, errors.Errorf("nextToken failed: %w", err)
 	}
 
-	switch tok.Kind {
-	case sqltoken.Eq:
-		operator = sqlast.Eq
-	case sqltoken.Neq:
-		operator = sqlast.NotEq
-	case sqltoken.Gt:
-		operator = sqlast.Gt
-	case sqltoken.GtEq:
-		operator = sqlast.GtEq
-	case sqltoken.Lt:
-		operator = sqlast.Lt
-	case sqltoken.LtEq:
-		operator = sqlast.LtEq
-	case sqltoken.Plus:
-		operator = sqlast.Plus
-	case sqltoken.Minus:
-		operator = sqlast.Minus
-	case sqltoken.Mult:
-		operator = sqlast.Multiply
-	case sqltoken.Mod:
-		operator = sqlast.Modulus
-	case sqltoken.Div:
-		operator = sqlast.Divide
-	case sqltoken.SQLKeyword:
-		word := tok.Value.(*sqltoken.SQLWord)
-		switch word.Keyword {
-		case "AND":
-			operator = sqlast.And
-		case "OR":
-			operator = sqlast.Or
-		case "LIKE":
-			operator = sqlast.Like
-		case "NOT":
-			ok, _, _ := p.parseKeyword("LIKE")
-			if ok {
-				operator = sqlast.NotLike
-			}
-		}
+	if o, ok := p.binaryOperator(tok); ok {
+		op := &sqlast.Operator{Type: o.op, From: tok.From, To: tok.To}
+		return p.parseBinaryExpr(expr, op, o.assoc, precedence)
 	}
-
-	if operator != sqlast.None {
-		right, err := p.parseSubexpr(precedence)
-		if err != nil {
-			return nil, errors.Errorf("parseSubexpr failed: %w", err)
+	if tok.Kind == sqltoken.SQLKeyword && tok.Value.(*sqltoken.SQLWord).Keyword == "NOT" {
+		if ok, _, _ := p.parseKeyword("LIKE"); ok {
+			op := &sqlast.Operator{Type: sqlast.NotLike, From: tok.From, To: tok.To}
+			return p.parseBinaryExpr(expr, op, leftAssoc, precedence)
 		}
-
-		return &sqlast.BinaryExpr{
-			Left:  expr,
-			Op:    &sqlast.Operator{Type: operator, From: tok.From, To: tok.To},
-			Right: right,
-		}, nil
 	}
 
 	if tok.Kind == sqltoken.SQLKeyword {
@@ -2011,17 +4742,60 @@ func (p *Parser) parseInfix(expr sqlast.Node, precedence uint) (sqlast.Node, err
 
 		switch word.Keyword {
 		case "IS":
+			negated, _, _ := p.parseKeyword("NOT")
 			if ok, _, _ := p.parseKeyword("NULL"); ok {
+				if negated {
+					return &sqlast.IsNotNull{
+						X: expr,
+					}, nil
+				}
 				return &sqlast.IsNull{
 					X: expr,
 				}, nil
 			}
-			if ok, _, _ := p.parseKeywords("NOT", "NULL"); ok {
-				return &sqlast.IsNotNull{
-					X: expr,
-				}, nil
+			// TRUE, FALSE and UNKNOWN are truth values only after IS [NOT]
+			if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.SQLKeyword {
+				var value sqlast.Node
+				switch t.Value.(*sqltoken.SQLWord).Keyword {
+				case "TRUE", "FALSE":
+					v, err := p.parseValue()
+					if err != nil {
+						return nil, errors.Errorf("parseValue failed: %w", err)
+					}
+					value = v
+				case "UNKNOWN":
+					p.mustNextToken()
+					value = &sqlast.NullValue{
+						From:    t.From,
+						To:      t.To,
+						Unknown: true,
+					}
+				}
+				if value != nil {
+					return &sqlast.IsTruthValue{
+						X:       expr,
+						Negated: negated,
+						Value:   value,
+					}, nil
+				}
+			}
+			return nil, p.unexpectedToken("NULL, TRUE, FALSE or UNKNOWN after IS")
+		case "OVERLAPS":
+			return p.parseOverlaps(expr, tok, precedence)
+		case "AT":
+			ok, _, _ := p.parseKeywords("TIME", "ZONE")
+			if !ok {
+				return nil, p.unexpectedToken("TIME ZONE after AT")
 			}
-			return nil, errors.Errorf("NULL or NOT NULL after IS")
+			zone, err := p.parseSubexpr(precedence)
+			if err != nil {
+				return nil, errors.Errorf("parseSubexpr failed: %w", err)
+			}
+			return &sqlast.AtTimeZone{
+				Expr: expr,
+				Zone: zone,
+				At:   tok.From,
+			}, nil
 		case "NOT", "IN", "BETWEEN":
 			p.prevToken()
 			negated, _, _ := p.parseKeyword("NOT")
@@ -2029,7 +4803,7 @@ func (p *Parser) parseInfix(expr sqlast.Node, precedence uint) (sqlast.Node, err
 				return p.parseIn(expr, negated)
 			}
 			if ok, _, _ := p.parseKeyword("BETWEEN"); ok {
-				return p.parseBetween(expr, negated)
+				return p.parseBetween(expr, negated, precedence)
 			}
 		}
 	}
@@ -2038,8 +4812,26 @@ func (p *Parser) parseInfix(expr sqlast.Node, precedence uint) (sqlast.Node, err
 		return p.parsePGCast(expr)
 	}
 
-	log.Fatalf("no infix parser for sqltoken %+v", tok)
-	return nil, nil
+	return nil, errors.Errorf("no infix parser for %+v", tok)
+}
+
+// parseOuterJoinMarker parses `(+)` after a column of Oracle dialect,
+// and returns the last position of it.
+func (p *Parser) parseOuterJoinMarker() (sqltoken.Pos, bool) {
+	if !p.dialect.Supports(dialect.OuterJoinMarker) {
+		return sqltoken.Pos{}, false
+	}
+
+	idx := p.index
+	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		if ok, _ := p.consumeToken(sqltoken.Plus); ok {
+			if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.RParen {
+				return p.mustNextToken().To, true
+			}
+		}
+	}
+	p.index = idx
+	return sqltoken.Pos{}, false
 }
 
 // TODO position
@@ -2055,7 +4847,9 @@ func (p *Parser) parsePGCast(expr sqlast.Node) (sqlast.Node, error) {
 }
 
 func (p *Parser) parseIn(expr sqlast.Node, negated bool) (sqlast.Node, error) {
-	p.expectToken(sqltoken.LParen)
+	if _, err := p.requireToken(sqltoken.LParen); err != nil {
+		return nil, err
+	}
 	sok, _, _ := p.parseKeyword("SELECT")
 	wok, _, _ := p.parseKeyword("WITH")
 	var inop sqlast.Node
@@ -2066,7 +4860,7 @@ func (p *Parser) parseIn(expr sqlast.Node, negated bool) (sqlast.Node, error) {
 			return nil, errors.Errorf("parseQuery failed: %w", err)
 		}
 		r, _ := p.nextToken()
-		if r.Kind != sqltoken.RParen {
+		if r == nil || r.Kind != sqltoken.RParen {
 			return nil, errors.Errorf("expected RParen but %+v", r)
 		}
 		inop = &sqlast.InSubQuery{
@@ -2081,7 +4875,7 @@ func (p *Parser) parseIn(expr sqlast.Node, negated bool) (sqlast.Node, error) {
 			return nil, errors.Errorf("parseExprList failed: %w", err)
 		}
 		r, _ := p.nextToken()
-		if r.Kind != sqltoken.RParen {
+		if r == nil || r.Kind != sqltoken.RParen {
 			return nil, errors.Errorf("expected RParen but %+v", r)
 		}
 		inop = &sqlast.InList{
@@ -2094,15 +4888,20 @@ func (p *Parser) parseIn(expr sqlast.Node, negated bool) (sqlast.Node, error) {
 	return inop, nil
 }
 
-func (p *Parser) parseBetween(expr sqlast.Node, negated bool) (sqlast.Node, error) {
-	low, err := p.parsePrefix()
+// parseBetween parses the bounds at the precedence of BETWEEN itself,
+// so the AND separating them is never taken as a boolean AND and
+// `a BETWEEN 1 AND 2 AND b` is `(a BETWEEN 1 AND 2) AND b`.
+func (p *Parser) parseBetween(expr sqlast.Node, negated bool, precedence uint) (sqlast.Node, error) {
+	low, err := p.parseSubexpr(precedence)
 	if err != nil {
-		return nil, errors.Errorf("parsePrefix: %w", err)
+		return nil, errors.Errorf("parseSubexpr failed: %w", err)
+	}
+	if _, err := p.requireKeyword("AND"); err != nil {
+		return nil, err
 	}
-	p.expectKeyword("AND")
-	high, err := p.parsePrefix()
+	high, err := p.parseSubexpr(precedence)
 	if err != nil {
-		return nil, errors.Errorf("parsePrefix: %w", err)
+		return nil, errors.Errorf("parseSubexpr failed: %w", err)
 	}
 
 	return &sqlast.Between{
@@ -2114,47 +4913,203 @@ func (p *Parser) parseBetween(expr sqlast.Node, negated bool) (sqlast.Node, erro
 
 }
 
+// parseBinaryExpr parses the right operand of op, an operator of the table
+// whose precedence is precedence, and returns the BinaryExpr of them.
+func (p *Parser) parseBinaryExpr(left sqlast.Node, op *sqlast.Operator, assoc associativity, precedence uint) (sqlast.Node, error) {
+	if q, ok := p.peekComparisonQuantifier(op.Type); ok {
+		return p.parseQuantifiedComparison(left, op, q)
+	}
+
+	// the operators of the same precedence in the right operand are taken into it
+	// by lowering the precedence if op associates to the right.
+	if assoc == rightAssoc {
+		precedence--
+	}
+	right, err := p.parseSubexpr(precedence)
+	if err != nil {
+		return nil, errors.Errorf("parseSubexpr failed: %w", err)
+	}
+
+	return &sqlast.BinaryExpr{
+		Left:  left,
+		Op:    op,
+		Right: right,
+	}, nil
+}
+
 func (p *Parser) getNextPrecedence() (uint, error) {
 	tok, _ := p.peekToken()
 	if tok == nil {
 		return 0, nil
 	}
+	if tok.Kind == sqltoken.SQLKeyword && tok.Value.(*sqltoken.SQLWord).Keyword == "NOT" {
+		// NOT IN, NOT BETWEEN and NOT LIKE bind like their positive forms.
+		idx := p.index
+		p.mustNextToken()
+		next, _ := p.peekToken()
+		p.index = idx
+		if next != nil && next.Kind == sqltoken.SQLKeyword {
+			switch next.Value.(*sqltoken.SQLWord).Keyword {
+			case "IN", "BETWEEN", "LIKE":
+				return p.getPrecedence(next), nil
+			}
+		}
+	}
 	return p.getPrecedence(tok), nil
 }
 
+// parseOverlaps parses the right operand of `(start, end) OVERLAPS (start, end)`.
+func (p *Parser) parseOverlaps(left sqlast.Node, overlaps *sqltoken.Token, precedence uint) (sqlast.Node, error) {
+	l, ok := left.(*sqlast.RowValueExpr)
+	if !ok || len(l.Values) != 2 {
+		return nil, errors.Errorf("expected (start, end) before OVERLAPS but %s", left.ToSQLString())
+	}
+	right, err := p.parseSubexpr(precedence)
+	if err != nil {
+		return nil, errors.Errorf("parseSubexpr failed: %w", err)
+	}
+	r, ok := right.(*sqlast.RowValueExpr)
+	if !ok || len(r.Values) != 2 {
+		return nil, errors.Errorf("expected (start, end) after OVERLAPS but %s", right.ToSQLString())
+	}
+
+	return &sqlast.OverlapsExpr{
+		Left:     l,
+		Overlaps: overlaps.From,
+		Right:    r,
+	}, nil
+}
+
+// pipesAsOr reports whether `||` means logical OR rather than string
+// concatenation, as in the default sql_mode of MySQL.
+func (p *Parser) pipesAsOr() bool {
+	return p.dialect.Supports(dialect.PipesAsOr)
+}
+
+// unaryPrecedence is the binding power of prefix +, - and ~.
+// They bind tighter than any binary operator except `::`,
+// so `-a * b` is `(-a) * b` while `-a::int` is `-(a::int)`.
+const unaryPrecedence = 47
+
+// getPrecedence returns the binding power of an infix operator token.
+// Higher binds tighter, and operators of equal precedence associate as
+// the assoc of their entry in the operator table. The table follows PostgreSQL:
+//
+//	50  ::
+//	47  unary + - ~ (see unaryPrecedence)
+//	45  AT TIME ZONE
+//	40  * / %
+//	30  + -
+//	28  || (string concatenation)
+//	27  << >>
+//	26  &
+//	25  ^
+//	24  |
+//	22  [NOT] IN, [NOT] BETWEEN, [NOT] LIKE, OVERLAPS
+//	20  = <> < <= > >=, ~ ~* !~ !~* (PostgreSQL)
+//	17  IS [NOT] NULL
+//	15  NOT
+//	10  AND
+//	 5  OR, and || when it means OR (MySQL)
 func (p *Parser) getPrecedence(ts *sqltoken.Token) uint {
+	if o, ok := p.binaryOperator(ts); ok {
+		return o.precedence
+	}
 	switch ts.Kind {
 	case sqltoken.SQLKeyword:
-		word := ts.Value.(*sqltoken.SQLWord)
-		switch word.Keyword {
-		case "OR":
-			return 5
-		case "AND":
-			return 10
-		case "NOT":
-			return 15
-		case "IS":
-			return 17
-		case "IN":
-			return 20
-		case "BETWEEN":
-			return 20
-		case "LIKE":
-			return 20
-		default:
-			return 0
-		}
-	case sqltoken.Eq, sqltoken.Lt, sqltoken.LtEq, sqltoken.Neq, sqltoken.Gt, sqltoken.GtEq:
-		return 20
-	case sqltoken.Plus, sqltoken.Minus:
-		return 30
-	case sqltoken.Mult, sqltoken.Div, sqltoken.Mod:
-		return 40
+		return keywordPrecedences[ts.Value.(*sqltoken.SQLWord).Keyword]
 	case sqltoken.DoubleColon:
 		return 50
-	default:
-		return 0
 	}
+	return 0
+}
+
+// associativity is the way operators of the same precedence are grouped.
+type associativity int
+
+const (
+	leftAssoc  associativity = iota // a - b - c is (a - b) - c
+	rightAssoc                      // a op b op c is a op (b op c)
+)
+
+// binaryOperator is an entry of the operator table, which is an infix
+// operator parsed into a BinaryExpr.
+type binaryOperator struct {
+	op         sqlast.OperatorType
+	precedence uint
+	assoc      associativity
+}
+
+// binaryOperators is the table of the infix operators written with symbols.
+// Adding such an operator only needs an entry here, or in keywordOperators if it is
+// a keyword. The ones depending on the dialect are adjusted in Parser.binaryOperator.
+var binaryOperators = map[sqltoken.Kind]binaryOperator{
+	sqltoken.Eq:                       {sqlast.Eq, 20, leftAssoc},
+	sqltoken.Neq:                      {sqlast.NotEq, 20, leftAssoc},
+	sqltoken.Lt:                       {sqlast.Lt, 20, leftAssoc},
+	sqltoken.LtEq:                     {sqlast.LtEq, 20, leftAssoc},
+	sqltoken.Gt:                       {sqlast.Gt, 20, leftAssoc},
+	sqltoken.GtEq:                     {sqlast.GtEq, 20, leftAssoc},
+	sqltoken.Tilde:                    {sqlast.RegexpMatch, 20, leftAssoc},
+	sqltoken.TildeAsterisk:            {sqlast.RegexpIMatch, 20, leftAssoc},
+	sqltoken.ExclamationTilde:         {sqlast.NotRegexpMatch, 20, leftAssoc},
+	sqltoken.ExclamationTildeAsterisk: {sqlast.NotRegexpIMatch, 20, leftAssoc},
+	sqltoken.Pipe:                     {sqlast.BitwiseOr, 24, leftAssoc},
+	sqltoken.Caret:                    {sqlast.BitwiseXor, 25, leftAssoc},
+	sqltoken.Ampersand:                {sqlast.BitwiseAnd, 26, leftAssoc},
+	sqltoken.ShiftLeft:                {sqlast.ShiftLeft, 27, leftAssoc},
+	sqltoken.ShiftRight:               {sqlast.ShiftRight, 27, leftAssoc},
+	sqltoken.DoublePipe:               {sqlast.StringConcat, 28, leftAssoc},
+	sqltoken.Plus:                     {sqlast.Plus, 30, leftAssoc},
+	sqltoken.Minus:                    {sqlast.Minus, 30, leftAssoc},
+	sqltoken.Mult:                     {sqlast.Multiply, 40, leftAssoc},
+	sqltoken.Div:                      {sqlast.Divide, 40, leftAssoc},
+	sqltoken.Mod:                      {sqlast.Modulus, 40, leftAssoc},
+}
+
+// keywordOperators is the table of the infix operators written as a keyword.
+var keywordOperators = map[string]binaryOperator{
+	"OR":   {sqlast.Or, 5, leftAssoc},
+	"AND":  {sqlast.And, 10, leftAssoc},
+	"LIKE": {sqlast.Like, 22, leftAssoc},
+}
+
+// keywordPrecedences are the precedences of the other infix forms which begin
+// with a keyword and are parsed by their own functions.
+var keywordPrecedences = map[string]uint{
+	"NOT":      15,
+	"IS":       17,
+	"IN":       22,
+	"BETWEEN":  22,
+	"OVERLAPS": 22,
+	"AT":       45,
+}
+
+// binaryOperator looks up the operator table for ts.
+func (p *Parser) binaryOperator(ts *sqltoken.Token) (binaryOperator, bool) {
+	switch ts.Kind {
+	case sqltoken.SQLKeyword:
+		o, ok := keywordOperators[ts.Value.(*sqltoken.SQLWord).Keyword]
+		return o, ok
+	case sqltoken.DoublePipe:
+		if p.pipesAsOr() {
+			return keywordOperators["OR"], true
+		}
+	case sqltoken.Tilde, sqltoken.TildeAsterisk, sqltoken.ExclamationTilde, sqltoken.ExclamationTildeAsterisk:
+		// regex match operators of PostgreSQL, `~` is only bitwise NOT in the other dialects
+		if !p.dialect.Supports(dialect.RegexOperators) {
+			return binaryOperator{}, false
+		}
+	}
+	o, ok := binaryOperators[ts.Kind]
+	return o, ok
+}
+
+// typedLiteralTypes are the keywords which make a typed literal with a following string literal.
+var typedLiteralTypes = map[string]sqlast.TypedLiteralType{
+	"DATE":      sqlast.DateLiteral,
+	"TIME":      sqlast.TimeLiteral,
+	"TIMESTAMP": sqlast.TimestampLiteral,
 }
 
 func (p *Parser) parsePrefix() (sqlast.Node, error) {
@@ -2166,6 +5121,36 @@ func (p *Parser) parsePrefix() (sqlast.Node, error) {
 	switch tok.Kind {
 	case sqltoken.SQLKeyword:
 		word := tok.Value.(*sqltoken.SQLWord)
+		if p.doubleQuoteStrings && word.QuoteStyle == '"' {
+			return &sqlast.SingleQuotedString{
+				From:   tok.From,
+				To:     tok.To,
+				String: word.Value,
+			}, nil
+		}
+		if typ, ok := typedLiteralTypes[word.Keyword]; ok && word.QuoteStyle == 0 {
+			if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.SingleQuotedString {
+				str := p.mustNextToken()
+				return &sqlast.TypedLiteral{
+					From: tok.From,
+					Type: typ,
+					Literal: &sqlast.SingleQuotedString{
+						From:   str.From,
+						To:     str.To,
+						String: str.Value.(string),
+					},
+				}, nil
+			}
+		}
+		if word.Keyword == "ARRAY" && word.QuoteStyle == 0 {
+			if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.LBracket {
+				ast, err := p.parseArrayConstructor(tok)
+				if err != nil {
+					return nil, errors.Errorf("parseArrayConstructor failed: %w", err)
+				}
+				return ast, nil
+			}
+		}
 		switch word.Keyword {
 		case "TRUE", "FALSE", "NULL":
 			p.prevToken()
@@ -2174,6 +5159,11 @@ func (p *Parser) parsePrefix() (sqlast.Node, error) {
 				return nil, errors.Errorf("parseSQLValue failed: %w", err)
 			}
 			return t, nil
+		case "DEFAULT":
+			return &sqlast.DefaultExpr{
+				From: tok.From,
+				To:   tok.To,
+			}, nil
 		case "CASE":
 			p.prevToken()
 			ast, err := p.parseCaseExpression()
@@ -2188,6 +5178,19 @@ func (p *Parser) parsePrefix() (sqlast.Node, error) {
 				return nil, errors.Errorf("parseCastExpression failed: %w", err)
 			}
 			return ast, nil
+		case "TRIM", "SUBSTRING", "EXTRACT", "POSITION":
+			if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.LParen {
+				return &sqlast.Ident{Value: word.String(),
+					From: tok.From,
+					To:   tok.To,
+				}, nil
+			}
+			p.prevToken()
+			ast, err := p.parseSpecialFunction()
+			if err != nil {
+				return nil, errors.Errorf("parseSpecialFunction failed: %w", err)
+			}
+			return ast, nil
 		case "EXISTS":
 			p.prevToken()
 			ast, err := p.parseExistsExpression(nil)
@@ -2217,7 +5220,7 @@ func (p *Parser) parsePrefix() (sqlast.Node, error) {
 			}
 			return &sqlast.UnaryExpr{
 				From: tok.From,
-				Op:   &sqlast.Operator{Type: sqlast.Not},
+				Op:   &sqlast.Operator{Type: sqlast.Not, From: tok.From, To: tok.To},
 				Expr: expr,
 			}, nil
 		default:
@@ -2264,6 +5267,19 @@ func (p *Parser) parsePrefix() (sqlast.Node, error) {
 				}, nil
 			}
 
+			if rparen, ok := p.parseOuterJoinMarker(); ok {
+				var column sqlast.Node = idParts[0]
+				if len(idParts) > 1 {
+					column = &sqlast.CompoundIdent{
+						Idents: idParts,
+					}
+				}
+				return &sqlast.OuterJoinColumn{
+					Column: column,
+					RParen: rparen,
+				}, nil
+			}
+
 			if ok, _ := p.consumeToken(sqltoken.LParen); ok {
 				p.prevToken()
 				name := &sqlast.ObjectName{
@@ -2284,33 +5300,48 @@ func (p *Parser) parsePrefix() (sqlast.Node, error) {
 		return &sqlast.Wildcard{
 			Wildcard: tok.From,
 		}, nil
-	case sqltoken.Plus:
-		precedence := p.getPrecedence(tok)
-		expr, err := p.parseSubexpr(precedence)
-		if err != nil {
-			return nil, errors.Errorf("parseSubexpr failed: %w", err)
+	case sqltoken.Placeholder:
+		return &sqlast.Placeholder{
+			Value: tok.Value.(string),
+			From:  tok.From,
+			To:    tok.To,
+		}, nil
+	case sqltoken.Colon:
+		// named placeholder i.e: `:name`
+		name, _ := p.peekToken()
+		if name == nil || name.Kind != sqltoken.SQLKeyword || name.From != tok.To {
+			return nil, errors.Errorf("expected name of placeholder after %s but %v", tok.Value, name)
 		}
-		return &sqlast.UnaryExpr{
-			From: tok.From,
-			Op:   &sqlast.Operator{Type: sqlast.Plus, From: tok.From, To: tok.To},
-			Expr: expr,
+		p.mustNextToken()
+		return &sqlast.Placeholder{
+			Value: ":" + name.Value.(*sqltoken.SQLWord).String(),
+			From:  tok.From,
+			To:    name.To,
 		}, nil
-	case sqltoken.Minus:
-		precedence := p.getPrecedence(tok)
-		expr, err := p.parseSubexpr(precedence)
+	case sqltoken.Plus, sqltoken.Minus, sqltoken.Tilde:
+		expr, err := p.parseSubexpr(unaryPrecedence)
 		if err != nil {
 			return nil, errors.Errorf("parseSubexpr failed: %w", err)
 		}
+		var opType sqlast.OperatorType
+		switch tok.Kind {
+		case sqltoken.Plus:
+			opType = sqlast.Plus
+		case sqltoken.Minus:
+			opType = sqlast.Minus
+		case sqltoken.Tilde:
+			opType = sqlast.BitwiseNot
+		}
 		return &sqlast.UnaryExpr{
 			From: tok.From,
-			Op:   &sqlast.Operator{Type: sqlast.Minus, From: tok.From, To: tok.To},
+			Op:   &sqlast.Operator{Type: opType, From: tok.From, To: tok.To},
 			Expr: expr,
 		}, nil
-	case sqltoken.Number, sqltoken.SingleQuotedString, sqltoken.NationalStringLiteral:
+	case sqltoken.Number, sqltoken.SingleQuotedString, sqltoken.NationalStringLiteral, sqltoken.UnicodeStringLiteral, sqltoken.DollarQuotedString:
 		p.prevToken()
 		v, err := p.parseSQLValue()
 		if err != nil {
-			return nil, errors.Errorf("parseSQLValue failed", err)
+			return nil, errors.Errorf("parseSQLValue failed: %w", err)
 		}
 		return v, nil
 	case sqltoken.LParen:
@@ -2326,7 +5357,7 @@ func (p *Parser) parsePrefix() (sqlast.Node, error) {
 				return nil, errors.Errorf("parseQuery failed: %w", err)
 			}
 			r, _ := p.nextToken()
-			if r.Kind != sqltoken.RParen {
+			if r == nil || r.Kind != sqltoken.RParen {
 				return nil, errors.Errorf("expected RParen but %+v", r)
 			}
 			ast = &sqlast.SubQuery{
@@ -2339,85 +5370,265 @@ func (p *Parser) parsePrefix() (sqlast.Node, error) {
 			if err != nil {
 				return nil, errors.Errorf("parseQuery failed: %w", err)
 			}
+			values := []sqlast.Node{expr}
+			if ok, _ := p.consumeToken(sqltoken.Comma); ok {
+				rest, err := p.parseExprList()
+				if err != nil {
+					return nil, errors.Errorf("parseExprList failed: %w", err)
+				}
+				values = append(values, rest...)
+			}
 			r, _ := p.nextToken()
-			if r.Kind != sqltoken.RParen {
+			if r == nil || r.Kind != sqltoken.RParen {
 				return nil, errors.Errorf("expected RParen but %+v", r)
 			}
-			ast = &sqlast.Nested{
-				LParen: tok.From,
-				RParen: r.To,
-				AST:    expr,
+			if len(values) > 1 {
+				ast = &sqlast.RowValueExpr{
+					LParen: tok.From,
+					RParen: r.To,
+					Values: values,
+				}
+			} else {
+				ast = &sqlast.Nested{
+					LParen: tok.From,
+					RParen: r.To,
+					AST:    expr,
+				}
+			}
+		}
+		return ast, nil
+	}
+	return nil, errors.Errorf("no prefix parser for %+v", tok)
+}
+
+// parseArrayConstructor parses `[elements...]` after ARRAY.
+func (p *Parser) parseArrayConstructor(array *sqltoken.Token) (sqlast.Node, error) {
+	p.mustNextToken()
+
+	var elements []sqlast.Node
+	if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.RBracket {
+		list, err := p.parseExprList()
+		if err != nil {
+			return nil, errors.Errorf("parseExprList failed: %w", err)
+		}
+		elements = list
+	}
+	r, err := p.requireToken(sqltoken.RBracket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlast.ArrayConstructor{
+		Array:    array.From,
+		Elements: elements,
+		RBracket: r.To,
+	}, nil
+}
+
+func (p *Parser) parseFunction(name *sqlast.ObjectName) (sqlast.Node, error) {
+	if _, err := p.requireToken(sqltoken.LParen); err != nil {
+		return nil, err
+	}
+
+	var quantifier sqlast.AggregateQuantifier
+	if ok, _, _ := p.parseKeyword("DISTINCT"); ok {
+		quantifier = sqlast.AggregateDistinct
+	} else if ok, _, _ := p.parseKeyword("ALL"); ok {
+		quantifier = sqlast.AggregateAll
+	}
+
+	args, err := p.parseFunctionArgs()
+	if err != nil {
+		return nil, errors.Errorf("parseFunctionArgs failed: %w", err)
+	}
+	if quantifier != sqlast.AggregateQuantifierNone && len(args) == 0 {
+		return nil, errors.Errorf("expected arguments after %s", quantifier.ToSQLString())
+	}
+
+	var orderBy []*sqlast.OrderByExpr
+	if ok, _, _ := p.parseKeywords("ORDER", "BY"); ok {
+		o, err := p.parseOrderByExprList()
+		if err != nil {
+			return nil, errors.Errorf("parseOrderByExprList failed: %w", err)
+		}
+		orderBy = o
+	}
+
+	var separator *sqlast.SingleQuotedString
+	if ok, _, _ := p.parseKeyword("SEPARATOR"); ok {
+		if !p.dialect.Supports(dialect.GroupConcatSeparator) {
+			return nil, errors.Errorf("SEPARATOR is only supported in MySQL dialect")
+		}
+		s, _ := p.peekToken()
+		if s == nil || s.Kind != sqltoken.SingleQuotedString {
+			return nil, errors.Errorf("expected string after SEPARATOR but %v", s)
+		}
+		p.mustNextToken()
+		separator = &sqlast.SingleQuotedString{
+			From:   s.From,
+			To:     s.To,
+			String: s.Value.(string),
+		}
+	}
+
+	r, _ := p.nextToken()
+	if r == nil || r.Kind != sqltoken.RParen {
+		return nil, errors.Errorf("expected RParen but %+v", r)
+	}
+
+	// WITHIN GROUP, FILTER and OVER are accepted in any order,
+	// and ToSQLString puts them in the standard order.
+	var withinGroup []*sqlast.OrderByExpr
+	var withinGroupRParen sqltoken.Pos
+	var filter sqlast.Node
+	var filterRParen sqltoken.Pos
+	var over *sqlast.WindowSpec
+	var overRParen sqltoken.Pos
+	for {
+		if ok, _, _ := p.parseKeywords("WITHIN", "GROUP"); ok {
+			if withinGroup != nil {
+				return nil, errors.Errorf("WITHIN GROUP is specified more than once")
+			}
+			if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.LParen {
+				return nil, p.unexpectedToken("LParen after WITHIN GROUP")
+			}
+			p.mustNextToken()
+			if ok, t, _ := p.parseKeywords("ORDER", "BY"); !ok {
+				return nil, errors.Errorf("expected ORDER BY in WITHIN GROUP but %v", t)
+			}
+			o, err := p.parseOrderByExprList()
+			if err != nil {
+				return nil, errors.Errorf("parseOrderByExprList failed: %w", err)
+			}
+			t, _ := p.peekToken()
+			if t == nil || t.Kind != sqltoken.RParen {
+				return nil, p.unexpectedToken("RParen")
+			}
+			p.mustNextToken()
+			withinGroup = o
+			withinGroupRParen = t.To
+			continue
+		}
+
+		if p.isFilterClause() {
+			if filter != nil {
+				return nil, errors.Errorf("FILTER is specified more than once")
+			}
+			p.mustNextToken()
+			p.mustNextToken()
+			if ok, t, _ := p.parseKeyword("WHERE"); !ok {
+				return nil, errors.Errorf("expected WHERE in FILTER but %v", t)
+			}
+			f, err := p.ParseExpr()
+			if err != nil {
+				return nil, errors.Errorf("ParseExpr failed: %w", err)
+			}
+			t, _ := p.peekToken()
+			if t == nil || t.Kind != sqltoken.RParen {
+				return nil, p.unexpectedToken("RParen")
+			}
+			p.mustNextToken()
+			filter = f
+			filterRParen = t.To
+			continue
+		}
+
+		if ok, _, _ := p.parseKeyword("OVER"); ok {
+			if over != nil {
+				return nil, errors.Errorf("OVER is specified more than once")
+			}
+			o, err := p.parseOver()
+			if err != nil {
+				return nil, errors.Errorf("parseOver failed: %w", err)
 			}
+			over = o
+			overRParen = p.tokens[p.index-1].To
+			continue
 		}
-		return ast, nil
+
+		break
 	}
-	log.Fatal("prefix parser expected a keyword but hit EOF")
-	return nil, nil
+
+	return &sqlast.Function{
+		Name:              name,
+		Quantifier:        quantifier,
+		Args:              args,
+		OrderBy:           orderBy,
+		Separator:         separator,
+		ArgsRParen:        r.To,
+		WithinGroup:       withinGroup,
+		WithinGroupRParen: withinGroupRParen,
+		Filter:            filter,
+		FilterRParen:      filterRParen,
+		Over:              over,
+		OverRparen:        overRParen,
+	}, nil
 }
 
-func (p *Parser) parseFunction(name *sqlast.ObjectName) (sqlast.Node, error) {
-	p.expectToken(sqltoken.LParen)
-	args, err := p.parseOptionalArgs()
-	if err != nil {
-		return nil, errors.Errorf("parseOptionalArgs failed: %w", err)
-	}
+// isFilterClause reports whether the next tokens are `FILTER (`.
+// FILTER not followed by `(` is an alias.
+func (p *Parser) isFilterClause() bool {
+	idx := p.index
+	defer func() {
+		p.index = idx
+	}()
 
-	r, _ := p.nextToken()
-	if r.Kind != sqltoken.RParen {
-		return nil, errors.Errorf("expected RParen but %+v", r)
+	if ok, _, _ := p.parseKeyword("FILTER"); !ok {
+		return false
 	}
+	t, _ := p.peekToken()
+	return t != nil && t.Kind == sqltoken.LParen
+}
 
-	var over *sqlast.WindowSpec
-	if ok, _, _ := p.parseKeyword("OVER"); ok {
-		p.expectToken(sqltoken.LParen)
-
-		var partitionBy []sqlast.Node
-		var partition sqltoken.Pos
+// parseOver parses ( window specification ) after OVER keyword.
+func (p *Parser) parseOver() (*sqlast.WindowSpec, error) {
+	if _, err := p.requireToken(sqltoken.LParen); err != nil {
+		return nil, err
+	}
 
-		ok, ptok, _ := p.parseKeyword("PARTITION")
-		if ok {
-			p.expectKeyword("BY")
+	var partitionBy []sqlast.Node
+	var partition sqltoken.Pos
 
-			el, err := p.parseExprList()
-			if err != nil {
-				return nil, errors.Errorf("parseExprList failed: %w", err)
-			}
-			partitionBy = el
-			partition = ptok.From
+	ok, ptok, _ := p.parseKeyword("PARTITION")
+	if ok {
+		if _, err := p.requireKeyword("BY"); err != nil {
+			return nil, err
 		}
 
-		var orderBy []*sqlast.OrderByExpr
-		var order sqltoken.Pos
-		ok, otok, _ := p.parseKeyword("ORDER")
-		if ok {
-			p.expectKeyword("BY")
-			el, err := p.parseOrderByExprList()
-			if err != nil {
-				return nil, errors.Errorf("parseOrderByExprList failed: %w", err)
-			}
-			orderBy = el
-			order = otok.From
+		el, err := p.parseExprList()
+		if err != nil {
+			return nil, errors.Errorf("parseExprList failed: %w", err)
 		}
+		partitionBy = el
+		partition = ptok.From
+	}
 
-		windowFrame, err := p.parseWindowFrame()
+	var orderBy []*sqlast.OrderByExpr
+	var order sqltoken.Pos
+	ok, otok, _ := p.parseKeyword("ORDER")
+	if ok {
+		if _, err := p.requireKeyword("BY"); err != nil {
+			return nil, err
+		}
+		el, err := p.parseOrderByExprList()
 		if err != nil {
-			return nil, errors.Errorf("parseWindowFrame failed: %w", err)
+			return nil, errors.Errorf("parseOrderByExprList failed: %w", err)
 		}
+		orderBy = el
+		order = otok.From
+	}
 
-		over = &sqlast.WindowSpec{
-			PartitionBy:  partitionBy,
-			OrderBy:      orderBy,
-			WindowsFrame: windowFrame,
-			Partition:    partition,
-			Order:        order,
-		}
+	windowFrame, err := p.parseWindowFrame()
+	if err != nil {
+		return nil, errors.Errorf("parseWindowFrame failed: %w", err)
 	}
 
-	return &sqlast.Function{
-		Name:       name,
-		Args:       args,
-		Over:       over,
-		ArgsRParen: r.To,
+	return &sqlast.WindowSpec{
+		PartitionBy:  partitionBy,
+		OrderBy:      orderBy,
+		WindowsFrame: windowFrame,
+		Partition:    partition,
+		Order:        order,
 	}, nil
 }
 
@@ -2434,6 +5645,75 @@ func (p *Parser) parseOptionalArgs() ([]sqlast.Node, error) {
 	}
 }
 
+// parseFunctionArgs parses positional arguments followed by named
+// arguments (`name => value` or `name := value`). It doesn't consume `)`.
+func (p *Parser) parseFunctionArgs() ([]sqlast.Node, error) {
+	if ok, _ := p.consumeToken(sqltoken.RParen); ok {
+		p.prevToken()
+		return nil, nil
+	}
+
+	var args []sqlast.Node
+	var named bool
+	for {
+		arg, err := p.parseNamedArg()
+		if err != nil {
+			return nil, errors.Errorf("parseNamedArg failed: %w", err)
+		}
+		if arg != nil {
+			named = true
+			args = append(args, arg)
+		} else {
+			expr, err := p.ParseExpr()
+			if err != nil {
+				return nil, errors.Errorf("ParseExpr failed: %w", err)
+			}
+			if named {
+				return nil, errors.Errorf("positional argument %s cannot follow named arguments", expr.ToSQLString())
+			}
+			args = append(args, expr)
+		}
+
+		if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.Comma {
+			p.mustNextToken()
+		} else {
+			break
+		}
+	}
+	return args, nil
+}
+
+// parseNamedArg returns nil without consuming tokens
+// if the next argument is not a named one.
+func (p *Parser) parseNamedArg() (*sqlast.NamedArg, error) {
+	idx := p.index
+	tok, err := p.nextToken()
+	if err != nil {
+		return nil, errors.Errorf("nextToken failed: %w", err)
+	}
+	if tok.Kind != sqltoken.SQLKeyword {
+		p.index = idx
+		return nil, nil
+	}
+	arrow, _ := p.peekToken()
+	if arrow == nil || (arrow.Kind != sqltoken.RArrow && arrow.Kind != sqltoken.ColonEq) {
+		p.index = idx
+		return nil, nil
+	}
+	p.mustNextToken()
+
+	value, err := p.ParseExpr()
+	if err != nil {
+		return nil, errors.Errorf("ParseExpr failed: %w", err)
+	}
+	word := tok.Value.(*sqltoken.SQLWord)
+	return &sqlast.NamedArg{
+		Name:    sqlast.NewIdentWithPos(word.String(), tok.From, tok.To),
+		ColonEq: arrow.Kind == sqltoken.ColonEq,
+		Value:   value,
+	}, nil
+}
+
 func (p *Parser) parseOrderByExprList() ([]*sqlast.OrderByExpr, error) {
 	var exprList []*sqlast.OrderByExpr
 
@@ -2443,18 +5723,22 @@ func (p *Parser) parseOrderByExprList() ([]*sqlast.OrderByExpr, error) {
 			return nil, errors.Errorf("ParseExpr failed: %w", err)
 		}
 		var asc *bool
+		var orderingPos sqltoken.Pos
 
-		if ok, _, _ := p.parseKeyword("ASC"); ok {
+		if ok, tok, _ := p.parseKeyword("ASC"); ok {
 			b := true
 			asc = &b
-		} else if ok, _, _ := p.parseKeyword("DESC"); ok {
+			orderingPos = tok.To
+		} else if ok, tok, _ := p.parseKeyword("DESC"); ok {
 			b := false
 			asc = &b
+			orderingPos = tok.To
 		}
 
 		exprList = append(exprList, &sqlast.OrderByExpr{
-			Expr: expr,
-			ASC:  asc,
+			Expr:        expr,
+			OrderingPos: orderingPos,
+			ASC:         asc,
 		})
 
 		if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.Comma {
@@ -2486,7 +5770,9 @@ func (p *Parser) parseWindowFrame() (*sqlast.WindowFrame, error) {
 			if err != nil {
 				return nil, errors.Errorf("parseWindowFrameBound: %w", err)
 			}
-			p.expectKeyword("AND")
+			if _, err := p.requireKeyword("AND"); err != nil {
+				return nil, err
+			}
 			endBound, err := p.parseWindowFrameBound()
 			if err != nil {
 				return nil, errors.Errorf("parseWindowFrameBound: %w", err)
@@ -2509,7 +5795,9 @@ func (p *Parser) parseWindowFrame() (*sqlast.WindowFrame, error) {
 		}
 	}
 
-	p.expectToken(sqltoken.RParen)
+	if _, err := p.requireToken(sqltoken.RParen); err != nil {
+		return nil, err
+	}
 	return windowFrame, nil
 }
 
@@ -2544,8 +5832,7 @@ func (p *Parser) parseWindowFrameBound() (sqlast.SQLWindowFrameBound, error) {
 	if ok, _, _ := p.parseKeyword("FOLLOWING"); ok {
 		return &sqlast.Following{Bound: rows}, nil
 	}
-	log.Fatal("expected PRECEDING or FOLLOWING")
-	return nil, nil
+	return nil, p.unexpectedToken("PRECEDING or FOLLOWING")
 }
 
 func (p *Parser) parseObjectName() (*sqlast.ObjectName, error) {
@@ -2627,22 +5914,63 @@ func (p *Parser) parseValue() (sqlast.Node, error) {
 			From:   tok.From,
 			To:     tok.To,
 		}, nil
+	case sqltoken.UnicodeStringLiteral:
+		return p.parseUnicodeString(tok)
+	case sqltoken.DollarQuotedString:
+		q := tok.Value.(*sqltoken.DollarQuote)
+		return &sqlast.DollarQuotedString{
+			From:   tok.From,
+			To:     tok.To,
+			Tag:    q.Tag,
+			String: q.Value,
+		}, nil
 	default:
 		return nil, errors.Errorf("unexpected sqltoken %v", tok)
 	}
 
 }
 
+// parseUnicodeString decodes U&'...' in tok with the escape character
+// given by optional `UESCAPE 'c'` after it.
+func (p *Parser) parseUnicodeString(tok *sqltoken.Token) (sqlast.Node, error) {
+	str := &sqlast.SingleQuotedString{
+		From:    tok.From,
+		To:      tok.To,
+		Unicode: true,
+	}
+	escape := '\\'
+	if ok, _, _ := p.parseKeyword("UESCAPE"); ok {
+		e, _ := p.peekToken()
+		if e == nil || e.Kind != sqltoken.SingleQuotedString {
+			return nil, errors.Errorf("expected escape character after UESCAPE but %v", e)
+		}
+		p.mustNextToken()
+		r := []rune(e.Value.(string))
+		if len(r) != 1 || strings.ContainsRune("0123456789abcdefABCDEF+'\" \t\r\n", r[0]) {
+			return nil, errors.Errorf("invalid escape character %s after UESCAPE", e.Value)
+		}
+		escape = r[0]
+		str.Escape = escape
+		str.To = e.To
+	}
+
+	s, err := sqltoken.DecodeUnicodeEscapes(tok.Value.(string), escape)
+	if err != nil {
+		return nil, errors.Errorf("DecodeUnicodeEscapes failed: %w", err)
+	}
+	str.String = s
+	return str, nil
+}
+
 func (p *Parser) parseOptionalPrecision() (*uint, sqltoken.Pos, error) {
 	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
 		n, _, err := p.parseLiteralInt()
 		if err != nil {
 			return nil, sqltoken.Pos{}, errors.Errorf("parseLiteralInt failed: %w", err)
 		}
-		tok, _ := p.nextToken()
-
-		if tok.Kind != sqltoken.RParen {
-			return nil, sqltoken.Pos{}, errors.Errorf("expected RParen but %s", tok)
+		tok, err := p.requireToken(sqltoken.RParen)
+		if err != nil {
+			return nil, sqltoken.Pos{}, err
 		}
 		i := uint(n)
 		return &i, tok.To, nil
@@ -2668,13 +5996,18 @@ func (p *Parser) parseOptionalPrecisionScale() (*uint, *uint, error) {
 		us := uint(s)
 		scale = &us
 	}
-	p.expectToken(sqltoken.RParen)
+	if _, err := p.requireToken(sqltoken.RParen); err != nil {
+		return nil, nil, err
+	}
 	i := uint(n)
 	return &i, scale, nil
 }
 
 func (p *Parser) parseLiteralInt() (int, *sqltoken.Token, error) {
-	tok, _ := p.nextToken()
+	tok, err := p.nextToken()
+	if err != nil {
+		return 0, nil, errors.Errorf("expect literal int: %w", err)
+	}
 	if tok.Kind != sqltoken.Number {
 		return 0, nil, errors.Errorf("expect literal int but %v", tok.Kind)
 	}
@@ -2690,10 +6023,12 @@ func (p *Parser) parseLiteralInt() (int, *sqltoken.Token, error) {
 func (p *Parser) parseListOfIds(separator sqltoken.Kind) ([]*sqlast.Ident, error) {
 	var idents []*sqlast.Ident
 	expectIdentifier := true
+	var eof error
 
 	for {
-		tok, _ := p.nextToken()
+		tok, err := p.nextToken()
 		if tok == nil {
+			eof = err
 			break
 		}
 		if tok.Kind == sqltoken.SQLKeyword && expectIdentifier {
@@ -2714,6 +6049,9 @@ func (p *Parser) parseListOfIds(separator sqltoken.Kind) ([]*sqlast.Ident, error
 	}
 
 	if expectIdentifier {
+		if eof != nil {
+			return nil, errors.Errorf("expect identifier: %w", eof)
+		}
 		return nil, errors.Errorf("expect identifier")
 	}
 
@@ -2733,7 +6071,9 @@ func (p *Parser) parseCaseExpression() (sqlast.Node, error) {
 			return nil, errors.Errorf("ParseExpr failed: %w", err)
 		}
 		operand = expr
-		p.expectKeyword("WHEN")
+		if _, err := p.requireKeyword("WHEN"); err != nil {
+			return nil, err
+		}
 	}
 
 	var conditions []sqlast.Node
@@ -2745,7 +6085,9 @@ func (p *Parser) parseCaseExpression() (sqlast.Node, error) {
 			return nil, errors.Errorf("ParseExpr failed: %w", err)
 		}
 		conditions = append(conditions, expr)
-		p.expectKeyword("THEN")
+		if _, err := p.requireKeyword("THEN"); err != nil {
+			return nil, err
+		}
 		result, err := p.ParseExpr()
 		if err != nil {
 			return nil, errors.Errorf("ParseExpr failed: %w", err)
@@ -2785,18 +6127,22 @@ func (p *Parser) parseCastExpression() (sqlast.Node, error) {
 	if !ok {
 		return nil, errors.Errorf("expected CAST but %+v", tok)
 	}
-	p.expectToken(sqltoken.LParen)
+	if _, err := p.requireToken(sqltoken.LParen); err != nil {
+		return nil, err
+	}
 	expr, err := p.ParseExpr()
 	if err != nil {
 		return nil, errors.Errorf("ParseExpr failed: %w", err)
 	}
-	p.expectKeyword("AS")
+	if _, err := p.requireKeyword("AS"); err != nil {
+		return nil, err
+	}
 	dataType, err := p.ParseDataType()
 	if err != nil {
-		return nil, errors.Errorf("ParseDataType")
+		return nil, errors.Errorf("ParseDataType failed: %w", err)
 	}
 	r, _ := p.nextToken()
-	if r.Kind != sqltoken.RParen {
+	if r == nil || r.Kind != sqltoken.RParen {
 		return nil, errors.Errorf("expect RParen but %+v", r)
 	}
 
@@ -2808,20 +6154,224 @@ func (p *Parser) parseCastExpression() (sqlast.Node, error) {
 	}, nil
 }
 
+// parseSpecialFunction parses TRIM, SUBSTRING, EXTRACT and POSITION whose
+// arguments are separated by keywords. Calls not in the keyword syntax,
+// e.g. SUBSTRING(s, 1, 3), are parsed as ordinary functions.
+func (p *Parser) parseSpecialFunction() (sqlast.Node, error) {
+	tok := p.mustNextToken()
+	word := tok.Value.(*sqltoken.SQLWord)
+	idx := p.index
+	if _, err := p.requireToken(sqltoken.LParen); err != nil {
+		return nil, err
+	}
+
+	var ast sqlast.Node
+	var err error
+	switch word.Keyword {
+	case "TRIM":
+		ast, err = p.parseTrim(tok)
+	case "SUBSTRING":
+		ast, err = p.parseSubstring(tok)
+	case "EXTRACT":
+		ast, err = p.parseExtract(tok)
+	case "POSITION":
+		ast, err = p.parsePosition(tok)
+	}
+	if err != nil {
+		return nil, errors.Errorf("parse %s failed: %w", word.Keyword, err)
+	}
+	if ast != nil {
+		return ast, nil
+	}
+
+	p.index = idx
+	name := &sqlast.ObjectName{
+		Idents: []*sqlast.Ident{
+			{Value: word.String(), From: tok.From, To: tok.To},
+		},
+	}
+	f, err := p.parseFunction(name)
+	if err != nil {
+		return nil, errors.Errorf("parseFunction failed: %w", err)
+	}
+	return f, nil
+}
+
+// parseTrim returns nil if the arguments are separated by comma.
+// Both the trim specification and the trim characters are optional,
+// and FROM may be omitted only if neither of them is present.
+func (p *Parser) parseTrim(trimTok *sqltoken.Token) (sqlast.Node, error) {
+	spec := sqlast.TrimSpecNone
+	if ok, _, _ := p.parseKeyword("BOTH"); ok {
+		spec = sqlast.TrimBoth
+	} else if ok, _, _ := p.parseKeyword("LEADING"); ok {
+		spec = sqlast.TrimLeading
+	} else if ok, _, _ := p.parseKeyword("TRAILING"); ok {
+		spec = sqlast.TrimTrailing
+	}
+
+	var chars sqlast.Node
+	if ok, _, _ := p.parseKeyword("FROM"); !ok {
+		expr, err := p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		if ok, _, _ := p.parseKeyword("FROM"); !ok {
+			if spec != sqlast.TrimSpecNone {
+				return nil, p.unexpectedToken("FROM")
+			}
+			r, _ := p.nextToken()
+			if r == nil || r.Kind != sqltoken.RParen {
+				return nil, nil
+			}
+			return &sqlast.TrimExpr{
+				Trim:   trimTok.From,
+				Expr:   expr,
+				RParen: r.To,
+			}, nil
+		}
+		chars = expr
+	}
+
+	expr, err := p.ParseExpr()
+	if err != nil {
+		return nil, errors.Errorf("ParseExpr failed: %w", err)
+	}
+	r, _ := p.nextToken()
+	if r == nil || r.Kind != sqltoken.RParen {
+		return nil, errors.Errorf("expected RParen but %+v", r)
+	}
+
+	return &sqlast.TrimExpr{
+		Trim:   trimTok.From,
+		Spec:   spec,
+		Chars:  chars,
+		From:   true,
+		Expr:   expr,
+		RParen: r.To,
+	}, nil
+}
+
+// parseSubstring returns nil if neither FROM nor FOR follows the first argument.
+func (p *Parser) parseSubstring(substringTok *sqltoken.Token) (sqlast.Node, error) {
+	expr, err := p.ParseExpr()
+	if err != nil {
+		return nil, errors.Errorf("ParseExpr failed: %w", err)
+	}
+
+	var from, forExpr sqlast.Node
+	if ok, _, _ := p.parseKeyword("FROM"); ok {
+		from, err = p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
+		}
+	}
+	if ok, _, _ := p.parseKeyword("FOR"); ok {
+		forExpr, err = p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
+		}
+	}
+	if from == nil && forExpr == nil {
+		return nil, nil
+	}
+
+	r, _ := p.nextToken()
+	if r == nil || r.Kind != sqltoken.RParen {
+		return nil, errors.Errorf("expected RParen but %+v", r)
+	}
+
+	return &sqlast.SubstringExpr{
+		Substring: substringTok.From,
+		Expr:      expr,
+		From:      from,
+		For:       forExpr,
+		RParen:    r.To,
+	}, nil
+}
+
+// parseExtract accepts any field name since the fields differ among dialects,
+// e.g. EPOCH and ISODOW of PostgreSQL, and the field is kept as it is written.
+func (p *Parser) parseExtract(extractTok *sqltoken.Token) (sqlast.Node, error) {
+	extract := &sqlast.ExtractExpr{
+		Extract: extractTok.From,
+	}
+	if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.SingleQuotedString {
+		// EXTRACT('epoch' FROM ts) of PostgreSQL
+		p.mustNextToken()
+		extract.FieldString = t.Value.(string)
+	} else {
+		f, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		extract.Field = f
+	}
+	if ok, t, _ := p.parseKeyword("FROM"); !ok {
+		return nil, errors.Errorf("expected FROM but %+v", t)
+	}
+	expr, err := p.ParseExpr()
+	if err != nil {
+		return nil, errors.Errorf("ParseExpr failed: %w", err)
+	}
+	r, _ := p.nextToken()
+	if r == nil || r.Kind != sqltoken.RParen {
+		return nil, errors.Errorf("expected RParen but %+v", r)
+	}
+
+	extract.Expr = expr
+	extract.RParen = r.To
+
+	return extract, nil
+}
+
+// parsePosition returns nil if the arguments are not separated by IN.
+func (p *Parser) parsePosition(positionTok *sqltoken.Token) (sqlast.Node, error) {
+	// parse the substring above the precedence of IN so that IN is left
+	ts := &sqltoken.Token{
+		Kind:  sqltoken.SQLKeyword,
+		Value: sqltoken.MakeKeyword("IN", 0),
+	}
+	substr, err := p.parseSubexpr(p.getPrecedence(ts))
+	if err != nil {
+		return nil, errors.Errorf("parseSubexpr failed: %w", err)
+	}
+	if ok, _, _ := p.parseKeyword("IN"); !ok {
+		return nil, nil
+	}
+	expr, err := p.ParseExpr()
+	if err != nil {
+		return nil, errors.Errorf("ParseExpr failed: %w", err)
+	}
+	r, _ := p.nextToken()
+	if r == nil || r.Kind != sqltoken.RParen {
+		return nil, errors.Errorf("expected RParen but %+v", r)
+	}
+
+	return &sqlast.PositionExpr{
+		Position: positionTok.From,
+		Substr:   substr,
+		Expr:     expr,
+		RParen:   r.To,
+	}, nil
+}
+
 func (p *Parser) parseExistsExpression(negatedTok *sqltoken.Token) (sqlast.Node, error) {
 	ok, tok, _ := p.parseKeyword("EXISTS")
 	if !ok {
 		return nil, errors.Errorf("expect EXISTS but %+v", tok)
 	}
 
-	p.expectToken(sqltoken.LParen)
+	if _, err := p.requireToken(sqltoken.LParen); err != nil {
+		return nil, err
+	}
 	expr, err := p.parseQuery()
 	if err != nil {
 		return nil, errors.Errorf("parseQuery failed: %w", err)
 	}
 
 	r, _ := p.nextToken()
-	if r.Kind != sqltoken.RParen {
+	if r == nil || r.Kind != sqltoken.RParen {
 		return nil, errors.Errorf("expect RParen but %+v", r)
 	}
 
@@ -2876,6 +6426,42 @@ func (p *Parser) expectToken(expected sqltoken.Kind) {
 	}
 }
 
+// requireKeyword consumes the expected keyword like expectKeyword, but returns
+// an error instead of exiting if the next token is not the keyword.
+func (p *Parser) requireKeyword(expected string) (*sqltoken.Token, error) {
+	ok, tok, err := p.parseKeyword(expected)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Errorf("expected %s but %+v", expected, tok)
+	}
+	return tok, nil
+}
+
+// requireToken consumes a token of the expected kind like expectToken, but
+// returns an error instead of exiting if the next token is another one.
+func (p *Parser) requireToken(expected sqltoken.Kind) (*sqltoken.Token, error) {
+	tok, err := p.peekToken()
+	if err != nil {
+		return nil, errors.Errorf("expected %s: %w", expected, err)
+	}
+	if tok.Kind != expected {
+		return nil, errors.Errorf("expected %s but %+v", expected, tok)
+	}
+	return p.mustNextToken(), nil
+}
+
+// unexpectedToken returns an error telling that the next token is not the
+// expected one. The error wraps EOF if there are no tokens left.
+func (p *Parser) unexpectedToken(expected string) error {
+	tok, err := p.peekToken()
+	if err != nil {
+		return errors.Errorf("expected %s: %w", expected, err)
+	}
+	return errors.Errorf("expected %s but %+v", expected, tok)
+}
+
 func (p *Parser) consumeToken(expected sqltoken.Kind) (bool, error) {
 	tok, err := p.peekToken()
 	if err != nil {
@@ -3011,6 +6597,9 @@ func (p *Parser) nextTokenWithParseComment() (*sqltoken.Token, error) {
 
 var EOF = errors.New("tokens are already consumed")
 
+// ErrUnexpectedEOF is returned when the input ends in the middle of a statement.
+var ErrUnexpectedEOF = errors.New("unexpected end of input")
+
 func (p *Parser) nextTokenNoSkip() (*sqltoken.Token, error) {
 	if p.index < uint(len(p.tokens)) {
 		p.index += 1
@@ -2,9 +2,14 @@ package xsqlparser
 
 import (
 	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	errors "golang.org/x/xerrors"
 
 	"github.com/akito0107/xsqlparser/dialect"
 	"github.com/akito0107/xsqlparser/sqlast"
@@ -578,6 +583,7 @@ GROUP BY region, product`,
 									},
 								},
 							},
+							RParen: sqltoken.NewPos(1, 95),
 						},
 					},
 					Body: &sqlast.SQLSelect{
@@ -908,371 +914,421 @@ FROM user WHERE id BETWEEN 1 AND 2`,
 					},
 				},
 			},
-		}
-
-		for _, c := range cases {
-
-			t.Run(c.name, func(t *testing.T) {
-				if c.skip {
-					t.Skip()
-				}
-				parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
-				if err != nil {
-					t.Fatal(err)
-				}
-				ast, err := parser.ParseStatement()
-				if err != nil {
-					t.Fatal(err)
-				}
-
-				if diff := CompareWithoutMarker(c.out, ast); diff != "" {
-					t.Errorf("diff %s", diff)
-				}
-			})
-		}
-	})
-
-	t.Run("create", func(t *testing.T) {
-		cases := []struct {
-			name string
-			in   string
-			out  sqlast.Stmt
-			skip bool
-		}{
 			{
-				name: "create table",
-				in: `
-CREATE TABLE persons (
- person_id UUID PRIMARY KEY NOT NULL,
- first_name varchar(255) UNIQUE,
- last_name character varying(255) NOT NULL,
- created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
-)`,
-				out: &sqlast.CreateTableStmt{
-					Create: sqltoken.NewPos(2, 1),
-					Name: &sqlast.ObjectName{
-						Idents: []*sqlast.Ident{
-							{
-								Value: "persons",
-								From:  sqltoken.NewPos(2, 14),
-								To:    sqltoken.NewPos(2, 21),
+				name: "at time zone",
+				in:   "SELECT ts AT TIME ZONE 'UTC' AT TIME ZONE 'PST' FROM events",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.NewPos(1, 1),
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.AtTimeZone{
+									Expr: &sqlast.AtTimeZone{
+										Expr: sqlast.NewIdentWithPos("ts", sqltoken.NewPos(1, 8), sqltoken.NewPos(1, 10)),
+										Zone: &sqlast.SingleQuotedString{
+											From:   sqltoken.NewPos(1, 24),
+											To:     sqltoken.NewPos(1, 29),
+											String: "UTC",
+										},
+										At: sqltoken.NewPos(1, 11),
+									},
+									Zone: &sqlast.SingleQuotedString{
+										From:   sqltoken.NewPos(1, 43),
+										To:     sqltoken.NewPos(1, 48),
+										String: "PST",
+									},
+									At: sqltoken.NewPos(1, 30),
+								},
 							},
 						},
-					},
-					Elements: []sqlast.TableElement{
-						&sqlast.ColumnDef{
-							Name: &sqlast.Ident{
-								Value: "person_id",
-								From:  sqltoken.NewPos(3, 2),
-								To:    sqltoken.NewPos(3, 11),
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("events", sqltoken.NewPos(1, 54), sqltoken.NewPos(1, 60)),
+									},
+								},
 							},
-							DataType: &sqlast.UUID{
-								From: sqltoken.NewPos(3, 12),
-								To:   sqltoken.NewPos(3, 16),
+						},
+					},
+				},
+			},
+			{
+				name: "for update skip locked",
+				in:   "SELECT id FROM jobs FOR UPDATE OF jobs SKIP LOCKED",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.NewPos(1, 1),
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: sqlast.NewIdentWithPos("id", sqltoken.NewPos(1, 8), sqltoken.NewPos(1, 10)),
 							},
-							Constraints: []*sqlast.ColumnConstraint{
-								{
-									Spec: &sqlast.UniqueColumnSpec{
-										IsPrimaryKey: true,
-										Primary:      sqltoken.NewPos(3, 17),
-										Key:          sqltoken.NewPos(3, 28),
+						},
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("jobs", sqltoken.NewPos(1, 16), sqltoken.NewPos(1, 20)),
 									},
 								},
+							},
+						},
+					},
+					Locks: []*sqlast.LockingClause{
+						{
+							Strength: sqlast.ForUpdate,
+							Tables: []*sqlast.ObjectName{
 								{
-									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(3, 29),
-										Null: sqltoken.NewPos(3, 37),
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("jobs", sqltoken.NewPos(1, 35), sqltoken.NewPos(1, 39)),
 									},
 								},
 							},
+							WaitPolicy: sqlast.SkipLocked,
+							For:        sqltoken.NewPos(1, 21),
+							To:         sqltoken.NewPos(1, 51),
 						},
-						&sqlast.ColumnDef{
-							Name: &sqlast.Ident{
-								Value: "first_name",
-								From:  sqltoken.NewPos(4, 2),
-								To:    sqltoken.NewPos(4, 12),
-							},
-							DataType: &sqlast.VarcharType{
-								Size:      sqlast.NewSize(255),
-								Character: sqltoken.NewPos(4, 13),
-								RParen:    sqltoken.NewPos(4, 25),
+					},
+				},
+			},
+			{
+				name: "offset fetch with placeholders",
+				in:   "SELECT id FROM t OFFSET $1 ROWS FETCH NEXT $2 ROWS ONLY",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.NewPos(1, 1),
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: sqlast.NewIdentWithPos("id", sqltoken.NewPos(1, 8), sqltoken.NewPos(1, 10)),
 							},
-							Constraints: []*sqlast.ColumnConstraint{
-								{
-									Spec: &sqlast.UniqueColumnSpec{
-										Unique: sqltoken.NewPos(4, 26),
+						},
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 16), sqltoken.NewPos(1, 17)),
 									},
 								},
 							},
 						},
-						&sqlast.ColumnDef{
-							Name: &sqlast.Ident{
-								Value: "last_name",
-								From:  sqltoken.NewPos(5, 2),
-								To:    sqltoken.NewPos(5, 11),
+					},
+					Offset: &sqlast.OffsetExpr{
+						Offset: sqltoken.NewPos(1, 18),
+						Value: &sqlast.Placeholder{
+							Value: "$1",
+							From:  sqltoken.NewPos(1, 25),
+							To:    sqltoken.NewPos(1, 27),
+						},
+						Unit: sqlast.FetchUnitRows,
+						To:   sqltoken.NewPos(1, 32),
+					},
+					Fetch: &sqlast.FetchExpr{
+						Fetch: sqltoken.NewPos(1, 33),
+						Next:  true,
+						Quantity: &sqlast.Placeholder{
+							Value: "$2",
+							From:  sqltoken.NewPos(1, 44),
+							To:    sqltoken.NewPos(1, 46),
+						},
+						Unit: sqlast.FetchUnitRows,
+						To:   sqltoken.NewPos(1, 56),
+					},
+				},
+			},
+			{
+				name: "ordinal group by and order by",
+				in:   "SELECT a, b FROM t GROUP BY 1 ORDER BY 2 DESC",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.NewPos(1, 1),
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 8), sqltoken.NewPos(1, 9)),
 							},
-							DataType: &sqlast.VarcharType{
-								Size:      sqlast.NewSize(255),
-								Character: sqltoken.NewPos(5, 12),
-								Varying:   sqltoken.NewPos(5, 29),
-								RParen:    sqltoken.NewPos(5, 34),
+							&sqlast.UnnamedSelectItem{
+								Node: sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 11), sqltoken.NewPos(1, 12)),
 							},
-							Constraints: []*sqlast.ColumnConstraint{
-								{
-									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(5, 35),
-										Null: sqltoken.NewPos(5, 43),
+						},
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 18), sqltoken.NewPos(1, 19)),
 									},
 								},
 							},
 						},
-						&sqlast.ColumnDef{
-							Name: &sqlast.Ident{
-								Value: "created_at",
-								From:  sqltoken.NewPos(6, 2),
-								To:    sqltoken.NewPos(6, 12),
-							},
-							DataType: &sqlast.Timestamp{
-								Timestamp: sqltoken.NewPos(6, 13),
-							},
-							Default: &sqlast.Ident{
-								Value: "CURRENT_TIMESTAMP",
-								From:  sqltoken.NewPos(6, 31),
-								To:    sqltoken.NewPos(6, 48),
+						GroupByClause: []sqlast.Node{
+							&sqlast.LongValue{
+								From: sqltoken.NewPos(1, 29),
+								To:   sqltoken.NewPos(1, 30),
+								Long: 1,
 							},
-							Constraints: []*sqlast.ColumnConstraint{
-								{
-									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(6, 49),
-										Null: sqltoken.NewPos(6, 57),
-									},
-								},
+						},
+					},
+					OrderBy: []*sqlast.OrderByExpr{
+						{
+							Expr: &sqlast.LongValue{
+								From: sqltoken.NewPos(1, 40),
+								To:   sqltoken.NewPos(1, 41),
+								Long: 2,
 							},
+							OrderingPos: sqltoken.NewPos(1, 46),
+							ASC:         func() *bool { b := false; return &b }(),
 						},
 					},
 				},
 			},
 			{
-				name: "with case",
-				in: `CREATE TABLE persons (
-person_id int PRIMARY KEY NOT NULL,
-last_name character varying(255) NOT NULL,
-test_id int NOT NULL REFERENCES test(id1),
-email character varying(255) UNIQUE NOT NULL,
-age int NOT NULL CHECK(age > 0 AND age < 100),
-created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
-)`,
-				out: &sqlast.CreateTableStmt{
-					Create: sqltoken.NewPos(1, 1),
-					Name: &sqlast.ObjectName{
-						Idents: []*sqlast.Ident{
-							{
-								Value: "persons",
-								From:  sqltoken.NewPos(1, 14),
-								To:    sqltoken.NewPos(1, 21),
+				name: "tablesample bernoulli",
+				in:   "SELECT * FROM t AS s TABLESAMPLE BERNOULLI(10) REPEATABLE (42)",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.NewPos(1, 1),
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.Wildcard{Wildcard: sqltoken.NewPos(1, 8)},
 							},
 						},
-					},
-					Elements: []sqlast.TableElement{
-						&sqlast.ColumnDef{
-							Name: &sqlast.Ident{
-								Value: "person_id",
-								From:  sqltoken.NewPos(2, 1),
-								To:    sqltoken.NewPos(2, 10),
-							},
-							DataType: &sqlast.Int{
-								From: sqltoken.NewPos(2, 11),
-								To:   sqltoken.NewPos(2, 14),
-							},
-							Constraints: []*sqlast.ColumnConstraint{
-								{
-									Spec: &sqlast.UniqueColumnSpec{
-										IsPrimaryKey: true,
-										Primary:      sqltoken.NewPos(2, 15),
-										Key:          sqltoken.NewPos(2, 26),
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 15), sqltoken.NewPos(1, 16)),
 									},
 								},
-								{
-									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(2, 27),
-										Null: sqltoken.NewPos(2, 35),
+								Alias: sqlast.NewIdentWithPos("s", sqltoken.NewPos(1, 20), sqltoken.NewPos(1, 21)),
+								Sample: &sqlast.TableSample{
+									TableSample: sqltoken.NewPos(1, 22),
+									Method:      sqlast.NewIdentWithPos("BERNOULLI", sqltoken.NewPos(1, 34), sqltoken.NewPos(1, 43)),
+									Args: []sqlast.Node{
+										&sqlast.LongValue{
+											From: sqltoken.NewPos(1, 44),
+											To:   sqltoken.NewPos(1, 46),
+											Long: 10,
+										},
 									},
+									RParen: sqltoken.NewPos(1, 47),
+									Seed: &sqlast.LongValue{
+										From: sqltoken.NewPos(1, 60),
+										To:   sqltoken.NewPos(1, 62),
+										Long: 42,
+									},
+									SeedRParen: sqltoken.NewPos(1, 63),
 								},
 							},
 						},
-						&sqlast.ColumnDef{
-							Name: &sqlast.Ident{
-								Value: "last_name",
-								From:  sqltoken.NewPos(3, 1),
-								To:    sqltoken.NewPos(3, 10),
+					},
+				},
+			},
+			{
+				name: "select without from",
+				in:   "SELECT 1, 'a', current_timestamp",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.NewPos(1, 1),
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.LongValue{
+									From: sqltoken.NewPos(1, 8),
+									To:   sqltoken.NewPos(1, 9),
+									Long: 1,
+								},
 							},
-							DataType: &sqlast.VarcharType{
-								Size:      sqlast.NewSize(255),
-								Character: sqltoken.NewPos(3, 11),
-								Varying:   sqltoken.NewPos(3, 28),
-								RParen:    sqltoken.NewPos(3, 33),
-							},
-							Constraints: []*sqlast.ColumnConstraint{
-								{
-									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(3, 34),
-										Null: sqltoken.NewPos(3, 42),
-									},
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.SingleQuotedString{
+									From:   sqltoken.NewPos(1, 11),
+									To:     sqltoken.NewPos(1, 14),
+									String: "a",
 								},
 							},
+							&sqlast.UnnamedSelectItem{
+								Node: sqlast.NewIdentWithPos(
+									"current_timestamp",
+									sqltoken.NewPos(1, 16),
+									sqltoken.NewPos(1, 33),
+								),
+							},
 						},
-						&sqlast.ColumnDef{
-							Name: &sqlast.Ident{
-								Value: "test_id",
-								From:  sqltoken.NewPos(4, 1),
-								To:    sqltoken.NewPos(4, 8),
+					},
+				},
+			},
+			{
+				name: "comma separated tables with join",
+				in:   "SELECT * FROM a, b JOIN c ON b.id = c.id",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.NewPos(1, 1),
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.Wildcard{Wildcard: sqltoken.NewPos(1, 8)},
 							},
-							DataType: &sqlast.Int{
-								From: sqltoken.NewPos(4, 9),
-								To:   sqltoken.NewPos(4, 12),
+						},
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 15), sqltoken.NewPos(1, 16)),
+									},
+								},
 							},
-							Constraints: []*sqlast.ColumnConstraint{
-								{
-									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(4, 13),
-										Null: sqltoken.NewPos(4, 21),
+							&sqlast.QualifiedJoin{
+								LeftElement: &sqlast.TableJoinElement{
+									Ref: &sqlast.Table{
+										Name: &sqlast.ObjectName{
+											Idents: []*sqlast.Ident{
+												sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 18), sqltoken.NewPos(1, 19)),
+											},
+										},
 									},
 								},
-								{
-									Spec: &sqlast.ReferencesColumnSpec{
-										References: sqltoken.NewPos(4, 22),
-										RParen:     sqltoken.NewPos(4, 42),
-										TableName: &sqlast.ObjectName{
+								Type: &sqlast.JoinType{Condition: sqlast.IMPLICIT},
+								RightElement: &sqlast.TableJoinElement{
+									Ref: &sqlast.Table{
+										Name: &sqlast.ObjectName{
 											Idents: []*sqlast.Ident{
-												{
-													Value: "test",
-													From:  sqltoken.NewPos(4, 33),
-													To:    sqltoken.NewPos(4, 37),
-												},
+												sqlast.NewIdentWithPos("c", sqltoken.NewPos(1, 25), sqltoken.NewPos(1, 26)),
 											},
 										},
-										Columns: []*sqlast.Ident{
-											&sqlast.Ident{
-												Value: "id1",
-												From:  sqltoken.NewPos(4, 38),
-												To:    sqltoken.NewPos(4, 41),
+									},
+								},
+								Spec: &sqlast.JoinCondition{
+									On: sqltoken.NewPos(1, 27),
+									SearchCondition: &sqlast.BinaryExpr{
+										Left: &sqlast.CompoundIdent{
+											Idents: []*sqlast.Ident{
+												sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 30), sqltoken.NewPos(1, 31)),
+												sqlast.NewIdentWithPos("id", sqltoken.NewPos(1, 32), sqltoken.NewPos(1, 34)),
+											},
+										},
+										Op: &sqlast.Operator{Type: sqlast.Eq, From: sqltoken.NewPos(1, 35), To: sqltoken.NewPos(1, 36)},
+										Right: &sqlast.CompoundIdent{
+											Idents: []*sqlast.Ident{
+												sqlast.NewIdentWithPos("c", sqltoken.NewPos(1, 37), sqltoken.NewPos(1, 38)),
+												sqlast.NewIdentWithPos("id", sqltoken.NewPos(1, 39), sqltoken.NewPos(1, 41)),
 											},
 										},
 									},
 								},
 							},
 						},
-						&sqlast.ColumnDef{
-							Name: &sqlast.Ident{
-								Value: "email",
-								From:  sqltoken.NewPos(5, 1),
-								To:    sqltoken.NewPos(5, 6),
+					},
+				},
+			},
+			{
+				name: "implicit alias",
+				in:   "SELECT a x, b AS y FROM t s",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.NewPos(1, 1),
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.AliasSelectItem{
+								Expr:          sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 8), sqltoken.NewPos(1, 9)),
+								Alias:         sqlast.NewIdentWithPos("x", sqltoken.NewPos(1, 10), sqltoken.NewPos(1, 11)),
+								ImplicitAlias: true,
 							},
-							DataType: &sqlast.VarcharType{
-								Size:      sqlast.NewSize(255),
-								Character: sqltoken.NewPos(5, 7),
-								Varying:   sqltoken.NewPos(5, 24),
-								RParen:    sqltoken.NewPos(5, 29),
+							&sqlast.AliasSelectItem{
+								Expr:  sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 14)),
+								Alias: sqlast.NewIdentWithPos("y", sqltoken.NewPos(1, 18), sqltoken.NewPos(1, 19)),
 							},
-							Constraints: []*sqlast.ColumnConstraint{
-								{
-									Spec: &sqlast.UniqueColumnSpec{
-										Unique: sqltoken.NewPos(5, 30),
-									},
-								},
-								{
-									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(5, 37),
-										Null: sqltoken.NewPos(5, 45),
+						},
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 25), sqltoken.NewPos(1, 26)),
 									},
 								},
+								Alias:         sqlast.NewIdentWithPos("s", sqltoken.NewPos(1, 27), sqltoken.NewPos(1, 28)),
+								ImplicitAlias: true,
 							},
 						},
-						&sqlast.ColumnDef{
-							Name: &sqlast.Ident{
-								Value: "age",
-								From:  sqltoken.NewPos(6, 1),
-								To:    sqltoken.NewPos(6, 4),
-							},
-							DataType: &sqlast.Int{
-								From: sqltoken.NewPos(6, 5),
-								To:   sqltoken.NewPos(6, 8),
-							},
-							Constraints: []*sqlast.ColumnConstraint{
-								{
-									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(6, 9),
-										Null: sqltoken.NewPos(6, 17),
+					},
+				},
+			},
+			{
+				name: "parenthesized set operation operands",
+				in:   "(SELECT 1) UNION (SELECT 2) ORDER BY 1",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SetOperationExpr{
+						Op: &sqlast.UnionOperator{
+							From: sqltoken.NewPos(1, 12),
+							To:   sqltoken.NewPos(1, 17),
+						},
+						Left: &sqlast.QueryExpr{
+							LParen: sqltoken.NewPos(1, 1),
+							RParen: sqltoken.NewPos(1, 11),
+							Query: &sqlast.QueryStmt{
+								Body: &sqlast.SQLSelect{
+									Select: sqltoken.NewPos(1, 2),
+									Projection: []sqlast.SQLSelectItem{
+										&sqlast.UnnamedSelectItem{
+											Node: &sqlast.LongValue{
+												From: sqltoken.NewPos(1, 9),
+												To:   sqltoken.NewPos(1, 10),
+												Long: 1,
+											},
+										},
 									},
 								},
-								{
-									Spec: &sqlast.CheckColumnSpec{
-										Check:  sqltoken.NewPos(6, 18),
-										RParen: sqltoken.NewPos(6, 46),
-										Expr: &sqlast.BinaryExpr{
-											Op: &sqlast.Operator{
-												Type: sqlast.And,
-												From: sqltoken.NewPos(6, 32),
-												To:   sqltoken.NewPos(6, 35),
-											},
-											Left: &sqlast.BinaryExpr{
-												Op: &sqlast.Operator{
-													Type: sqlast.Gt,
-													From: sqltoken.NewPos(6, 28),
-													To:   sqltoken.NewPos(6, 29),
-												},
-												Left: &sqlast.Ident{
-													Value: "age",
-													From:  sqltoken.NewPos(6, 24),
-													To:    sqltoken.NewPos(6, 27),
-												},
-												Right: &sqlast.LongValue{
-													From: sqltoken.NewPos(6, 30),
-													To:   sqltoken.NewPos(6, 31),
-													Long: 0,
-												},
-											},
-											Right: &sqlast.BinaryExpr{
-												Op: &sqlast.Operator{
-													Type: sqlast.Lt,
-													From: sqltoken.NewPos(6, 40),
-													To:   sqltoken.NewPos(6, 41),
-												},
-												Left: &sqlast.Ident{
-													Value: "age",
-													From:  sqltoken.NewPos(6, 36),
-													To:    sqltoken.NewPos(6, 39),
-												},
-												Right: &sqlast.LongValue{
-													From: sqltoken.NewPos(6, 42),
-													To:   sqltoken.NewPos(6, 45),
-													Long: 100,
-												},
+							},
+						},
+						Right: &sqlast.QueryExpr{
+							LParen: sqltoken.NewPos(1, 18),
+							RParen: sqltoken.NewPos(1, 28),
+							Query: &sqlast.QueryStmt{
+								Body: &sqlast.SQLSelect{
+									Select: sqltoken.NewPos(1, 19),
+									Projection: []sqlast.SQLSelectItem{
+										&sqlast.UnnamedSelectItem{
+											Node: &sqlast.LongValue{
+												From: sqltoken.NewPos(1, 26),
+												To:   sqltoken.NewPos(1, 27),
+												Long: 2,
 											},
 										},
 									},
 								},
 							},
 						},
-						&sqlast.ColumnDef{
-							Name: &sqlast.Ident{
-								Value: "created_at",
-								From:  sqltoken.NewPos(7, 1),
-								To:    sqltoken.NewPos(7, 11),
+					},
+					OrderBy: []*sqlast.OrderByExpr{
+						{
+							Expr: &sqlast.LongValue{
+								From: sqltoken.NewPos(1, 38),
+								To:   sqltoken.NewPos(1, 39),
+								Long: 1,
 							},
-							DataType: &sqlast.Timestamp{
-								Timestamp: sqltoken.NewPos(7, 12),
+						},
+					},
+				},
+			},
+			{
+				name: "select into",
+				in:   "SELECT a INTO TEMP TABLE t2 FROM t1",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.NewPos(1, 1),
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 8), sqltoken.NewPos(1, 9)),
 							},
-							Default: &sqlast.Ident{
-								Value: "CURRENT_TIMESTAMP",
-								From:  sqltoken.NewPos(7, 30),
-								To:    sqltoken.NewPos(7, 47),
+						},
+						Into: &sqlast.SelectInto{
+							Into:      sqltoken.NewPos(1, 10),
+							Temporary: true,
+							Table:     true,
+							Name: &sqlast.ObjectName{
+								Idents: []*sqlast.Ident{
+									sqlast.NewIdentWithPos("t2", sqltoken.NewPos(1, 26), sqltoken.NewPos(1, 28)),
+								},
 							},
-							Constraints: []*sqlast.ColumnConstraint{
-								{
-									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(7, 48),
-										Null: sqltoken.NewPos(7, 56),
+						},
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("t1", sqltoken.NewPos(1, 34), sqltoken.NewPos(1, 36)),
 									},
 								},
 							},
@@ -1281,116 +1337,84 @@ created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
 				},
 			},
 			{
-				name: "with table constraint",
-				in: `CREATE TABLE persons (
-person_id int,
-CONSTRAINT production UNIQUE(test_column),
-PRIMARY KEY(person_id),
-CHECK(id > 100),
-FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
-)`,
-				out: &sqlast.CreateTableStmt{
-					Create: sqltoken.NewPos(1, 1),
-					Name: &sqlast.ObjectName{
-						Idents: []*sqlast.Ident{
-							{
-								Value: "persons",
-								From:  sqltoken.NewPos(1, 14),
-								To:    sqltoken.NewPos(1, 21),
+				name: "table function with ordinality",
+				in:   "SELECT * FROM unnest(arr) WITH ORDINALITY AS t",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.NewPos(1, 1),
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.Wildcard{Wildcard: sqltoken.NewPos(1, 8)},
 							},
 						},
-					},
-					Elements: []sqlast.TableElement{
-						&sqlast.ColumnDef{
-							Name: &sqlast.Ident{
-								Value: "person_id",
-								From:  sqltoken.NewPos(2, 1),
-								To:    sqltoken.NewPos(2, 10),
-							},
-							DataType: &sqlast.Int{
-								From: sqltoken.NewPos(2, 11),
-								To:   sqltoken.NewPos(2, 14),
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("unnest", sqltoken.NewPos(1, 15), sqltoken.NewPos(1, 21)),
+									},
+								},
+								Args: []sqlast.Node{
+									sqlast.NewIdentWithPos("arr", sqltoken.NewPos(1, 22), sqltoken.NewPos(1, 25)),
+								},
+								ArgsRParen:     sqltoken.NewPos(1, 26),
+								WithOrdinality: true,
+								OrdinalityEnd:  sqltoken.NewPos(1, 42),
+								Alias:          sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 46), sqltoken.NewPos(1, 47)),
 							},
 						},
-						&sqlast.TableConstraint{
-							Constraint: sqltoken.NewPos(3, 1),
-							Name: &sqlast.Ident{
-								Value: "production",
-								From:  sqltoken.NewPos(3, 12),
-								To:    sqltoken.NewPos(3, 22),
-							},
-							Spec: &sqlast.UniqueTableConstraint{
-								Unique: sqltoken.NewPos(3, 23),
-								RParen: sqltoken.NewPos(3, 42),
-								Columns: []*sqlast.Ident{&sqlast.Ident{
-									Value: "test_column",
-									From:  sqltoken.NewPos(3, 30),
-									To:    sqltoken.NewPos(3, 41),
-								}},
-							},
+					},
+				},
+			},
+			{
+				name: "table shorthand",
+				in:   "TABLE t UNION TABLE t2",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SetOperationExpr{
+						Op: &sqlast.UnionOperator{
+							From: sqltoken.NewPos(1, 9),
+							To:   sqltoken.NewPos(1, 14),
 						},
-						&sqlast.TableConstraint{
-							Spec: &sqlast.UniqueTableConstraint{
-								Primary: sqltoken.NewPos(4, 1),
-								RParen:  sqltoken.NewPos(4, 23),
-								Columns: []*sqlast.Ident{&sqlast.Ident{
-									Value: "person_id",
-									From:  sqltoken.NewPos(4, 13),
-									To:    sqltoken.NewPos(4, 22),
-								}},
-								IsPrimary: true,
+						Left: &sqlast.TableQueryExpr{
+							Table: sqltoken.NewPos(1, 1),
+							Name: &sqlast.ObjectName{
+								Idents: []*sqlast.Ident{
+									sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 7), sqltoken.NewPos(1, 8)),
+								},
 							},
 						},
-						&sqlast.TableConstraint{
-							Spec: &sqlast.CheckTableConstraint{
-								Check:  sqltoken.NewPos(5, 1),
-								RParen: sqltoken.NewPos(5, 16),
-								Expr: &sqlast.BinaryExpr{
-									Left: &sqlast.Ident{
-										Value: "id",
-										From:  sqltoken.NewPos(5, 7),
-										To:    sqltoken.NewPos(5, 9),
-									},
-									Op: &sqlast.Operator{
-										Type: sqlast.Gt,
-										From: sqltoken.NewPos(5, 10),
-										To:   sqltoken.NewPos(5, 11),
-									},
-									Right: &sqlast.LongValue{
-										From: sqltoken.NewPos(5, 12),
-										To:   sqltoken.NewPos(5, 15),
-										Long: 100,
-									},
+						Right: &sqlast.TableQueryExpr{
+							Table: sqltoken.NewPos(1, 15),
+							Name: &sqlast.ObjectName{
+								Idents: []*sqlast.Ident{
+									sqlast.NewIdentWithPos("t2", sqltoken.NewPos(1, 21), sqltoken.NewPos(1, 23)),
 								},
 							},
 						},
-						&sqlast.TableConstraint{
-							Spec: &sqlast.ReferentialTableConstraint{
-								Foreign: sqltoken.NewPos(6, 1),
-								Columns: []*sqlast.Ident{&sqlast.Ident{
-									Value: "test_id",
-									From:  sqltoken.NewPos(6, 13),
-									To:    sqltoken.NewPos(6, 20),
-								}},
-								KeyExpr: &sqlast.ReferenceKeyExpr{
-									TableName: &sqlast.Ident{
-										Value: "other_table",
-										From:  sqltoken.NewPos(6, 33),
-										To:    sqltoken.NewPos(6, 44),
-									},
-									Columns: []*sqlast.Ident{
-										&sqlast.Ident{
-											Value: "col1",
-											From:  sqltoken.NewPos(6, 45),
-											To:    sqltoken.NewPos(6, 49),
-										},
-										&sqlast.Ident{
-											Value: "col2",
-											From:  sqltoken.NewPos(6, 51),
-											To:    sqltoken.NewPos(6, 55),
-										},
+					},
+				},
+			},
+			{
+				name: "non-reserved keywords as identifiers",
+				in:   "SELECT a AS count, value FROM t",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.NewPos(1, 1),
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.AliasSelectItem{
+								Expr:  sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 8), sqltoken.NewPos(1, 9)),
+								Alias: sqlast.NewIdentWithPos("count", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 18)),
+							},
+							&sqlast.UnnamedSelectItem{
+								Node: sqlast.NewIdentWithPos("value", sqltoken.NewPos(1, 20), sqltoken.NewPos(1, 25)),
+							},
+						},
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 31), sqltoken.NewPos(1, 32)),
 									},
-									RParen: sqltoken.NewPos(6, 56),
 								},
 							},
 						},
@@ -1398,112 +1422,75 @@ FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
 				},
 			},
 			{
-				name: "create view",
-				in:   "CREATE VIEW comedies AS SELECT * FROM films WHERE kind = 'Comedy'",
-				out: &sqlast.CreateViewStmt{
-					Create: sqltoken.NewPos(1, 1),
-					Name: &sqlast.ObjectName{
-						Idents: []*sqlast.Ident{
-							{
-								Value: "comedies",
-								From:  sqltoken.NewPos(1, 13),
-								To:    sqltoken.NewPos(1, 21),
+				name: "typed column definitions of function",
+				in:   "SELECT * FROM f(1) AS t(a int, b text)",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.NewPos(1, 1),
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.Wildcard{Wildcard: sqltoken.NewPos(1, 8)},
 							},
 						},
-					},
-					Query: &sqlast.QueryStmt{
-						Body: &sqlast.SQLSelect{
-							Select: sqltoken.NewPos(1, 25),
-							Projection: []sqlast.SQLSelectItem{
-								&sqlast.UnnamedSelectItem{Node: &sqlast.Wildcard{
-									Wildcard: sqltoken.NewPos(1, 32),
-								}}},
-							FromClause: []sqlast.TableReference{
-								&sqlast.Table{
-									Name: &sqlast.ObjectName{
-										Idents: []*sqlast.Ident{
-											{
-												Value: "films",
-												From:  sqltoken.NewPos(1, 39),
-												To:    sqltoken.NewPos(1, 44),
-											},
-										},
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("f", sqltoken.NewPos(1, 15), sqltoken.NewPos(1, 16)),
 									},
 								},
-							},
-							WhereClause: &sqlast.BinaryExpr{
-								Op: &sqlast.Operator{
-									Type: sqlast.Eq,
-									From: sqltoken.NewPos(1, 56),
-									To:   sqltoken.NewPos(1, 57),
+								Args: []sqlast.Node{
+									&sqlast.LongValue{From: sqltoken.NewPos(1, 17), To: sqltoken.NewPos(1, 18), Long: 1},
 								},
-								Left: sqlast.NewIdentWithPos("kind", sqltoken.NewPos(1, 51), sqltoken.NewPos(1, 55)),
-								Right: &sqlast.SingleQuotedString{
-									From:   sqltoken.NewPos(1, 58),
-									To:     sqltoken.NewPos(1, 66),
-									String: "Comedy",
+								ArgsRParen: sqltoken.NewPos(1, 19),
+								Alias:      sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 23), sqltoken.NewPos(1, 24)),
+								ColumnDefs: []*sqlast.ColumnDef{
+									{
+										Name:     sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 25), sqltoken.NewPos(1, 26)),
+										DataType: &sqlast.Int{From: sqltoken.NewPos(1, 27), To: sqltoken.NewPos(1, 30)},
+									},
+									{
+										Name:     sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 32), sqltoken.NewPos(1, 33)),
+										DataType: &sqlast.Text{From: sqltoken.NewPos(1, 34), To: sqltoken.NewPos(1, 38)},
+									},
 								},
+								AliasRParen: sqltoken.NewPos(1, 39),
 							},
 						},
 					},
 				},
 			},
-		}
-
-		for _, c := range cases {
-
-			t.Run(c.name, func(t *testing.T) {
-				if c.skip {
-					t.Skip()
-				}
-				parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
-				if err != nil {
-					t.Fatal(err)
-				}
-				ast, err := parser.ParseStatement()
-				if err != nil {
-					t.Fatalf("%+v", err)
-				}
-
-				if diff := CompareWithoutMarker(c.out, ast); diff != "" {
-					t.Errorf("diff %s", diff)
-				}
-			})
-		}
-	})
-
-	t.Run("delete", func(t *testing.T) {
-		cases := []struct {
-			name string
-			in   string
-			out  sqlast.Stmt
-			skip bool
-		}{
 			{
-				in:   "DELETE FROM customers WHERE customer_id = 1",
-				name: "simple case",
-				out: &sqlast.DeleteStmt{
-					Delete: sqltoken.NewPos(1, 1),
-					TableName: &sqlast.ObjectName{
-						Idents: []*sqlast.Ident{
-							{
-								Value: "customers",
-								From:  sqltoken.NewPos(1, 13),
-								To:    sqltoken.NewPos(1, 22),
+				name: "json_table",
+				in:   "SELECT * FROM JSON_TABLE(doc, '$.items[*]' COLUMNS (id int PATH '$.id', name text PATH '$.name')) AS jt",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.NewPos(1, 1),
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.Wildcard{Wildcard: sqltoken.NewPos(1, 8)},
 							},
 						},
-					},
-					Selection: &sqlast.BinaryExpr{
-						Op: &sqlast.Operator{
-							Type: sqlast.Eq,
-							From: sqltoken.NewPos(1, 41),
-							To:   sqltoken.NewPos(1, 42),
-						},
-						Left: sqlast.NewIdentWithPos("customer_id", sqltoken.NewPos(1, 29), sqltoken.NewPos(1, 40)),
-						Right: &sqlast.LongValue{
-							From: sqltoken.NewPos(1, 43),
-							To:   sqltoken.NewPos(1, 44),
-							Long: 1,
+						FromClause: []sqlast.TableReference{
+							&sqlast.JSONTable{
+								JSONTable: sqltoken.NewPos(1, 15),
+								Expr:      sqlast.NewIdentWithPos("doc", sqltoken.NewPos(1, 26), sqltoken.NewPos(1, 29)),
+								Path:      &sqlast.SingleQuotedString{From: sqltoken.NewPos(1, 31), To: sqltoken.NewPos(1, 43), String: "$.items[*]"},
+								Columns: []sqlast.JSONTableColumn{
+									&sqlast.JSONTablePathColumn{
+										Name:     sqlast.NewIdentWithPos("id", sqltoken.NewPos(1, 53), sqltoken.NewPos(1, 55)),
+										DataType: &sqlast.Int{From: sqltoken.NewPos(1, 56), To: sqltoken.NewPos(1, 59)},
+										Path:     &sqlast.SingleQuotedString{From: sqltoken.NewPos(1, 65), To: sqltoken.NewPos(1, 71), String: "$.id"},
+									},
+									&sqlast.JSONTablePathColumn{
+										Name:     sqlast.NewIdentWithPos("name", sqltoken.NewPos(1, 73), sqltoken.NewPos(1, 77)),
+										DataType: &sqlast.Text{From: sqltoken.NewPos(1, 78), To: sqltoken.NewPos(1, 82)},
+										Path:     &sqlast.SingleQuotedString{From: sqltoken.NewPos(1, 88), To: sqltoken.NewPos(1, 96), String: "$.name"},
+									},
+								},
+								RParen: sqltoken.NewPos(1, 98),
+								Alias:  sqlast.NewIdentWithPos("jt", sqltoken.NewPos(1, 102), sqltoken.NewPos(1, 104)),
+							},
 						},
 					},
 				},
@@ -1532,7 +1519,7 @@ FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
 		}
 	})
 
-	t.Run("insert", func(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
 		cases := []struct {
 			name string
 			in   string
@@ -1540,547 +1527,5027 @@ FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
 			skip bool
 		}{
 			{
-				in:   "INSERT INTO customers (customer_name, contract_name) VALUES('Cardinal', 'Tom B. Erichsen')",
-				name: "simple case",
-				out: &sqlast.InsertStmt{
-					Insert: sqltoken.NewPos(1, 1),
-					TableName: &sqlast.ObjectName{
+				name: "create table",
+				in: `
+CREATE TABLE persons (
+ person_id UUID PRIMARY KEY NOT NULL,
+ first_name varchar(255) UNIQUE,
+ last_name character varying(255) NOT NULL,
+ created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
+)`,
+				out: &sqlast.CreateTableStmt{
+					Create: sqltoken.NewPos(2, 1),
+					Name: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
-							sqlast.NewIdentWithPos("customers", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 22)),
+							{
+								Value: "persons",
+								From:  sqltoken.NewPos(2, 14),
+								To:    sqltoken.NewPos(2, 21),
+							},
 						},
 					},
-					Columns: []*sqlast.Ident{
-						sqlast.NewIdentWithPos("customer_name", sqltoken.NewPos(1, 24), sqltoken.NewPos(1, 37)),
-						sqlast.NewIdentWithPos("contract_name", sqltoken.NewPos(1, 39), sqltoken.NewPos(1, 52)),
-					},
-					Source: &sqlast.ConstructorSource{
-						Rows: []*sqlast.RowValueExpr{
-							{
-								LParen: sqltoken.NewPos(1, 60),
-								RParen: sqltoken.NewPos(1, 91),
-								Values: []sqlast.Node{
-									&sqlast.SingleQuotedString{
-										From:   sqltoken.NewPos(1, 61),
-										To:     sqltoken.NewPos(1, 71),
-										String: "Cardinal",
+					Elements: []sqlast.TableElement{
+						&sqlast.ColumnDef{
+							Name: &sqlast.Ident{
+								Value: "person_id",
+								From:  sqltoken.NewPos(3, 2),
+								To:    sqltoken.NewPos(3, 11),
+							},
+							DataType: &sqlast.UUID{
+								From: sqltoken.NewPos(3, 12),
+								To:   sqltoken.NewPos(3, 16),
+							},
+							Constraints: []*sqlast.ColumnConstraint{
+								{
+									Spec: &sqlast.UniqueColumnSpec{
+										IsPrimaryKey: true,
+										Primary:      sqltoken.NewPos(3, 17),
+										Key:          sqltoken.NewPos(3, 28),
 									},
-									&sqlast.SingleQuotedString{
-										From:   sqltoken.NewPos(1, 73),
-										To:     sqltoken.NewPos(1, 90),
-										String: "Tom B. Erichsen",
+								},
+								{
+									Spec: &sqlast.NotNullColumnSpec{
+										Not:  sqltoken.NewPos(3, 29),
+										Null: sqltoken.NewPos(3, 37),
 									},
 								},
 							},
 						},
-					},
-				},
-			},
-			{
-				name: "multi record case",
-				in: `INSERT INTO customers (customer_name, contract_name) VALUES
-('Cardinal', 'Tom B. Erichsen'),
-('Cardinal', 'Tom B. Erichsen')`,
-				out: &sqlast.InsertStmt{
-					Insert: sqltoken.NewPos(1, 1),
-					TableName: &sqlast.ObjectName{
-						Idents: []*sqlast.Ident{
-							{
-								Value: "customers",
-								From:  sqltoken.NewPos(1, 13),
-								To:    sqltoken.NewPos(1, 22),
+						&sqlast.ColumnDef{
+							Name: &sqlast.Ident{
+								Value: "first_name",
+								From:  sqltoken.NewPos(4, 2),
+								To:    sqltoken.NewPos(4, 12),
 							},
-						},
-					},
-					Columns: []*sqlast.Ident{
-						sqlast.NewIdentWithPos("customer_name", sqltoken.NewPos(1, 24), sqltoken.NewPos(1, 37)),
-						sqlast.NewIdentWithPos("contract_name", sqltoken.NewPos(1, 39), sqltoken.NewPos(1, 52)),
-					},
-					Source: &sqlast.ConstructorSource{
-						Rows: []*sqlast.RowValueExpr{
-							{
-								LParen: sqltoken.NewPos(2, 1),
-								RParen: sqltoken.NewPos(2, 32),
-								Values: []sqlast.Node{
-									&sqlast.SingleQuotedString{
-										From:   sqltoken.NewPos(2, 2),
-										To:     sqltoken.NewPos(2, 12),
-										String: "Cardinal",
-									},
-									&sqlast.SingleQuotedString{
-										From:   sqltoken.NewPos(2, 14),
-										To:     sqltoken.NewPos(2, 31),
-										String: "Tom B. Erichsen",
-									},
-								},
+							DataType: &sqlast.VarcharType{
+								Size:      sqlast.NewSize(255),
+								Character: sqltoken.NewPos(4, 13),
+								RParen:    sqltoken.NewPos(4, 25),
 							},
-							{
-								LParen: sqltoken.NewPos(3, 1),
-								RParen: sqltoken.NewPos(3, 32),
-								Values: []sqlast.Node{
-									&sqlast.SingleQuotedString{
-										From:   sqltoken.NewPos(3, 2),
-										To:     sqltoken.NewPos(3, 12),
-										String: "Cardinal",
-									},
-									&sqlast.SingleQuotedString{
-										From:   sqltoken.NewPos(3, 14),
-										To:     sqltoken.NewPos(3, 31),
-										String: "Tom B. Erichsen",
+							Constraints: []*sqlast.ColumnConstraint{
+								{
+									Spec: &sqlast.UniqueColumnSpec{
+										Unique: sqltoken.NewPos(4, 26),
 									},
 								},
 							},
 						},
-					},
-				},
-			},
-		}
-
-		for _, c := range cases {
-
-			t.Run(c.name, func(t *testing.T) {
-				if c.skip {
-					t.Skip()
-				}
-				parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
-				if err != nil {
-					t.Fatal(err)
-				}
-				ast, err := parser.ParseStatement()
-				if err != nil {
-					t.Fatal(err)
-				}
-
-				if diff := CompareWithoutMarker(c.out, ast); diff != "" {
-					t.Errorf("diff %s", diff)
-				}
-			})
-		}
-	})
-
-	t.Run("alter", func(t *testing.T) {
-		cases := []struct {
-			name string
-			in   string
-			out  sqlast.Stmt
-			skip bool
-		}{
-			{
-				name: "add column",
-				in: `
-ALTER TABLE customers
-ADD COLUMN email character varying(255)`,
-				out: &sqlast.AlterTableStmt{
-					Alter: sqltoken.NewPos(2, 1),
-					TableName: &sqlast.ObjectName{
-						Idents: []*sqlast.Ident{
-							sqlast.NewIdentWithPos("customers", sqltoken.NewPos(2, 13), sqltoken.NewPos(2, 22)),
-						},
-					},
-					Action: &sqlast.AddColumnTableAction{
-						Add: sqltoken.NewPos(3, 1),
-						Column: &sqlast.ColumnDef{
-							Name: sqlast.NewIdentWithPos("email", sqltoken.NewPos(3, 12), sqltoken.NewPos(3, 17)),
+						&sqlast.ColumnDef{
+							Name: &sqlast.Ident{
+								Value: "last_name",
+								From:  sqltoken.NewPos(5, 2),
+								To:    sqltoken.NewPos(5, 11),
+							},
 							DataType: &sqlast.VarcharType{
 								Size:      sqlast.NewSize(255),
-								Character: sqltoken.NewPos(3, 18),
-								Varying:   sqltoken.NewPos(3, 35),
-								RParen:    sqltoken.NewPos(3, 40),
+								Character: sqltoken.NewPos(5, 12),
+								Varying:   sqltoken.NewPos(5, 29),
+								RParen:    sqltoken.NewPos(5, 34),
+							},
+							Constraints: []*sqlast.ColumnConstraint{
+								{
+									Spec: &sqlast.NotNullColumnSpec{
+										Not:  sqltoken.NewPos(5, 35),
+										Null: sqltoken.NewPos(5, 43),
+									},
+								},
+							},
+						},
+						&sqlast.ColumnDef{
+							Name: &sqlast.Ident{
+								Value: "created_at",
+								From:  sqltoken.NewPos(6, 2),
+								To:    sqltoken.NewPos(6, 12),
+							},
+							DataType: &sqlast.Timestamp{
+								Timestamp: sqltoken.NewPos(6, 13),
+							},
+							Default: &sqlast.Ident{
+								Value: "CURRENT_TIMESTAMP",
+								From:  sqltoken.NewPos(6, 31),
+								To:    sqltoken.NewPos(6, 48),
+							},
+							Constraints: []*sqlast.ColumnConstraint{
+								{
+									Spec: &sqlast.NotNullColumnSpec{
+										Not:  sqltoken.NewPos(6, 49),
+										Null: sqltoken.NewPos(6, 57),
+									},
+								},
 							},
 						},
 					},
 				},
 			},
 			{
-				name: "add constraint",
-				in: `
-ALTER TABLE products
-ADD FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)`,
-				out: &sqlast.AlterTableStmt{
-					Alter: sqltoken.NewPos(2, 1),
-					TableName: &sqlast.ObjectName{
+				name: "with case",
+				in: `CREATE TABLE persons (
+person_id int PRIMARY KEY NOT NULL,
+last_name character varying(255) NOT NULL,
+test_id int NOT NULL REFERENCES test(id1),
+email character varying(255) UNIQUE NOT NULL,
+age int NOT NULL CHECK(age > 0 AND age < 100),
+created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
+)`,
+				out: &sqlast.CreateTableStmt{
+					Create: sqltoken.NewPos(1, 1),
+					Name: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
-							sqlast.NewIdentWithPos("products", sqltoken.NewPos(2, 13), sqltoken.NewPos(2, 21)),
+							{
+								Value: "persons",
+								From:  sqltoken.NewPos(1, 14),
+								To:    sqltoken.NewPos(1, 21),
+							},
 						},
 					},
-					Action: &sqlast.AddConstraintTableAction{
-						Add: sqltoken.NewPos(3, 1),
-						Constraint: &sqlast.TableConstraint{
-							Spec: &sqlast.ReferentialTableConstraint{
-								Foreign: sqltoken.NewPos(3, 5),
-								Columns: []*sqlast.Ident{
-									sqlast.NewIdentWithPos("test_id", sqltoken.NewPos(3, 17), sqltoken.NewPos(3, 24)),
+					Elements: []sqlast.TableElement{
+						&sqlast.ColumnDef{
+							Name: &sqlast.Ident{
+								Value: "person_id",
+								From:  sqltoken.NewPos(2, 1),
+								To:    sqltoken.NewPos(2, 10),
+							},
+							DataType: &sqlast.Int{
+								From: sqltoken.NewPos(2, 11),
+								To:   sqltoken.NewPos(2, 14),
+							},
+							Constraints: []*sqlast.ColumnConstraint{
+								{
+									Spec: &sqlast.UniqueColumnSpec{
+										IsPrimaryKey: true,
+										Primary:      sqltoken.NewPos(2, 15),
+										Key:          sqltoken.NewPos(2, 26),
+									},
 								},
-								KeyExpr: &sqlast.ReferenceKeyExpr{
-									TableName: sqlast.NewIdentWithPos("other_table", sqltoken.NewPos(3, 37), sqltoken.NewPos(3, 48)),
-									Columns: []*sqlast.Ident{
-										sqlast.NewIdentWithPos("col1", sqltoken.NewPos(3, 49), sqltoken.NewPos(3, 53)),
-										sqlast.NewIdentWithPos("col2", sqltoken.NewPos(3, 55), sqltoken.NewPos(3, 59)),
+								{
+									Spec: &sqlast.NotNullColumnSpec{
+										Not:  sqltoken.NewPos(2, 27),
+										Null: sqltoken.NewPos(2, 35),
 									},
-									RParen: sqltoken.NewPos(3, 60),
 								},
 							},
 						},
-					},
-				},
-			},
-			{
-				name: "drop constraint",
-				in: `ALTER TABLE products
-DROP CONSTRAINT fk CASCADE`,
-				out: &sqlast.AlterTableStmt{
-					Alter: sqltoken.NewPos(1, 1),
-					TableName: &sqlast.ObjectName{
-						Idents: []*sqlast.Ident{
-							sqlast.NewIdentWithPos("products", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 21)),
+						&sqlast.ColumnDef{
+							Name: &sqlast.Ident{
+								Value: "last_name",
+								From:  sqltoken.NewPos(3, 1),
+								To:    sqltoken.NewPos(3, 10),
+							},
+							DataType: &sqlast.VarcharType{
+								Size:      sqlast.NewSize(255),
+								Character: sqltoken.NewPos(3, 11),
+								Varying:   sqltoken.NewPos(3, 28),
+								RParen:    sqltoken.NewPos(3, 33),
+							},
+							Constraints: []*sqlast.ColumnConstraint{
+								{
+									Spec: &sqlast.NotNullColumnSpec{
+										Not:  sqltoken.NewPos(3, 34),
+										Null: sqltoken.NewPos(3, 42),
+									},
+								},
+							},
 						},
-					},
-					Action: &sqlast.DropConstraintTableAction{
-						Drop:       sqltoken.NewPos(2, 1),
-						Name:       sqlast.NewIdentWithPos("fk", sqltoken.NewPos(2, 17), sqltoken.NewPos(2, 19)),
-						Cascade:    true,
-						CascadePos: sqltoken.NewPos(2, 27),
-					},
-				},
-			},
-			{
-				name: "remove column",
-				in: `ALTER TABLE products
-DROP COLUMN description CASCADE`,
-				out: &sqlast.AlterTableStmt{
-					Alter: sqltoken.NewPos(1, 1),
-					TableName: &sqlast.ObjectName{
-						Idents: []*sqlast.Ident{
-							sqlast.NewIdentWithPos("products", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 21)),
+						&sqlast.ColumnDef{
+							Name: &sqlast.Ident{
+								Value: "test_id",
+								From:  sqltoken.NewPos(4, 1),
+								To:    sqltoken.NewPos(4, 8),
+							},
+							DataType: &sqlast.Int{
+								From: sqltoken.NewPos(4, 9),
+								To:   sqltoken.NewPos(4, 12),
+							},
+							Constraints: []*sqlast.ColumnConstraint{
+								{
+									Spec: &sqlast.NotNullColumnSpec{
+										Not:  sqltoken.NewPos(4, 13),
+										Null: sqltoken.NewPos(4, 21),
+									},
+								},
+								{
+									Spec: &sqlast.ReferencesColumnSpec{
+										References: sqltoken.NewPos(4, 22),
+										RParen:     sqltoken.NewPos(4, 42),
+										To:         sqltoken.NewPos(4, 42),
+										TableName: &sqlast.ObjectName{
+											Idents: []*sqlast.Ident{
+												{
+													Value: "test",
+													From:  sqltoken.NewPos(4, 33),
+													To:    sqltoken.NewPos(4, 37),
+												},
+											},
+										},
+										Columns: []*sqlast.Ident{
+											&sqlast.Ident{
+												Value: "id1",
+												From:  sqltoken.NewPos(4, 38),
+												To:    sqltoken.NewPos(4, 41),
+											},
+										},
+									},
+								},
+							},
+						},
+						&sqlast.ColumnDef{
+							Name: &sqlast.Ident{
+								Value: "email",
+								From:  sqltoken.NewPos(5, 1),
+								To:    sqltoken.NewPos(5, 6),
+							},
+							DataType: &sqlast.VarcharType{
+								Size:      sqlast.NewSize(255),
+								Character: sqltoken.NewPos(5, 7),
+								Varying:   sqltoken.NewPos(5, 24),
+								RParen:    sqltoken.NewPos(5, 29),
+							},
+							Constraints: []*sqlast.ColumnConstraint{
+								{
+									Spec: &sqlast.UniqueColumnSpec{
+										Unique: sqltoken.NewPos(5, 30),
+									},
+								},
+								{
+									Spec: &sqlast.NotNullColumnSpec{
+										Not:  sqltoken.NewPos(5, 37),
+										Null: sqltoken.NewPos(5, 45),
+									},
+								},
+							},
+						},
+						&sqlast.ColumnDef{
+							Name: &sqlast.Ident{
+								Value: "age",
+								From:  sqltoken.NewPos(6, 1),
+								To:    sqltoken.NewPos(6, 4),
+							},
+							DataType: &sqlast.Int{
+								From: sqltoken.NewPos(6, 5),
+								To:   sqltoken.NewPos(6, 8),
+							},
+							Constraints: []*sqlast.ColumnConstraint{
+								{
+									Spec: &sqlast.NotNullColumnSpec{
+										Not:  sqltoken.NewPos(6, 9),
+										Null: sqltoken.NewPos(6, 17),
+									},
+								},
+								{
+									Spec: &sqlast.CheckColumnSpec{
+										Check:  sqltoken.NewPos(6, 18),
+										RParen: sqltoken.NewPos(6, 46),
+										Expr: &sqlast.BinaryExpr{
+											Op: &sqlast.Operator{
+												Type: sqlast.And,
+												From: sqltoken.NewPos(6, 32),
+												To:   sqltoken.NewPos(6, 35),
+											},
+											Left: &sqlast.BinaryExpr{
+												Op: &sqlast.Operator{
+													Type: sqlast.Gt,
+													From: sqltoken.NewPos(6, 28),
+													To:   sqltoken.NewPos(6, 29),
+												},
+												Left: &sqlast.Ident{
+													Value: "age",
+													From:  sqltoken.NewPos(6, 24),
+													To:    sqltoken.NewPos(6, 27),
+												},
+												Right: &sqlast.LongValue{
+													From: sqltoken.NewPos(6, 30),
+													To:   sqltoken.NewPos(6, 31),
+													Long: 0,
+												},
+											},
+											Right: &sqlast.BinaryExpr{
+												Op: &sqlast.Operator{
+													Type: sqlast.Lt,
+													From: sqltoken.NewPos(6, 40),
+													To:   sqltoken.NewPos(6, 41),
+												},
+												Left: &sqlast.Ident{
+													Value: "age",
+													From:  sqltoken.NewPos(6, 36),
+													To:    sqltoken.NewPos(6, 39),
+												},
+												Right: &sqlast.LongValue{
+													From: sqltoken.NewPos(6, 42),
+													To:   sqltoken.NewPos(6, 45),
+													Long: 100,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&sqlast.ColumnDef{
+							Name: &sqlast.Ident{
+								Value: "created_at",
+								From:  sqltoken.NewPos(7, 1),
+								To:    sqltoken.NewPos(7, 11),
+							},
+							DataType: &sqlast.Timestamp{
+								Timestamp: sqltoken.NewPos(7, 12),
+							},
+							Default: &sqlast.Ident{
+								Value: "CURRENT_TIMESTAMP",
+								From:  sqltoken.NewPos(7, 30),
+								To:    sqltoken.NewPos(7, 47),
+							},
+							Constraints: []*sqlast.ColumnConstraint{
+								{
+									Spec: &sqlast.NotNullColumnSpec{
+										Not:  sqltoken.NewPos(7, 48),
+										Null: sqltoken.NewPos(7, 56),
+									},
+								},
+							},
 						},
-					},
-					Action: &sqlast.RemoveColumnTableAction{
-						Drop:       sqltoken.NewPos(2, 1),
-						Name:       sqlast.NewIdentWithPos("description", sqltoken.NewPos(2, 13), sqltoken.NewPos(2, 24)),
-						Cascade:    true,
-						CascadePos: sqltoken.NewPos(2, 32),
 					},
 				},
 			},
 			{
-				name: "alter column",
-				in: `ALTER TABLE products
-ALTER COLUMN created_at SET DEFAULT current_timestamp`,
-				out: &sqlast.AlterTableStmt{
-					Alter: sqltoken.NewPos(1, 1),
-					TableName: &sqlast.ObjectName{
+				name: "with table constraint",
+				in: `CREATE TABLE persons (
+person_id int,
+CONSTRAINT production UNIQUE(test_column),
+PRIMARY KEY(person_id),
+CHECK(id > 100),
+FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
+)`,
+				out: &sqlast.CreateTableStmt{
+					Create: sqltoken.NewPos(1, 1),
+					Name: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
-							sqlast.NewIdentWithPos("products", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 21)),
+							{
+								Value: "persons",
+								From:  sqltoken.NewPos(1, 14),
+								To:    sqltoken.NewPos(1, 21),
+							},
 						},
 					},
-					Action: &sqlast.AlterColumnTableAction{
-						Alter:      sqltoken.NewPos(2, 1),
-						ColumnName: sqlast.NewIdentWithPos("created_at", sqltoken.NewPos(2, 14), sqltoken.NewPos(2, 24)),
-						Action: &sqlast.SetDefaultColumnAction{
-							Set:     sqltoken.NewPos(2, 25),
-							Default: sqlast.NewIdentWithPos("current_timestamp", sqltoken.NewPos(2, 37), sqltoken.NewPos(2, 54)),
+					Elements: []sqlast.TableElement{
+						&sqlast.ColumnDef{
+							Name: &sqlast.Ident{
+								Value: "person_id",
+								From:  sqltoken.NewPos(2, 1),
+								To:    sqltoken.NewPos(2, 10),
+							},
+							DataType: &sqlast.Int{
+								From: sqltoken.NewPos(2, 11),
+								To:   sqltoken.NewPos(2, 14),
+							},
 						},
+						&sqlast.TableConstraint{
+							Constraint: sqltoken.NewPos(3, 1),
+							Name: &sqlast.Ident{
+								Value: "production",
+								From:  sqltoken.NewPos(3, 12),
+								To:    sqltoken.NewPos(3, 22),
+							},
+							Spec: &sqlast.UniqueTableConstraint{
+								Unique: sqltoken.NewPos(3, 23),
+								RParen: sqltoken.NewPos(3, 42),
+								Columns: []*sqlast.Ident{&sqlast.Ident{
+									Value: "test_column",
+									From:  sqltoken.NewPos(3, 30),
+									To:    sqltoken.NewPos(3, 41),
+								}},
+							},
+						},
+						&sqlast.TableConstraint{
+							Spec: &sqlast.UniqueTableConstraint{
+								Primary: sqltoken.NewPos(4, 1),
+								RParen:  sqltoken.NewPos(4, 23),
+								Columns: []*sqlast.Ident{&sqlast.Ident{
+									Value: "person_id",
+									From:  sqltoken.NewPos(4, 13),
+									To:    sqltoken.NewPos(4, 22),
+								}},
+								IsPrimary: true,
+							},
+						},
+						&sqlast.TableConstraint{
+							Spec: &sqlast.CheckTableConstraint{
+								Check:  sqltoken.NewPos(5, 1),
+								RParen: sqltoken.NewPos(5, 16),
+								Expr: &sqlast.BinaryExpr{
+									Left: &sqlast.Ident{
+										Value: "id",
+										From:  sqltoken.NewPos(5, 7),
+										To:    sqltoken.NewPos(5, 9),
+									},
+									Op: &sqlast.Operator{
+										Type: sqlast.Gt,
+										From: sqltoken.NewPos(5, 10),
+										To:   sqltoken.NewPos(5, 11),
+									},
+									Right: &sqlast.LongValue{
+										From: sqltoken.NewPos(5, 12),
+										To:   sqltoken.NewPos(5, 15),
+										Long: 100,
+									},
+								},
+							},
+						},
+						&sqlast.TableConstraint{
+							Spec: &sqlast.ReferentialTableConstraint{
+								Foreign: sqltoken.NewPos(6, 1),
+								Columns: []*sqlast.Ident{&sqlast.Ident{
+									Value: "test_id",
+									From:  sqltoken.NewPos(6, 13),
+									To:    sqltoken.NewPos(6, 20),
+								}},
+								KeyExpr: &sqlast.ReferenceKeyExpr{
+									TableName: &sqlast.Ident{
+										Value: "other_table",
+										From:  sqltoken.NewPos(6, 33),
+										To:    sqltoken.NewPos(6, 44),
+									},
+									Columns: []*sqlast.Ident{
+										&sqlast.Ident{
+											Value: "col1",
+											From:  sqltoken.NewPos(6, 45),
+											To:    sqltoken.NewPos(6, 49),
+										},
+										&sqlast.Ident{
+											Value: "col2",
+											From:  sqltoken.NewPos(6, 51),
+											To:    sqltoken.NewPos(6, 55),
+										},
+									},
+									RParen: sqltoken.NewPos(6, 56),
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "inline references with match and actions",
+				in:   "CREATE TABLE orders (customer_id int REFERENCES customers(id) MATCH FULL ON DELETE CASCADE)",
+				out: &sqlast.CreateTableStmt{
+					Create: sqltoken.NewPos(1, 1),
+					Name: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("orders", sqltoken.NewPos(1, 14), sqltoken.NewPos(1, 20)),
+						},
+					},
+					Elements: []sqlast.TableElement{
+						&sqlast.ColumnDef{
+							Name: sqlast.NewIdentWithPos("customer_id", sqltoken.NewPos(1, 22), sqltoken.NewPos(1, 33)),
+							DataType: &sqlast.Int{
+								From: sqltoken.NewPos(1, 34),
+								To:   sqltoken.NewPos(1, 37),
+							},
+							Constraints: []*sqlast.ColumnConstraint{
+								{
+									Spec: &sqlast.ReferencesColumnSpec{
+										References: sqltoken.NewPos(1, 38),
+										RParen:     sqltoken.NewPos(1, 62),
+										TableName: &sqlast.ObjectName{
+											Idents: []*sqlast.Ident{
+												sqlast.NewIdentWithPos("customers", sqltoken.NewPos(1, 49), sqltoken.NewPos(1, 58)),
+											},
+										},
+										Columns: []*sqlast.Ident{
+											sqlast.NewIdentWithPos("id", sqltoken.NewPos(1, 59), sqltoken.NewPos(1, 61)),
+										},
+										Match:    sqlast.MatchFull,
+										OnDelete: sqlast.ReferentialCascade,
+										To:       sqltoken.NewPos(1, 91),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "create view",
+				in:   "CREATE VIEW comedies AS SELECT * FROM films WHERE kind = 'Comedy'",
+				out: &sqlast.CreateViewStmt{
+					Create: sqltoken.NewPos(1, 1),
+					Name: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							{
+								Value: "comedies",
+								From:  sqltoken.NewPos(1, 13),
+								To:    sqltoken.NewPos(1, 21),
+							},
+						},
+					},
+					Query: &sqlast.QueryStmt{
+						Body: &sqlast.SQLSelect{
+							Select: sqltoken.NewPos(1, 25),
+							Projection: []sqlast.SQLSelectItem{
+								&sqlast.UnnamedSelectItem{Node: &sqlast.Wildcard{
+									Wildcard: sqltoken.NewPos(1, 32),
+								}}},
+							FromClause: []sqlast.TableReference{
+								&sqlast.Table{
+									Name: &sqlast.ObjectName{
+										Idents: []*sqlast.Ident{
+											{
+												Value: "films",
+												From:  sqltoken.NewPos(1, 39),
+												To:    sqltoken.NewPos(1, 44),
+											},
+										},
+									},
+								},
+							},
+							WhereClause: &sqlast.BinaryExpr{
+								Op: &sqlast.Operator{
+									Type: sqlast.Eq,
+									From: sqltoken.NewPos(1, 56),
+									To:   sqltoken.NewPos(1, 57),
+								},
+								Left: sqlast.NewIdentWithPos("kind", sqltoken.NewPos(1, 51), sqltoken.NewPos(1, 55)),
+								Right: &sqlast.SingleQuotedString{
+									From:   sqltoken.NewPos(1, 58),
+									To:     sqltoken.NewPos(1, 66),
+									String: "Comedy",
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "create table as select",
+				in:   "CREATE TABLE t (a, b) AS SELECT x, y FROM s WITH NO DATA",
+				out: &sqlast.CreateTableStmt{
+					Create: sqltoken.NewPos(1, 1),
+					Name: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 14), sqltoken.NewPos(1, 15)),
+						},
+					},
+					Columns: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 17), sqltoken.NewPos(1, 18)),
+						sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 20), sqltoken.NewPos(1, 21)),
+					},
+					Query: &sqlast.QueryStmt{
+						Body: &sqlast.SQLSelect{
+							Select: sqltoken.NewPos(1, 26),
+							Projection: []sqlast.SQLSelectItem{
+								&sqlast.UnnamedSelectItem{
+									Node: sqlast.NewIdentWithPos("x", sqltoken.NewPos(1, 33), sqltoken.NewPos(1, 34)),
+								},
+								&sqlast.UnnamedSelectItem{
+									Node: sqlast.NewIdentWithPos("y", sqltoken.NewPos(1, 36), sqltoken.NewPos(1, 37)),
+								},
+							},
+							FromClause: []sqlast.TableReference{
+								&sqlast.Table{
+									Name: &sqlast.ObjectName{
+										Idents: []*sqlast.Ident{
+											sqlast.NewIdentWithPos("s", sqltoken.NewPos(1, 43), sqltoken.NewPos(1, 44)),
+										},
+									},
+								},
+							},
+						},
+					},
+					WithData: func() *bool { b := false; return &b }(),
+					DataEnd:  sqltoken.NewPos(1, 57),
+				},
+			},
+			{
+				name: "create index with operator class",
+				in:   "CREATE INDEX idx ON t (name text_pattern_ops DESC, id)",
+				out: &sqlast.CreateIndexStmt{
+					Create:    sqltoken.NewPos(1, 1),
+					IndexName: sqlast.NewIdentWithPos("idx", sqltoken.NewPos(1, 14), sqltoken.NewPos(1, 17)),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 21), sqltoken.NewPos(1, 22)),
+						},
+					},
+					Columns: []*sqlast.IndexColumn{
+						{
+							Name: sqlast.NewIdentWithPos("name", sqltoken.NewPos(1, 24), sqltoken.NewPos(1, 28)),
+							OpClass: &sqlast.ObjectName{
+								Idents: []*sqlast.Ident{
+									sqlast.NewIdentWithPos("text_pattern_ops", sqltoken.NewPos(1, 29), sqltoken.NewPos(1, 45)),
+								},
+							},
+							ASC:         func() *bool { b := false; return &b }(),
+							OrderingPos: sqltoken.NewPos(1, 50),
+						},
+						{
+							Name: sqlast.NewIdentWithPos("id", sqltoken.NewPos(1, 52), sqltoken.NewPos(1, 54)),
+						},
+					},
+					RParen: sqltoken.NewPos(1, 55),
+				},
+			},
+			{
+				name: "create index with expression and nulls ordering",
+				in:   "CREATE INDEX idx ON t (lower(name) DESC NULLS LAST)",
+				out: &sqlast.CreateIndexStmt{
+					Create:    sqltoken.NewPos(1, 1),
+					IndexName: sqlast.NewIdentWithPos("idx", sqltoken.NewPos(1, 14), sqltoken.NewPos(1, 17)),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 21), sqltoken.NewPos(1, 22)),
+						},
+					},
+					Columns: []*sqlast.IndexColumn{
+						{
+							Expr: &sqlast.Function{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("lower", sqltoken.NewPos(1, 24), sqltoken.NewPos(1, 29)),
+									},
+								},
+								Args: []sqlast.Node{
+									sqlast.NewIdentWithPos("name", sqltoken.NewPos(1, 30), sqltoken.NewPos(1, 34)),
+								},
+								ArgsRParen: sqltoken.NewPos(1, 35),
+							},
+							ASC:         func() *bool { b := false; return &b }(),
+							OrderingPos: sqltoken.NewPos(1, 40),
+							NullsFirst:  func() *bool { b := false; return &b }(),
+							NullsPos:    sqltoken.NewPos(1, 51),
+						},
+					},
+					RParen: sqltoken.NewPos(1, 52),
+				},
+			},
+		}
+
+		for _, c := range cases {
+
+			t.Run(c.name, func(t *testing.T) {
+				if c.skip {
+					t.Skip()
+				}
+				parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				ast, err := parser.ParseStatement()
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+
+				if diff := CompareWithoutMarker(c.out, ast); diff != "" {
+					t.Errorf("diff %s", diff)
+				}
+			})
+		}
+
+		t.Run("word after index column which is not an operator class", func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(`CREATE INDEX idx ON t (name COLLATE "C")`), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = parser.ParseStatement()
+			if err == nil {
+				t.Fatal("should be error")
+			}
+			if !strings.Contains(err.Error(), "Value:COLLATE") {
+				t.Errorf("COLLATE must not be taken as an operator class but %v", err)
+			}
+		})
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		cases := []struct {
+			name string
+			in   string
+			out  sqlast.Stmt
+			skip bool
+		}{
+			{
+				in:   "DELETE FROM customers WHERE customer_id = 1",
+				name: "simple case",
+				out: &sqlast.DeleteStmt{
+					Delete: sqltoken.NewPos(1, 1),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							{
+								Value: "customers",
+								From:  sqltoken.NewPos(1, 13),
+								To:    sqltoken.NewPos(1, 22),
+							},
+						},
+					},
+					Selection: &sqlast.BinaryExpr{
+						Op: &sqlast.Operator{
+							Type: sqlast.Eq,
+							From: sqltoken.NewPos(1, 41),
+							To:   sqltoken.NewPos(1, 42),
+						},
+						Left: sqlast.NewIdentWithPos("customer_id", sqltoken.NewPos(1, 29), sqltoken.NewPos(1, 40)),
+						Right: &sqlast.LongValue{
+							From: sqltoken.NewPos(1, 43),
+							To:   sqltoken.NewPos(1, 44),
+							Long: 1,
+						},
+					},
+				},
+			},
+			{
+				in:   "DELETE FROM customers RETURNING *",
+				name: "returning wildcard",
+				out: &sqlast.DeleteStmt{
+					Delete: sqltoken.NewPos(1, 1),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("customers", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 22)),
+						},
+					},
+					Returning: []sqlast.SQLSelectItem{
+						&sqlast.UnnamedSelectItem{
+							Node: &sqlast.Wildcard{Wildcard: sqltoken.NewPos(1, 33)},
+						},
+					},
+				},
+			},
+		}
+
+		for _, c := range cases {
+
+			t.Run(c.name, func(t *testing.T) {
+				if c.skip {
+					t.Skip()
+				}
+				parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				ast, err := parser.ParseStatement()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if diff := CompareWithoutMarker(c.out, ast); diff != "" {
+					t.Errorf("diff %s", diff)
+				}
+			})
+		}
+	})
+
+	t.Run("insert", func(t *testing.T) {
+		cases := []struct {
+			name string
+			in   string
+			out  sqlast.Stmt
+			skip bool
+		}{
+			{
+				in:   "INSERT INTO customers (customer_name, contract_name) VALUES('Cardinal', 'Tom B. Erichsen')",
+				name: "simple case",
+				out: &sqlast.InsertStmt{
+					Insert: sqltoken.NewPos(1, 1),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("customers", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 22)),
+						},
+					},
+					Columns: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("customer_name", sqltoken.NewPos(1, 24), sqltoken.NewPos(1, 37)),
+						sqlast.NewIdentWithPos("contract_name", sqltoken.NewPos(1, 39), sqltoken.NewPos(1, 52)),
+					},
+					Source: &sqlast.ConstructorSource{
+						Rows: []*sqlast.RowValueExpr{
+							{
+								LParen: sqltoken.NewPos(1, 60),
+								RParen: sqltoken.NewPos(1, 91),
+								Values: []sqlast.Node{
+									&sqlast.SingleQuotedString{
+										From:   sqltoken.NewPos(1, 61),
+										To:     sqltoken.NewPos(1, 71),
+										String: "Cardinal",
+									},
+									&sqlast.SingleQuotedString{
+										From:   sqltoken.NewPos(1, 73),
+										To:     sqltoken.NewPos(1, 90),
+										String: "Tom B. Erichsen",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "returning",
+				in:   "INSERT INTO t VALUES (1) RETURNING id",
+				out: &sqlast.InsertStmt{
+					Insert: sqltoken.NewPos(1, 1),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 14)),
+						},
+					},
+					Source: &sqlast.ConstructorSource{
+						Rows: []*sqlast.RowValueExpr{
+							{
+								LParen: sqltoken.NewPos(1, 22),
+								RParen: sqltoken.NewPos(1, 25),
+								Values: []sqlast.Node{
+									&sqlast.LongValue{
+										From: sqltoken.NewPos(1, 23),
+										To:   sqltoken.NewPos(1, 24),
+										Long: 1,
+									},
+								},
+							},
+						},
+					},
+					Returning: []sqlast.SQLSelectItem{
+						&sqlast.UnnamedSelectItem{
+							Node: sqlast.NewIdentWithPos("id", sqltoken.NewPos(1, 36), sqltoken.NewPos(1, 38)),
+						},
+					},
+				},
+			},
+			{
+				name: "default and null values",
+				in:   "INSERT INTO t VALUES (DEFAULT, NULL, 3)",
+				out: &sqlast.InsertStmt{
+					Insert: sqltoken.NewPos(1, 1),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 14)),
+						},
+					},
+					Source: &sqlast.ConstructorSource{
+						Rows: []*sqlast.RowValueExpr{
+							{
+								LParen: sqltoken.NewPos(1, 22),
+								RParen: sqltoken.NewPos(1, 40),
+								Values: []sqlast.Node{
+									&sqlast.DefaultExpr{
+										From: sqltoken.NewPos(1, 23),
+										To:   sqltoken.NewPos(1, 30),
+									},
+									&sqlast.NullValue{
+										From: sqltoken.NewPos(1, 32),
+										To:   sqltoken.NewPos(1, 36),
+									},
+									&sqlast.LongValue{
+										From: sqltoken.NewPos(1, 38),
+										To:   sqltoken.NewPos(1, 39),
+										Long: 3,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "default values",
+				in:   "INSERT INTO t DEFAULT VALUES",
+				out: &sqlast.InsertStmt{
+					Insert: sqltoken.NewPos(1, 1),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 14)),
+						},
+					},
+					Source: &sqlast.DefaultValuesSource{
+						Default: sqltoken.NewPos(1, 15),
+						Values:  sqltoken.NewPos(1, 29),
+					},
+				},
+			},
+			{
+				name: "multi record case",
+				in: `INSERT INTO customers (customer_name, contract_name) VALUES
+('Cardinal', 'Tom B. Erichsen'),
+('Cardinal', 'Tom B. Erichsen')`,
+				out: &sqlast.InsertStmt{
+					Insert: sqltoken.NewPos(1, 1),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							{
+								Value: "customers",
+								From:  sqltoken.NewPos(1, 13),
+								To:    sqltoken.NewPos(1, 22),
+							},
+						},
+					},
+					Columns: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("customer_name", sqltoken.NewPos(1, 24), sqltoken.NewPos(1, 37)),
+						sqlast.NewIdentWithPos("contract_name", sqltoken.NewPos(1, 39), sqltoken.NewPos(1, 52)),
+					},
+					Source: &sqlast.ConstructorSource{
+						Rows: []*sqlast.RowValueExpr{
+							{
+								LParen: sqltoken.NewPos(2, 1),
+								RParen: sqltoken.NewPos(2, 32),
+								Values: []sqlast.Node{
+									&sqlast.SingleQuotedString{
+										From:   sqltoken.NewPos(2, 2),
+										To:     sqltoken.NewPos(2, 12),
+										String: "Cardinal",
+									},
+									&sqlast.SingleQuotedString{
+										From:   sqltoken.NewPos(2, 14),
+										To:     sqltoken.NewPos(2, 31),
+										String: "Tom B. Erichsen",
+									},
+								},
+							},
+							{
+								LParen: sqltoken.NewPos(3, 1),
+								RParen: sqltoken.NewPos(3, 32),
+								Values: []sqlast.Node{
+									&sqlast.SingleQuotedString{
+										From:   sqltoken.NewPos(3, 2),
+										To:     sqltoken.NewPos(3, 12),
+										String: "Cardinal",
+									},
+									&sqlast.SingleQuotedString{
+										From:   sqltoken.NewPos(3, 14),
+										To:     sqltoken.NewPos(3, 31),
+										String: "Tom B. Erichsen",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		for _, c := range cases {
+
+			t.Run(c.name, func(t *testing.T) {
+				if c.skip {
+					t.Skip()
+				}
+				parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				ast, err := parser.ParseStatement()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if diff := CompareWithoutMarker(c.out, ast); diff != "" {
+					t.Errorf("diff %s", diff)
+				}
+			})
+		}
+	})
+
+	t.Run("alter", func(t *testing.T) {
+		cases := []struct {
+			name string
+			in   string
+			out  sqlast.Stmt
+			skip bool
+		}{
+			{
+				name: "add column",
+				in: `
+ALTER TABLE customers
+ADD COLUMN email character varying(255)`,
+				out: &sqlast.AlterTableStmt{
+					Alter: sqltoken.NewPos(2, 1),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("customers", sqltoken.NewPos(2, 13), sqltoken.NewPos(2, 22)),
+						},
+					},
+					Action: &sqlast.AddColumnTableAction{
+						Add: sqltoken.NewPos(3, 1),
+						Column: &sqlast.ColumnDef{
+							Name: sqlast.NewIdentWithPos("email", sqltoken.NewPos(3, 12), sqltoken.NewPos(3, 17)),
+							DataType: &sqlast.VarcharType{
+								Size:      sqlast.NewSize(255),
+								Character: sqltoken.NewPos(3, 18),
+								Varying:   sqltoken.NewPos(3, 35),
+								RParen:    sqltoken.NewPos(3, 40),
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "add constraint",
+				in: `
+ALTER TABLE products
+ADD FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)`,
+				out: &sqlast.AlterTableStmt{
+					Alter: sqltoken.NewPos(2, 1),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("products", sqltoken.NewPos(2, 13), sqltoken.NewPos(2, 21)),
+						},
+					},
+					Action: &sqlast.AddConstraintTableAction{
+						Add: sqltoken.NewPos(3, 1),
+						Constraint: &sqlast.TableConstraint{
+							Spec: &sqlast.ReferentialTableConstraint{
+								Foreign: sqltoken.NewPos(3, 5),
+								Columns: []*sqlast.Ident{
+									sqlast.NewIdentWithPos("test_id", sqltoken.NewPos(3, 17), sqltoken.NewPos(3, 24)),
+								},
+								KeyExpr: &sqlast.ReferenceKeyExpr{
+									TableName: sqlast.NewIdentWithPos("other_table", sqltoken.NewPos(3, 37), sqltoken.NewPos(3, 48)),
+									Columns: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("col1", sqltoken.NewPos(3, 49), sqltoken.NewPos(3, 53)),
+										sqlast.NewIdentWithPos("col2", sqltoken.NewPos(3, 55), sqltoken.NewPos(3, 59)),
+									},
+									RParen: sqltoken.NewPos(3, 60),
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "drop constraint",
+				in: `ALTER TABLE products
+DROP CONSTRAINT fk CASCADE`,
+				out: &sqlast.AlterTableStmt{
+					Alter: sqltoken.NewPos(1, 1),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("products", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 21)),
+						},
+					},
+					Action: &sqlast.DropConstraintTableAction{
+						Drop:       sqltoken.NewPos(2, 1),
+						Name:       sqlast.NewIdentWithPos("fk", sqltoken.NewPos(2, 17), sqltoken.NewPos(2, 19)),
+						Cascade:    true,
+						CascadePos: sqltoken.NewPos(2, 27),
+					},
+				},
+			},
+			{
+				name: "remove column",
+				in: `ALTER TABLE products
+DROP COLUMN description CASCADE`,
+				out: &sqlast.AlterTableStmt{
+					Alter: sqltoken.NewPos(1, 1),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("products", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 21)),
+						},
+					},
+					Action: &sqlast.RemoveColumnTableAction{
+						Drop:       sqltoken.NewPos(2, 1),
+						Name:       sqlast.NewIdentWithPos("description", sqltoken.NewPos(2, 13), sqltoken.NewPos(2, 24)),
+						Cascade:    true,
+						CascadePos: sqltoken.NewPos(2, 32),
+					},
+				},
+			},
+			{
+				name: "alter column",
+				in: `ALTER TABLE products
+ALTER COLUMN created_at SET DEFAULT current_timestamp`,
+				out: &sqlast.AlterTableStmt{
+					Alter: sqltoken.NewPos(1, 1),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("products", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 21)),
+						},
+					},
+					Action: &sqlast.AlterColumnTableAction{
+						Alter:      sqltoken.NewPos(2, 1),
+						ColumnName: sqlast.NewIdentWithPos("created_at", sqltoken.NewPos(2, 14), sqltoken.NewPos(2, 24)),
+						Action: &sqlast.SetDefaultColumnAction{
+							Set:     sqltoken.NewPos(2, 25),
+							Default: sqlast.NewIdentWithPos("current_timestamp", sqltoken.NewPos(2, 37), sqltoken.NewPos(2, 54)),
+						},
+					},
+				},
+			},
+			{
+				name: "pg change type",
+				in: `ALTER TABLE products
+ALTER COLUMN number TYPE numeric(255,10)`,
+				out: &sqlast.AlterTableStmt{
+					Alter: sqltoken.NewPos(1, 1),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("products", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 21)),
+						},
+					},
+					Action: &sqlast.AlterColumnTableAction{
+						Alter:      sqltoken.NewPos(2, 1),
+						ColumnName: sqlast.NewIdentWithPos("number", sqltoken.NewPos(2, 14), sqltoken.NewPos(2, 20)),
+						Action: &sqlast.PGAlterDataTypeColumnAction{
+							Type: sqltoken.NewPos(2, 21),
+							DataType: &sqlast.Decimal{
+								Scale:     sqlast.NewSize(10),
+								Precision: sqlast.NewSize(255),
+								Numeric:   sqltoken.NewPos(2, 26),
+								RParen:    sqltoken.NewPos(2, 41),
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "pg change type using",
+				in: `ALTER TABLE products
+ALTER COLUMN number TYPE int USING number::int`,
+				out: &sqlast.AlterTableStmt{
+					Alter: sqltoken.NewPos(1, 1),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("products", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 21)),
+						},
+					},
+					Action: &sqlast.AlterColumnTableAction{
+						Alter:      sqltoken.NewPos(2, 1),
+						ColumnName: sqlast.NewIdentWithPos("number", sqltoken.NewPos(2, 14), sqltoken.NewPos(2, 20)),
+						Action: &sqlast.PGAlterDataTypeColumnAction{
+							Type: sqltoken.NewPos(2, 21),
+							DataType: &sqlast.Int{
+								From: sqltoken.NewPos(2, 26),
+								To:   sqltoken.NewPos(2, 29),
+							},
+							Using: &sqlast.Cast{
+								Expr: sqlast.NewIdentWithPos("number", sqltoken.NewPos(2, 36), sqltoken.NewPos(2, 42)),
+								DateType: &sqlast.Int{
+									From: sqltoken.NewPos(2, 44),
+									To:   sqltoken.NewPos(2, 47),
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		for _, c := range cases {
+
+			t.Run(c.name, func(t *testing.T) {
+				if c.skip {
+					t.Skip()
+				}
+				parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				ast, err := parser.ParseStatement()
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+
+				if diff := CompareWithoutMarker(c.out, ast); diff != "" {
+					t.Errorf("diff %s", diff)
+				}
+			})
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		cases := []struct {
+			name string
+			in   string
+			out  sqlast.Stmt
+			skip bool
+		}{
+			{
+				name: "simple case",
+				in:   "UPDATE customers SET contract_name = 'Alfred Schmidt', city = 'Frankfurt' WHERE customer_id = 1",
+				out: &sqlast.UpdateStmt{
+					Update: sqltoken.NewPos(1, 1),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							{
+								Value: "customers",
+								From:  sqltoken.NewPos(1, 8),
+								To:    sqltoken.NewPos(1, 17),
+							},
+						},
+					},
+					Assignments: []*sqlast.Assignment{
+						{
+							ID: sqlast.NewIdentWithPos("contract_name", sqltoken.NewPos(1, 22), sqltoken.NewPos(1, 35)),
+							Value: &sqlast.SingleQuotedString{
+								From:   sqltoken.NewPos(1, 38),
+								To:     sqltoken.NewPos(1, 54),
+								String: "Alfred Schmidt",
+							},
+						},
+						{
+							ID:    sqlast.NewIdentWithPos("city", sqltoken.NewPos(1, 56), sqltoken.NewPos(1, 60)),
+							Value: &sqlast.SingleQuotedString{String: "Frankfurt", From: sqltoken.NewPos(1, 63), To: sqltoken.NewPos(1, 74)},
+						},
+					},
+					Selection: &sqlast.BinaryExpr{
+						Op:   &sqlast.Operator{Type: sqlast.Eq, From: sqltoken.NewPos(1, 93), To: sqltoken.NewPos(1, 94)},
+						Left: sqlast.NewIdentWithPos("customer_id", sqltoken.NewPos(1, 81), sqltoken.NewPos(1, 92)),
+						Right: &sqlast.LongValue{
+							From: sqltoken.NewPos(1, 95),
+							To:   sqltoken.NewPos(1, 96),
+							Long: 1,
+						},
+					},
+				},
+			},
+		}
+
+		for _, c := range cases {
+
+			t.Run(c.name, func(t *testing.T) {
+				if c.skip {
+					t.Skip()
+				}
+				parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				ast, err := parser.ParseStatement()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if diff := CompareWithoutMarker(c.out, ast); diff != "" {
+					t.Errorf("diff %s", diff)
+				}
+			})
+		}
+	})
+}
+
+func TestParser_ParseExpr(t *testing.T) {
+	cases := []struct {
+		name    string
+		dialect dialect.Dialect
+		in      string
+		out     sqlast.Node
+	}{
+		{
+			name: "boolean literal",
+			in:   "active = TRUE",
+			out: &sqlast.BinaryExpr{
+				Left:  sqlast.NewIdentWithPos("active", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 7)),
+				Op:    &sqlast.Operator{Type: sqlast.Eq, From: sqltoken.NewPos(1, 8), To: sqltoken.NewPos(1, 9)},
+				Right: &sqlast.BooleanValue{From: sqltoken.NewPos(1, 10), To: sqltoken.NewPos(1, 14), Boolean: true},
+			},
+		},
+		{
+			name: "false literal",
+			in:   "false",
+			out:  &sqlast.BooleanValue{From: sqltoken.NewPos(1, 1), To: sqltoken.NewPos(1, 6), Boolean: false},
+		},
+		{
+			name: "null literal",
+			in:   "NULL",
+			out:  &sqlast.NullValue{From: sqltoken.NewPos(1, 1), To: sqltoken.NewPos(1, 5)},
+		},
+		{
+			name: "unknown is an identifier",
+			in:   "flag = unknown",
+			out: &sqlast.BinaryExpr{
+				Left:  sqlast.NewIdentWithPos("flag", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 5)),
+				Op:    &sqlast.Operator{Type: sqlast.Eq, From: sqltoken.NewPos(1, 6), To: sqltoken.NewPos(1, 7)},
+				Right: sqlast.NewIdentWithPos("unknown", sqltoken.NewPos(1, 8), sqltoken.NewPos(1, 15)),
+			},
+		},
+		{
+			name: "is unknown",
+			in:   "x IS UNKNOWN",
+			out: &sqlast.IsTruthValue{
+				X:     sqlast.NewIdentWithPos("x", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 2)),
+				Value: &sqlast.NullValue{From: sqltoken.NewPos(1, 6), To: sqltoken.NewPos(1, 13), Unknown: true},
+			},
+		},
+		{
+			name: "is true",
+			in:   "x IS TRUE",
+			out: &sqlast.IsTruthValue{
+				X:     sqlast.NewIdentWithPos("x", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 2)),
+				Value: &sqlast.BooleanValue{From: sqltoken.NewPos(1, 6), To: sqltoken.NewPos(1, 10), Boolean: true},
+			},
+		},
+		{
+			name: "is not false",
+			in:   "x IS NOT FALSE",
+			out: &sqlast.IsTruthValue{
+				X:       sqlast.NewIdentWithPos("x", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 2)),
+				Negated: true,
+				Value:   &sqlast.BooleanValue{From: sqltoken.NewPos(1, 10), To: sqltoken.NewPos(1, 15), Boolean: false},
+			},
+		},
+		{
+			name: "is null",
+			in:   "x IS NULL",
+			out: &sqlast.IsNull{
+				X: sqlast.NewIdentWithPos("x", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 2)),
+			},
+		},
+		{
+			name: "unary minus binds tighter than multiply",
+			in:   "-a * b",
+			out: &sqlast.BinaryExpr{
+				Left: &sqlast.UnaryExpr{
+					From: sqltoken.NewPos(1, 1),
+					Op:   &sqlast.Operator{Type: sqlast.Minus, From: sqltoken.NewPos(1, 1), To: sqltoken.NewPos(1, 2)},
+					Expr: sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 2), sqltoken.NewPos(1, 3)),
+				},
+				Op:    &sqlast.Operator{Type: sqlast.Multiply, From: sqltoken.NewPos(1, 4), To: sqltoken.NewPos(1, 5)},
+				Right: sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 6), sqltoken.NewPos(1, 7)),
+			},
+		},
+		{
+			name: "unary minus binds tighter than plus",
+			in:   "-a + b",
+			out: &sqlast.BinaryExpr{
+				Left: &sqlast.UnaryExpr{
+					From: sqltoken.NewPos(1, 1),
+					Op:   &sqlast.Operator{Type: sqlast.Minus, From: sqltoken.NewPos(1, 1), To: sqltoken.NewPos(1, 2)},
+					Expr: sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 2), sqltoken.NewPos(1, 3)),
+				},
+				Op:    &sqlast.Operator{Type: sqlast.Plus, From: sqltoken.NewPos(1, 4), To: sqltoken.NewPos(1, 5)},
+				Right: sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 6), sqltoken.NewPos(1, 7)),
+			},
+		},
+		{
+			name: "bitwise not",
+			in:   "~a * b",
+			out: &sqlast.BinaryExpr{
+				Left: &sqlast.UnaryExpr{
+					From: sqltoken.NewPos(1, 1),
+					Op:   &sqlast.Operator{Type: sqlast.BitwiseNot, From: sqltoken.NewPos(1, 1), To: sqltoken.NewPos(1, 2)},
+					Expr: sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 2), sqltoken.NewPos(1, 3)),
+				},
+				Op:    &sqlast.Operator{Type: sqlast.Multiply, From: sqltoken.NewPos(1, 4), To: sqltoken.NewPos(1, 5)},
+				Right: sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 6), sqltoken.NewPos(1, 7)),
+			},
+		},
+		{
+			name: "unary minus before paren",
+			in:   "-(a+b)",
+			out: &sqlast.UnaryExpr{
+				From: sqltoken.NewPos(1, 1),
+				Op:   &sqlast.Operator{Type: sqlast.Minus, From: sqltoken.NewPos(1, 1), To: sqltoken.NewPos(1, 2)},
+				Expr: &sqlast.Nested{
+					LParen: sqltoken.NewPos(1, 2),
+					RParen: sqltoken.NewPos(1, 7),
+					AST: &sqlast.BinaryExpr{
+						Left:  sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 3), sqltoken.NewPos(1, 4)),
+						Op:    &sqlast.Operator{Type: sqlast.Plus, From: sqltoken.NewPos(1, 4), To: sqltoken.NewPos(1, 5)},
+						Right: sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 5), sqltoken.NewPos(1, 6)),
+					},
+				},
+			},
+		},
+		{
+			name: "double negation",
+			in:   "- -a",
+			out: &sqlast.UnaryExpr{
+				From: sqltoken.NewPos(1, 1),
+				Op:   &sqlast.Operator{Type: sqlast.Minus, From: sqltoken.NewPos(1, 1), To: sqltoken.NewPos(1, 2)},
+				Expr: &sqlast.UnaryExpr{
+					From: sqltoken.NewPos(1, 3),
+					Op:   &sqlast.Operator{Type: sqlast.Minus, From: sqltoken.NewPos(1, 3), To: sqltoken.NewPos(1, 4)},
+					Expr: sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 4), sqltoken.NewPos(1, 5)),
+				},
+			},
+		},
+		{
+			name: "unary minus before function",
+			in:   "-count(*)",
+			out: &sqlast.UnaryExpr{
+				From: sqltoken.NewPos(1, 1),
+				Op:   &sqlast.Operator{Type: sqlast.Minus, From: sqltoken.NewPos(1, 1), To: sqltoken.NewPos(1, 2)},
+				Expr: &sqlast.Function{
+					Name: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("count", sqltoken.NewPos(1, 2), sqltoken.NewPos(1, 7)),
+						},
+					},
+					Args:       []sqlast.Node{&sqlast.Wildcard{Wildcard: sqltoken.NewPos(1, 8)}},
+					ArgsRParen: sqltoken.NewPos(1, 10),
+				},
+			},
+		},
+		{
+			name: "not binds tighter than and",
+			in:   "NOT a AND b",
+			out: &sqlast.BinaryExpr{
+				Left: &sqlast.UnaryExpr{
+					From: sqltoken.NewPos(1, 1),
+					Op:   &sqlast.Operator{Type: sqlast.Not, From: sqltoken.NewPos(1, 1), To: sqltoken.NewPos(1, 4)},
+					Expr: sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 5), sqltoken.NewPos(1, 6)),
+				},
+				Op:    &sqlast.Operator{Type: sqlast.And, From: sqltoken.NewPos(1, 7), To: sqltoken.NewPos(1, 10)},
+				Right: sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 11), sqltoken.NewPos(1, 12)),
+			},
+		},
+		{
+			name: "named function arguments",
+			in:   "make_interval(1, days => 5, hours := 2)",
+			out: &sqlast.Function{
+				Name: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("make_interval", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 14)),
+					},
+				},
+				Args: []sqlast.Node{
+					&sqlast.LongValue{From: sqltoken.NewPos(1, 15), To: sqltoken.NewPos(1, 16), Long: 1},
+					&sqlast.NamedArg{
+						Name:  sqlast.NewIdentWithPos("days", sqltoken.NewPos(1, 18), sqltoken.NewPos(1, 22)),
+						Value: &sqlast.LongValue{From: sqltoken.NewPos(1, 26), To: sqltoken.NewPos(1, 27), Long: 5},
+					},
+					&sqlast.NamedArg{
+						Name:    sqlast.NewIdentWithPos("hours", sqltoken.NewPos(1, 29), sqltoken.NewPos(1, 34)),
+						ColonEq: true,
+						Value:   &sqlast.LongValue{From: sqltoken.NewPos(1, 38), To: sqltoken.NewPos(1, 39), Long: 2},
+					},
+				},
+				ArgsRParen: sqltoken.NewPos(1, 40),
+			},
+		},
+		{
+			name: "extract",
+			in:   "EXTRACT(YEAR FROM ts)",
+			out: &sqlast.ExtractExpr{
+				Extract: sqltoken.NewPos(1, 1),
+				Field:   sqlast.NewIdentWithPos("YEAR", sqltoken.NewPos(1, 9), sqltoken.NewPos(1, 13)),
+				Expr:    sqlast.NewIdentWithPos("ts", sqltoken.NewPos(1, 19), sqltoken.NewPos(1, 21)),
+				RParen:  sqltoken.NewPos(1, 22),
+			},
+		},
+		{
+			name: "extract epoch",
+			in:   "EXTRACT(EPOCH FROM ts)",
+			out: &sqlast.ExtractExpr{
+				Extract: sqltoken.NewPos(1, 1),
+				Field:   sqlast.NewIdentWithPos("EPOCH", sqltoken.NewPos(1, 9), sqltoken.NewPos(1, 14)),
+				Expr:    sqlast.NewIdentWithPos("ts", sqltoken.NewPos(1, 20), sqltoken.NewPos(1, 22)),
+				RParen:  sqltoken.NewPos(1, 23),
+			},
+		},
+		{
+			name: "extract dow",
+			in:   "EXTRACT(dow FROM ts)",
+			out: &sqlast.ExtractExpr{
+				Extract: sqltoken.NewPos(1, 1),
+				Field:   sqlast.NewIdentWithPos("dow", sqltoken.NewPos(1, 9), sqltoken.NewPos(1, 12)),
+				Expr:    sqlast.NewIdentWithPos("ts", sqltoken.NewPos(1, 18), sqltoken.NewPos(1, 20)),
+				RParen:  sqltoken.NewPos(1, 21),
+			},
+		},
+		{
+			name: "extract quoted field",
+			in:   "EXTRACT('isodow' FROM ts)",
+			out: &sqlast.ExtractExpr{
+				Extract:     sqltoken.NewPos(1, 1),
+				FieldString: "isodow",
+				Expr:        sqlast.NewIdentWithPos("ts", sqltoken.NewPos(1, 23), sqltoken.NewPos(1, 25)),
+				RParen:      sqltoken.NewPos(1, 26),
+			},
+		},
+		{
+			name: "string concat is left associative",
+			in:   "a || b || c",
+			out: &sqlast.BinaryExpr{
+				Left: &sqlast.BinaryExpr{
+					Left:  sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 2)),
+					Op:    &sqlast.Operator{Type: sqlast.StringConcat, From: sqltoken.NewPos(1, 3), To: sqltoken.NewPos(1, 5)},
+					Right: sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 6), sqltoken.NewPos(1, 7)),
+				},
+				Op:    &sqlast.Operator{Type: sqlast.StringConcat, From: sqltoken.NewPos(1, 8), To: sqltoken.NewPos(1, 10)},
+				Right: sqlast.NewIdentWithPos("c", sqltoken.NewPos(1, 11), sqltoken.NewPos(1, 12)),
+			},
+		},
+		{
+			name: "string concat binds looser than plus",
+			in:   "a || b + c",
+			out: &sqlast.BinaryExpr{
+				Left: sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 2)),
+				Op:   &sqlast.Operator{Type: sqlast.StringConcat, From: sqltoken.NewPos(1, 3), To: sqltoken.NewPos(1, 5)},
+				Right: &sqlast.BinaryExpr{
+					Left:  sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 6), sqltoken.NewPos(1, 7)),
+					Op:    &sqlast.Operator{Type: sqlast.Plus, From: sqltoken.NewPos(1, 8), To: sqltoken.NewPos(1, 9)},
+					Right: sqlast.NewIdentWithPos("c", sqltoken.NewPos(1, 10), sqltoken.NewPos(1, 11)),
+				},
+			},
+		},
+		{
+			name: "bitwise operators",
+			in:   "a & 4 | 1 << 2",
+			out: &sqlast.BinaryExpr{
+				Left: &sqlast.BinaryExpr{
+					Left:  sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 2)),
+					Op:    &sqlast.Operator{Type: sqlast.BitwiseAnd, From: sqltoken.NewPos(1, 3), To: sqltoken.NewPos(1, 4)},
+					Right: &sqlast.LongValue{From: sqltoken.NewPos(1, 5), To: sqltoken.NewPos(1, 6), Long: 4},
+				},
+				Op: &sqlast.Operator{Type: sqlast.BitwiseOr, From: sqltoken.NewPos(1, 7), To: sqltoken.NewPos(1, 8)},
+				Right: &sqlast.BinaryExpr{
+					Left:  &sqlast.LongValue{From: sqltoken.NewPos(1, 9), To: sqltoken.NewPos(1, 10), Long: 1},
+					Op:    &sqlast.Operator{Type: sqlast.ShiftLeft, From: sqltoken.NewPos(1, 11), To: sqltoken.NewPos(1, 13)},
+					Right: &sqlast.LongValue{From: sqltoken.NewPos(1, 14), To: sqltoken.NewPos(1, 15), Long: 2},
+				},
+			},
+		},
+		{
+			name: "shift right and xor",
+			in:   "a >> 2 ^ b",
+			out: &sqlast.BinaryExpr{
+				Left: &sqlast.BinaryExpr{
+					Left:  sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 2)),
+					Op:    &sqlast.Operator{Type: sqlast.ShiftRight, From: sqltoken.NewPos(1, 3), To: sqltoken.NewPos(1, 5)},
+					Right: &sqlast.LongValue{From: sqltoken.NewPos(1, 6), To: sqltoken.NewPos(1, 7), Long: 2},
+				},
+				Op:    &sqlast.Operator{Type: sqlast.BitwiseXor, From: sqltoken.NewPos(1, 8), To: sqltoken.NewPos(1, 9)},
+				Right: sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 10), sqltoken.NewPos(1, 11)),
+			},
+		},
+		{
+			name:    "double quoted string in mysql",
+			dialect: &dialect.MySQLDialect{},
+			in:      `"abc" = a`,
+			out: &sqlast.BinaryExpr{
+				Left:  &sqlast.SingleQuotedString{From: sqltoken.NewPos(1, 1), To: sqltoken.NewPos(1, 6), String: "abc"},
+				Op:    &sqlast.Operator{Type: sqlast.Eq, From: sqltoken.NewPos(1, 7), To: sqltoken.NewPos(1, 8)},
+				Right: sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 9), sqltoken.NewPos(1, 10)),
+			},
+		},
+		{
+			name:    "double pipe is logical or in mysql",
+			dialect: &dialect.MySQLDialect{},
+			in:      "a || b AND c",
+			out: &sqlast.BinaryExpr{
+				Left: sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 2)),
+				Op:   &sqlast.Operator{Type: sqlast.Or, From: sqltoken.NewPos(1, 3), To: sqltoken.NewPos(1, 5)},
+				Right: &sqlast.BinaryExpr{
+					Left:  sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 6), sqltoken.NewPos(1, 7)),
+					Op:    &sqlast.Operator{Type: sqlast.And, From: sqltoken.NewPos(1, 8), To: sqltoken.NewPos(1, 11)},
+					Right: sqlast.NewIdentWithPos("c", sqltoken.NewPos(1, 12), sqltoken.NewPos(1, 13)),
+				},
+			},
+		},
+		{
+			name: "ordered aggregate with distinct",
+			in:   "array_agg(DISTINCT x ORDER BY x DESC)",
+			out: &sqlast.Function{
+				Name: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("array_agg", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 10))},
+				},
+				Quantifier: sqlast.AggregateDistinct,
+				Args: []sqlast.Node{
+					sqlast.NewIdentWithPos("x", sqltoken.NewPos(1, 20), sqltoken.NewPos(1, 21)),
+				},
+				OrderBy: []*sqlast.OrderByExpr{
+					{
+						Expr:        sqlast.NewIdentWithPos("x", sqltoken.NewPos(1, 31), sqltoken.NewPos(1, 32)),
+						OrderingPos: sqltoken.NewPos(1, 37),
+						ASC:         func() *bool { b := false; return &b }(),
+					},
+				},
+				ArgsRParen: sqltoken.NewPos(1, 38),
+			},
+		},
+		{
+			name: "aggregate with all",
+			in:   "count(ALL x)",
+			out: &sqlast.Function{
+				Name: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("count", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 6))},
+				},
+				Quantifier: sqlast.AggregateAll,
+				Args: []sqlast.Node{
+					sqlast.NewIdentWithPos("x", sqltoken.NewPos(1, 11), sqltoken.NewPos(1, 12)),
+				},
+				ArgsRParen: sqltoken.NewPos(1, 13),
+			},
+		},
+		{
+			name: "quantified comparison with subquery",
+			in:   "x > ALL (SELECT y FROM t)",
+			out: &sqlast.QuantifiedComparison{
+				Left:          sqlast.NewIdentWithPos("x", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 2)),
+				Op:            &sqlast.Operator{Type: sqlast.Gt, From: sqltoken.NewPos(1, 3), To: sqltoken.NewPos(1, 4)},
+				Quantifier:    sqlast.QuantifierAll,
+				QuantifierPos: sqltoken.NewPos(1, 5),
+				Right: &sqlast.SubQuery{
+					LParen: sqltoken.NewPos(1, 9),
+					RParen: sqltoken.NewPos(1, 26),
+					Query: &sqlast.QueryStmt{
+						Body: &sqlast.SQLSelect{
+							Select: sqltoken.NewPos(1, 10),
+							Projection: []sqlast.SQLSelectItem{
+								&sqlast.UnnamedSelectItem{
+									Node: sqlast.NewIdentWithPos("y", sqltoken.NewPos(1, 17), sqltoken.NewPos(1, 18)),
+								},
+							},
+							FromClause: []sqlast.TableReference{
+								&sqlast.Table{
+									Name: &sqlast.ObjectName{
+										Idents: []*sqlast.Ident{
+											sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 24), sqltoken.NewPos(1, 25)),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "quantified comparison with array",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "x = ANY (ids) AND y <> SOME (names)",
+			out: &sqlast.BinaryExpr{
+				Left: &sqlast.QuantifiedComparison{
+					Left:          sqlast.NewIdentWithPos("x", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 2)),
+					Op:            &sqlast.Operator{Type: sqlast.Eq, From: sqltoken.NewPos(1, 3), To: sqltoken.NewPos(1, 4)},
+					Quantifier:    sqlast.QuantifierAny,
+					QuantifierPos: sqltoken.NewPos(1, 5),
+					Right: &sqlast.Nested{
+						LParen: sqltoken.NewPos(1, 9),
+						RParen: sqltoken.NewPos(1, 14),
+						AST:    sqlast.NewIdentWithPos("ids", sqltoken.NewPos(1, 10), sqltoken.NewPos(1, 13)),
+					},
+				},
+				Op: &sqlast.Operator{Type: sqlast.And, From: sqltoken.NewPos(1, 15), To: sqltoken.NewPos(1, 18)},
+				Right: &sqlast.QuantifiedComparison{
+					Left:          sqlast.NewIdentWithPos("y", sqltoken.NewPos(1, 19), sqltoken.NewPos(1, 20)),
+					Op:            &sqlast.Operator{Type: sqlast.NotEq, From: sqltoken.NewPos(1, 21), To: sqltoken.NewPos(1, 23)},
+					Quantifier:    sqlast.QuantifierSome,
+					QuantifierPos: sqltoken.NewPos(1, 24),
+					Right: &sqlast.Nested{
+						LParen: sqltoken.NewPos(1, 29),
+						RParen: sqltoken.NewPos(1, 36),
+						AST:    sqlast.NewIdentWithPos("names", sqltoken.NewPos(1, 30), sqltoken.NewPos(1, 35)),
+					},
+				},
+			},
+		},
+		{
+			name:    "quantified comparison with array constructor",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "x = ANY (ARRAY[1, 2])",
+			out: &sqlast.QuantifiedComparison{
+				Left:          sqlast.NewIdentWithPos("x", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 2)),
+				Op:            &sqlast.Operator{Type: sqlast.Eq, From: sqltoken.NewPos(1, 3), To: sqltoken.NewPos(1, 4)},
+				Quantifier:    sqlast.QuantifierAny,
+				QuantifierPos: sqltoken.NewPos(1, 5),
+				Right: &sqlast.Nested{
+					LParen: sqltoken.NewPos(1, 9),
+					RParen: sqltoken.NewPos(1, 22),
+					AST: &sqlast.ArrayConstructor{
+						Array: sqltoken.NewPos(1, 10),
+						Elements: []sqlast.Node{
+							&sqlast.LongValue{From: sqltoken.NewPos(1, 16), To: sqltoken.NewPos(1, 17), Long: 1},
+							&sqlast.LongValue{From: sqltoken.NewPos(1, 19), To: sqltoken.NewPos(1, 20), Long: 2},
+						},
+						RBracket: sqltoken.NewPos(1, 21),
+					},
+				},
+			},
+		},
+		{
+			name: "empty array constructor",
+			in:   "ARRAY[]",
+			out: &sqlast.ArrayConstructor{
+				Array:    sqltoken.NewPos(1, 1),
+				RBracket: sqltoken.NewPos(1, 8),
+			},
+		},
+		{
+			name: "overlaps",
+			in:   "(DATE '2020-01-01', DATE '2020-01-31') OVERLAPS (DATE '2020-01-15', d)",
+			out: &sqlast.OverlapsExpr{
+				Left: &sqlast.RowValueExpr{
+					LParen: sqltoken.NewPos(1, 1),
+					RParen: sqltoken.NewPos(1, 39),
+					Values: []sqlast.Node{
+						&sqlast.TypedLiteral{
+							From:    sqltoken.NewPos(1, 2),
+							Type:    sqlast.DateLiteral,
+							Literal: &sqlast.SingleQuotedString{From: sqltoken.NewPos(1, 7), To: sqltoken.NewPos(1, 19), String: "2020-01-01"},
+						},
+						&sqlast.TypedLiteral{
+							From:    sqltoken.NewPos(1, 21),
+							Type:    sqlast.DateLiteral,
+							Literal: &sqlast.SingleQuotedString{From: sqltoken.NewPos(1, 26), To: sqltoken.NewPos(1, 38), String: "2020-01-31"},
+						},
+					},
+				},
+				Overlaps: sqltoken.NewPos(1, 40),
+				Right: &sqlast.RowValueExpr{
+					LParen: sqltoken.NewPos(1, 49),
+					RParen: sqltoken.NewPos(1, 71),
+					Values: []sqlast.Node{
+						&sqlast.TypedLiteral{
+							From:    sqltoken.NewPos(1, 50),
+							Type:    sqlast.DateLiteral,
+							Literal: &sqlast.SingleQuotedString{From: sqltoken.NewPos(1, 55), To: sqltoken.NewPos(1, 67), String: "2020-01-15"},
+						},
+						sqlast.NewIdentWithPos("d", sqltoken.NewPos(1, 69), sqltoken.NewPos(1, 70)),
+					},
+				},
+			},
+		},
+		{
+			name: "date literal",
+			in:   "DATE '2020-01-01'",
+			out: &sqlast.TypedLiteral{
+				From:    sqltoken.NewPos(1, 1),
+				Type:    sqlast.DateLiteral,
+				Literal: &sqlast.SingleQuotedString{From: sqltoken.NewPos(1, 6), To: sqltoken.NewPos(1, 18), String: "2020-01-01"},
+			},
+		},
+		{
+			name: "time literal",
+			in:   "TIME '12:00'",
+			out: &sqlast.TypedLiteral{
+				From:    sqltoken.NewPos(1, 1),
+				Type:    sqlast.TimeLiteral,
+				Literal: &sqlast.SingleQuotedString{From: sqltoken.NewPos(1, 6), To: sqltoken.NewPos(1, 13), String: "12:00"},
+			},
+		},
+		{
+			name: "timestamp literal",
+			in:   "TIMESTAMP '2020-01-01 00:00:00'",
+			out: &sqlast.TypedLiteral{
+				From:    sqltoken.NewPos(1, 1),
+				Type:    sqlast.TimestampLiteral,
+				Literal: &sqlast.SingleQuotedString{From: sqltoken.NewPos(1, 11), To: sqltoken.NewPos(1, 32), String: "2020-01-01 00:00:00"},
+			},
+		},
+		{
+			name:    "regex match in postgres",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "col ~ '^a'",
+			out: &sqlast.BinaryExpr{
+				Left:  sqlast.NewIdentWithPos("col", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 4)),
+				Op:    &sqlast.Operator{Type: sqlast.RegexpMatch, From: sqltoken.NewPos(1, 5), To: sqltoken.NewPos(1, 6)},
+				Right: &sqlast.SingleQuotedString{From: sqltoken.NewPos(1, 7), To: sqltoken.NewPos(1, 11), String: "^a"},
+			},
+		},
+		{
+			name:    "negated case insensitive regex match in postgres",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "col !~* 'b$'",
+			out: &sqlast.BinaryExpr{
+				Left:  sqlast.NewIdentWithPos("col", sqltoken.NewPos(1, 1), sqltoken.NewPos(1, 4)),
+				Op:    &sqlast.Operator{Type: sqlast.NotRegexpIMatch, From: sqltoken.NewPos(1, 5), To: sqltoken.NewPos(1, 8)},
+				Right: &sqlast.SingleQuotedString{From: sqltoken.NewPos(1, 9), To: sqltoken.NewPos(1, 13), String: "b$"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := c.dialect
+			if d == nil {
+				d = &dialect.GenericSQLDialect{}
+			}
+			parser, err := NewParser(bytes.NewBufferString(c.in), d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expr, err := parser.ParseExpr()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if diff := CompareWithoutMarker(c.out, expr); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+
+			reparser, err := NewParser(bytes.NewBufferString(expr.ToSQLString()), d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			reparsed, err := reparser.ParseExpr()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if reparsed.ToSQLString() != expr.ToSQLString() {
+				t.Errorf("round trip mismatch: %s, %s", expr.ToSQLString(), reparsed.ToSQLString())
+			}
+		})
+	}
+}
+
+func TestParser_Precedence(t *testing.T) {
+	cases := []struct {
+		in      string
+		out     string
+		dialect dialect.Dialect
+	}{
+		{in: "1 + 2 * 3 = 7 AND NOT x", out: "(((1 + (2 * 3)) = 7) AND (NOT x))"},
+		{in: "a - b - c", out: "((a - b) - c)"},
+		{in: "a / b * c % d", out: "(((a / b) * c) % d)"},
+		{in: "a OR b AND c", out: "(a OR (b AND c))"},
+		{in: "a AND b OR c AND d", out: "((a AND b) OR (c AND d))"},
+		{in: "NOT a = b", out: "(NOT (a = b))"},
+		{in: "NOT a OR b", out: "((NOT a) OR b)"},
+		{in: "a = b IS NULL", out: "((a = b) IS NULL)"},
+		{in: "a IS NULL AND b", out: "((a IS NULL) AND b)"},
+		{in: "NOT a IS NOT NULL", out: "(NOT (a IS NOT NULL))"},
+		{in: "a = b LIKE c", out: "(a = (b LIKE c))"},
+		{in: "a NOT LIKE b || c AND d", out: "((a NOT LIKE (b || c)) AND d)"},
+		{in: "a || b = c", out: "((a || b) = c)"},
+		{in: "a || b + c", out: "(a || (b + c))"},
+		{in: "a + 1 IN (1, 2) = b", out: "(((a + 1) IN (1, 2)) = b)"},
+		{in: "a NOT IN (1) OR b", out: "((a NOT IN (1)) OR b)"},
+		{in: "x = a NOT IN (1)", out: "(x = (a NOT IN (1)))"},
+		{in: "a BETWEEN 1 AND 2 AND b", out: "((a BETWEEN 1 AND 2) AND b)"},
+		{in: "a NOT BETWEEN b + 1 AND c * 2 OR d", out: "((a NOT BETWEEN (b + 1) AND (c * 2)) OR d)"},
+		{in: "a BETWEEN 1 AND 2 = b", out: "((a BETWEEN 1 AND 2) = b)"},
+		{in: "a | b & c", out: "(a | (b & c))"},
+		{in: "a & b << c", out: "(a & (b << c))"},
+		{in: "a << b || c", out: "(a << (b || c))"},
+		{in: "-a * b::int", out: "((- a) * CAST(b AS int))"},
+		{in: "-count(*) * 2", out: "((- count(*)) * 2)"},
+		{in: "- -a + b", out: "((- (- a)) + b)"},
+		{in: "a - -b", out: "(a - (- b))"},
+		{in: "a || b AND c", out: "(a OR (b AND c))", dialect: &dialect.MySQLDialect{}},
+		{in: "a ~ 'x' AND b !~* 'y'", out: "((a ~ 'x') AND (b !~* 'y'))", dialect: &dialect.PostgresqlDialect{}},
+		{in: "a || b ~* c", out: "((a || b) ~* c)", dialect: &dialect.PostgresqlDialect{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			d := c.dialect
+			if d == nil {
+				d = &dialect.GenericSQLDialect{}
+			}
+			parser, err := NewParser(bytes.NewBufferString(c.in), d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expr, err := parser.ParseExpr()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if got := parenthesize(expr); got != c.out {
+				t.Errorf("expected %s but %s", c.out, got)
+			}
+		})
+	}
+}
+
+func TestParser_OperatorAssociativity(t *testing.T) {
+	cases := []struct {
+		in    string
+		key   sqltoken.Kind
+		assoc associativity
+		out   string
+	}{
+		{in: "a - b - c", key: sqltoken.Minus, assoc: leftAssoc, out: "((a - b) - c)"},
+		{in: "a - b - c", key: sqltoken.Minus, assoc: rightAssoc, out: "(a - (b - c))"},
+		{in: "a - b + c", key: sqltoken.Minus, assoc: rightAssoc, out: "(a - (b + c))"},
+		{in: "a << b >> c", key: sqltoken.ShiftLeft, assoc: leftAssoc, out: "((a << b) >> c)"},
+		{in: "a << b << c", key: sqltoken.ShiftLeft, assoc: rightAssoc, out: "(a << (b << c))"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%s with %d", c.in, c.assoc), func(t *testing.T) {
+			orig := binaryOperators[c.key]
+			defer func() { binaryOperators[c.key] = orig }()
+			o := orig
+			o.assoc = c.assoc
+			binaryOperators[c.key] = o
+
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			expr, err := parser.ParseExpr()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got := parenthesize(expr); got != c.out {
+				t.Errorf("expected %s but %s", c.out, got)
+			}
+		})
+	}
+}
+
+// parenthesize renders an expression with every operator application
+// wrapped in parentheses so that the grouping chosen by the parser is visible.
+func parenthesize(node sqlast.Node) string {
+	switch n := node.(type) {
+	case *sqlast.BinaryExpr:
+		return fmt.Sprintf("(%s %s %s)", parenthesize(n.Left), n.Op.ToSQLString(), parenthesize(n.Right))
+	case *sqlast.UnaryExpr:
+		return fmt.Sprintf("(%s %s)", n.Op.ToSQLString(), parenthesize(n.Expr))
+	case *sqlast.IsNull:
+		return fmt.Sprintf("(%s IS NULL)", parenthesize(n.X))
+	case *sqlast.IsNotNull:
+		return fmt.Sprintf("(%s IS NOT NULL)", parenthesize(n.X))
+	case *sqlast.InList:
+		list := make([]string, 0, len(n.List))
+		for _, l := range n.List {
+			list = append(list, parenthesize(l))
+		}
+		op := "IN"
+		if n.Negated {
+			op = "NOT IN"
+		}
+		return fmt.Sprintf("(%s %s (%s))", parenthesize(n.Expr), op, strings.Join(list, ", "))
+	case *sqlast.Between:
+		op := "BETWEEN"
+		if n.Negated {
+			op = "NOT BETWEEN"
+		}
+		return fmt.Sprintf("(%s %s %s AND %s)", parenthesize(n.Expr), op, parenthesize(n.Low), parenthesize(n.High))
+	default:
+		return node.ToSQLString()
+	}
+}
+
+func TestParser_UnexpectedEOF(t *testing.T) {
+	cases := []struct {
+		name       string
+		in         string
+		unexpected bool
+	}{
+		{
+			name:       "incomplete from clause",
+			in:         "SELECT * FROM",
+			unexpected: true,
+		},
+		{
+			name:       "incomplete where clause",
+			in:         "SELECT * FROM t WHERE a =",
+			unexpected: true,
+		},
+		{
+			name:       "incomplete explain",
+			in:         "EXPLAIN",
+			unexpected: true,
+		},
+		{
+			name: "distinct on without parentheses",
+			in:   "SELECT DISTINCT ON a FROM t",
+		},
+		{
+			name:       "incomplete distinct on",
+			in:         "SELECT DISTINCT ON",
+			unexpected: true,
+		},
+		{
+			name: "tablesample without parentheses",
+			in:   "SELECT * FROM t TABLESAMPLE bernoulli 10",
+		},
+		{
+			name:       "incomplete tablesample",
+			in:         "SELECT * FROM t TABLESAMPLE bernoulli",
+			unexpected: true,
+		},
+		{
+			name: "repeatable without parentheses",
+			in:   "SELECT * FROM t TABLESAMPLE bernoulli (10) REPEATABLE 1",
+		},
+		{
+			name: "prepare without as",
+			in:   "PREPARE p SELECT 1",
+		},
+		{
+			name:       "incomplete prepare",
+			in:         "PREPARE p",
+			unexpected: true,
+		},
+		{
+			name:       "incomplete create",
+			in:         "CREATE",
+			unexpected: true,
+		},
+		{
+			name:       "incomplete limit",
+			in:         "SELECT * FROM t LIMIT",
+			unexpected: true,
+		},
+		{
+			name:       "incomplete between",
+			in:         "SELECT a BETWEEN 1",
+			unexpected: true,
+		},
+		{
+			name:       "incomplete case",
+			in:         "SELECT CASE WHEN a",
+			unexpected: true,
+		},
+		{
+			name:       "incomplete insert values",
+			in:         "INSERT INTO t VALUES",
+			unexpected: true,
+		},
+		{
+			name:       "incomplete join",
+			in:         "SELECT * FROM a JOIN b",
+			unexpected: true,
+		},
+		{
+			name:       "incomplete alter column",
+			in:         "ALTER TABLE t ALTER COLUMN a",
+			unexpected: true,
+		},
+		{
+			name:       "incomplete column definition",
+			in:         "CREATE TABLE t (a",
+			unexpected: true,
+		},
+		{
+			name:       "incomplete fetch",
+			in:         "SELECT * FROM t FETCH FIRST 1",
+			unexpected: true,
+		},
+		{
+			name:       "incomplete at time zone",
+			in:         "SELECT a AT",
+			unexpected: true,
+		},
+		{
+			name:       "incomplete drop",
+			in:         "DROP",
+			unexpected: true,
+		},
+		{
+			name:       "incomplete drop materialized view",
+			in:         "DROP MATERIALIZED",
+			unexpected: true,
+		},
+		{
+			name:       "unclosed array constructor",
+			in:         "SELECT ARRAY[1, 2",
+			unexpected: true,
+		},
+		{
+			name: "not a statement",
+			in:   "1 + 1",
+		},
+		{
+			name: "missing delimiter",
+			in:   "SELECT a FROM t u v",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = parser.ParseSQL()
+			if err == nil {
+				t.Fatal("must be error but nil")
+			}
+			if errors.Is(err, ErrUnexpectedEOF) != c.unexpected {
+				t.Errorf("errors.Is(err, ErrUnexpectedEOF) must be %v but err: %v", c.unexpected, err)
+			}
+		})
+	}
+}
+
+func TestParser_UnbalancedBrackets(t *testing.T) {
+	cases := []struct {
+		name       string
+		in         string
+		msg        string
+		unexpected bool
+	}{
+		{
+			name:       "unclosed parenthesis in subquery",
+			in:         "SELECT * FROM (SELECT a FROM t WHERE b IN (1, 2)",
+			msg:        "unclosed parenthesis opened at 1:15",
+			unexpected: true,
+		},
+		{
+			name: "unclosed bracket in array",
+			in:   "CREATE TABLE t (a int[3)",
+			msg:  "unclosed bracket opened at 1:22",
+		},
+		{
+			name: "unclosed parenthesis before semicolon",
+			in:   "SELECT (a + 1 FROM t;\nSELECT 1",
+			msg:  "unclosed parenthesis opened at 1:8",
+		},
+		{
+			name: "unmatched closing parenthesis",
+			in:   "SELECT a) FROM t",
+			msg:  "unmatched closing parenthesis at 1:9",
+		},
+		{
+			name:       "in second statement",
+			in:         "SELECT 1;\nSELECT * FROM (SELECT 1",
+			msg:        "unclosed parenthesis opened at 2:15",
+			unexpected: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = parser.ParseSQL()
+			if err == nil {
+				t.Fatal("must be error but nil")
+			}
+			if !strings.Contains(err.Error(), c.msg) {
+				t.Errorf("error must contain %q but %v", c.msg, err)
+			}
+			if errors.Is(err, ErrUnexpectedEOF) != c.unexpected {
+				t.Errorf("errors.Is(err, ErrUnexpectedEOF) must be %v but err: %v", c.unexpected, err)
+			}
+		})
+	}
+}
+
+func TestParser_ParseSQL(t *testing.T) {
+	in := `
+create table account (
+    account_id serial primary key,
+    name varchar(255) not null,
+    email varchar(255) unique not null,
+    age smallint not null,
+    registered_at timestamp with time zone default current_timestamp
+);
+
+create table category (
+    category_id serial primary key,
+    name varchar(255) not null
+);
+
+create table item (
+    item_id serial primary key,
+    price int not null,
+    name varchar(255) not null,
+    category_id int references category(category_id),
+    created_at timestamp with time zone default current_timestamp
+);
+`
+	parser, err := NewParser(bytes.NewBufferString(in), &dialect.GenericSQLDialect{})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	stmts, err := parser.ParseSQL()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	if len(stmts) != 3 {
+		t.Fatal("must be 3 stmts")
+	}
+
+	t.Run("without the last semicolon", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString("SELECT 1; SELECT 2"), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		stmts, err := parser.ParseSQL()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if len(stmts) != 2 {
+			t.Fatalf("must be 2 stmts but %d", len(stmts))
+		}
+	})
+}
+
+func TestParser_ParseFile(t *testing.T) {
+
+	cases := []struct {
+		name string
+		skip bool
+		in   string
+		out  []*sqlast.CommentGroup
+	}{
+		{
+			name: "single line",
+			in: `--comment
+select 1 from test;`,
+			out: []*sqlast.CommentGroup{
+				{
+					List: []*sqlast.Comment{
+						{
+							Text: "comment",
+							From: sqltoken.NewPos(1, 1),
+							To:   sqltoken.NewPos(1, 10),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "multi line",
+			in: `
+create table account (
+    account_id serial primary key,  --aaa
+	/*bbb*/
+    name varchar(255) not null,
+    email /*ccc*/ varchar(255) unique not null --ddd
+);
+
+--eee
+
+/*fff
+ggg
+*/
+select 1 from test; --hhh
+/*jjj*/ --kkk
+select 1 from test; /*lll*/ --mmm
+--nnn
+`,
+			out: []*sqlast.CommentGroup{
+				{
+					List: []*sqlast.Comment{
+						{
+							Text: "aaa",
+							From: sqltoken.NewPos(3, 37),
+							To:   sqltoken.NewPos(3, 42),
+						},
+					},
+				},
+				{
+					List: []*sqlast.Comment{
+						{
+							Text: "bbb",
+							From: sqltoken.NewPos(4, 5),
+							To:   sqltoken.NewPos(4, 12),
+						},
+					},
+				},
+				{
+					List: []*sqlast.Comment{
+						{
+							Text: "ccc",
+							From: sqltoken.NewPos(6, 11),
+							To:   sqltoken.NewPos(6, 18),
+						},
+					},
+				},
+				{
+					List: []*sqlast.Comment{
+						{
+							Text: "ddd",
+							From: sqltoken.NewPos(6, 48),
+							To:   sqltoken.NewPos(6, 53),
+						},
+					},
+				},
+				{
+					List: []*sqlast.Comment{
+						{
+							Text: "eee",
+							From: sqltoken.NewPos(9, 1),
+							To:   sqltoken.NewPos(9, 6),
+						},
+						{
+							Text: "fff\nggg\n",
+							From: sqltoken.NewPos(11, 1),
+							To:   sqltoken.NewPos(13, 3),
+						},
+					},
+				},
+				{
+					List: []*sqlast.Comment{
+						{
+							Text: "hhh",
+							From: sqltoken.NewPos(14, 21),
+							To:   sqltoken.NewPos(14, 26),
+						},
+					},
+				},
+				{
+					List: []*sqlast.Comment{
+						{
+							Text: "jjj",
+							From: sqltoken.NewPos(15, 1),
+							To:   sqltoken.NewPos(15, 8),
+						},
+						{
+							Text: "kkk",
+							From: sqltoken.NewPos(15, 9),
+							To:   sqltoken.NewPos(15, 14),
+						},
+					},
+				},
+				{
+					List: []*sqlast.Comment{
+						{
+							Text: "lll",
+							From: sqltoken.NewPos(16, 21),
+							To:   sqltoken.NewPos(16, 28),
+						},
+						{
+							Text: "mmm",
+							From: sqltoken.NewPos(16, 29),
+							To:   sqltoken.NewPos(16, 34),
+						},
+					},
+				},
+				{
+					List: []*sqlast.Comment{
+						{
+							Text: "nnn",
+							From: sqltoken.NewPos(17, 1),
+							To:   sqltoken.NewPos(17, 6),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "without the last semicolon",
+			in: `select 1 from test; --aaa
+select a from test order by a --bbb
+/*ccc*/`,
+			out: []*sqlast.CommentGroup{
+				{
+					List: []*sqlast.Comment{
+						{
+							Text: "aaa",
+							From: sqltoken.NewPos(1, 21),
+							To:   sqltoken.NewPos(1, 26),
+						},
+					},
+				},
+				{
+					List: []*sqlast.Comment{
+						{
+							Text: "bbb",
+							From: sqltoken.NewPos(2, 31),
+							To:   sqltoken.NewPos(2, 36),
+						},
+					},
+				},
+				{
+					List: []*sqlast.Comment{
+						{
+							Text: "ccc",
+							From: sqltoken.NewPos(3, 1),
+							To:   sqltoken.NewPos(3, 8),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.skip {
+				t.Skip()
+			}
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{}, ParseComment)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := parser.ParseFile()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if diff := cmp.Diff(c.out, f.Comments); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+
+		})
+	}
+
+}
+
+func TestParseType(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  string
+	}{
+		{
+			name: "int",
+			in:   "int",
+			out:  "int",
+		},
+		{
+			name: "numeric with precision and scale",
+			in:   "numeric(10,2)",
+			out:  "numeric(10,2)",
+		},
+		{
+			name: "numeric array",
+			in:   "numeric(10,2)[]",
+			out:  "numeric(10,2)[]",
+		},
+		{
+			name: "multi dimensional array",
+			in:   "text[][]",
+			out:  "text[][]",
+		},
+		{
+			name: "sized array",
+			in:   "int[3]",
+			out:  "int[3]",
+		},
+		{
+			name: "multi dimensional sized array",
+			in:   "int[2][ 3 ][]",
+			out:  "int[2][3][]",
+		},
+		{
+			name: "timestamp with time zone",
+			in:   "timestamp with time zone",
+			out:  "timestamp with time zone",
+		},
+		{
+			name: "character varying",
+			in:   "character varying(255)",
+			out:  "character varying(255)",
+		},
+		{
+			name: "double precision",
+			in:   "double precision",
+			out:  "double precision",
+		},
+		{
+			name: "custom type",
+			in:   "public.my_type",
+			out:  "public.my_type",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tp, err := ParseType(c.in, &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if tp.ToSQLString() != c.out {
+				t.Errorf("should be %s but %s", c.out, tp.ToSQLString())
+			}
+		})
+	}
+
+	t.Run("trailing tokens", func(t *testing.T) {
+		for _, in := range []string{"int int", "varchar(10) x", "numeric(10,2)[] ,", "int[a]", "int[3"} {
+			if _, err := ParseType(in, &dialect.GenericSQLDialect{}); err == nil {
+				t.Errorf("%s: must be error but blank", in)
+			}
+		}
+	})
+
+	t.Run("malformed types", func(t *testing.T) {
+		for _, in := range []string{"timestamp with foo", "time with", "double", "numeric(10", "numeric(10,2", "numeric(10 2)", "varchar(", "char(", "float("} {
+			if _, err := ParseType(in, &dialect.GenericSQLDialect{}); err == nil {
+				t.Errorf("%s: must be error but blank", in)
+			}
+		}
+	})
+}
+
+var update = flag.Bool("update", false, "update golden files")
+
+// TestParser_ExprGolden pins the trees of a battery of expressions, so that
+// changes of the expression parser can be checked not to change them.
+func TestParser_ExprGolden(t *testing.T) {
+	exprs := []string{
+		"1 + 2 * 3 - 4 / 5 % 6",
+		"a - b - c + d",
+		"a OR b AND NOT c OR d",
+		"NOT a = b AND c <> d",
+		"a = b IS NULL OR c IS NOT NULL",
+		"a < b AND b <= c AND c > d AND d >= e AND e != f",
+		"a LIKE 'x%' AND b NOT LIKE c || 'y'",
+		"a || b || c = d",
+		"a + 1 IN (1, 2, 3) AND b NOT IN (SELECT c FROM t)",
+		"a BETWEEN b + 1 AND c * 2 AND d NOT BETWEEN 1 AND 2",
+		"a | b & c ^ d << e >> f",
+		"-a * b + -(c - d) - ~e",
+		"a::int + b::text::varchar(10)",
+		"ts AT TIME ZONE 'UTC' = now() AT TIME ZONE tz",
+		"(a, b) OVERLAPS (c, d) AND e",
+		"a = ANY (SELECT b FROM t) OR c > ALL (SELECT d FROM u)",
+		"a = ANY (ARRAY[1, b + 2]) AND c <> ALL (ARRAY[])",
+		"a ~ 'x' AND b ~* 'y' OR c !~ 'z' AND d !~* 'w'",
+		"CASE WHEN a > 1 THEN b * 2 ELSE c END + 1",
+		"count(*) + max(b) OVER (PARTITION BY c ORDER BY d)",
+		"EXISTS (SELECT 1 FROM t WHERE t.a = b) AND NOT EXISTS (SELECT 1)",
+		"CAST(a AS int) * 2 - COALESCE(b, 0)",
+		"a.b.c = $1 AND d = ?",
+		"LEFT(s, 3) = RIGHT(s, 2) AND ANY_VALUE(c) IS NOT NULL",
+	}
+	dialects := []struct {
+		name    string
+		dialect dialect.Dialect
+	}{
+		{name: "generic", dialect: &dialect.GenericSQLDialect{}},
+		{name: "postgresql", dialect: &dialect.PostgresqlDialect{}},
+		{name: "mysql", dialect: &dialect.MySQLDialect{}},
+	}
+
+	var buf bytes.Buffer
+	for _, d := range dialects {
+		for _, in := range exprs {
+			fmt.Fprintf(&buf, "-- %s: %s\n", d.name, in)
+			expr, err := ParseExpr(in, d.dialect)
+			if err != nil {
+				fmt.Fprintf(&buf, "error\n")
+				continue
+			}
+			if err := sqlast.Fdump(&buf, expr); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	golden := "testdata/expr.golden"
+	if *update {
+		if err := ioutil.WriteFile(golden, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expect, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(string(expect), buf.String()); diff != "" {
+		t.Errorf("diff %s", diff)
+	}
+}
+
+func TestParseExpr(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  string
+	}{
+		{
+			name: "and with in list",
+			in:   "a = 1 AND b IN (1,2)",
+			out:  "a = 1 AND b IN (1, 2)",
+		},
+		{
+			name: "in subquery",
+			in:   "owner_id IN (SELECT id FROM users WHERE active = true)",
+			out:  "owner_id IN (SELECT id FROM users WHERE active = TRUE)",
+		},
+		{
+			name: "exists",
+			in:   "EXISTS (SELECT 1 FROM members AS m WHERE m.user_id = current_user_id)",
+			out:  "EXISTS (SELECT 1 FROM members AS m WHERE m.user_id = current_user_id)",
+		},
+		{
+			name: "trim",
+			in:   "TRIM(BOTH 'x' FROM col)",
+			out:  "TRIM(BOTH 'x' FROM col)",
+		},
+		{
+			name: "trim without spec",
+			in:   "trim(' ' from name) || trim(name)",
+			out:  "TRIM(' ' FROM name) || TRIM(name)",
+		},
+		{
+			name: "trim only expr",
+			in:   "TRIM(s)",
+			out:  "TRIM(s)",
+		},
+		{
+			name: "trim spec without chars",
+			in:   "TRIM(LEADING FROM s)",
+			out:  "TRIM(LEADING FROM s)",
+		},
+		{
+			name: "trim trailing chars",
+			in:   "trim(trailing 'x' from s)",
+			out:  "TRIM(TRAILING 'x' FROM s)",
+		},
+		{
+			name: "trim only from",
+			in:   "TRIM(FROM s)",
+			out:  "TRIM(FROM s)",
+		},
+		{
+			name: "substring",
+			in:   "SUBSTRING(s FROM 1 FOR 3)",
+			out:  "SUBSTRING(s FROM 1 FOR 3)",
+		},
+		{
+			name: "substring with comma",
+			in:   "substring(s, 1, 3)",
+			out:  "substring(s, 1, 3)",
+		},
+		{
+			name: "extract",
+			in:   "EXTRACT(YEAR FROM ts) = 2020",
+			out:  "EXTRACT(YEAR FROM ts) = 2020",
+		},
+		{
+			name: "position",
+			in:   "POSITION('b' IN s) > 0",
+			out:  "POSITION('b' IN s) > 0",
+		},
+		{
+			name: "special function names as identifiers",
+			in:   "position + year",
+			out:  "position + year",
+		},
+		{
+			name: "keywords as function names",
+			in:   "LEFT(s, 3) = right(s, 2)",
+			out:  "LEFT(s, 3) = right(s, 2)",
+		},
+		{
+			name: "any_value",
+			in:   "ANY_VALUE(name) || GROUP_CONCAT(DISTINCT tag)",
+			out:  "ANY_VALUE(name) || GROUP_CONCAT(DISTINCT tag)",
+		},
+		{
+			name: "named placeholders",
+			in:   "a = :a AND b IN (:b1, :b2)",
+			out:  "a = :a AND b IN (:b1, :b2)",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			expr, err := ParseExpr(c.in, &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if expr.ToSQLString() != c.out {
+				t.Errorf("should be %s but %s", c.out, expr.ToSQLString())
+			}
+		})
+	}
+
+	t.Run("trailing tokens", func(t *testing.T) {
+		for _, in := range []string{"a = 1 b", "a = 1)", "a = 1;"} {
+			if _, err := ParseExpr(in, &dialect.GenericSQLDialect{}); err == nil {
+				t.Errorf("%s: must be error but blank", in)
+			}
+		}
+	})
+
+	t.Run("malformed expressions", func(t *testing.T) {
+		for _, in := range []string{
+			"a NOT b", "a NOT", "a BETWEEN 1", "a IN", "a IN 1", "count(x", "count(*) OVER (ROWS 1)",
+			"CASE WHEN a", "CASE a", "CAST(a", "CAST(a int)", "EXISTS", "a = ,",
+		} {
+			if _, err := ParseExpr(in, &dialect.GenericSQLDialect{}); err == nil {
+				t.Errorf("%s: must be error but blank", in)
+			}
+		}
+	})
+
+	t.Run("nil dialect", func(t *testing.T) {
+		expr, err := ParseExpr("a || b", nil)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if expr.ToSQLString() != "a || b" {
+			t.Errorf("should be a || b but %s", expr.ToSQLString())
+		}
+	})
+
+	t.Run("overlaps without pairs", func(t *testing.T) {
+		for _, in := range []string{"a OVERLAPS (b, c)", "(a, b) OVERLAPS c", "(a, b, c) OVERLAPS (d, e)"} {
+			if _, err := ParseExpr(in, &dialect.GenericSQLDialect{}); err == nil {
+				t.Errorf("%s: must be error but blank", in)
+			}
+		}
+	})
+
+	t.Run("positional after named argument", func(t *testing.T) {
+		if _, err := ParseExpr("f(a => 1, 2)", &dialect.GenericSQLDialect{}); err == nil {
+			t.Error("must be error but blank")
+		}
+	})
+
+	t.Run("colon without name", func(t *testing.T) {
+		for _, in := range []string{"a = : b", "a = :1"} {
+			if _, err := ParseExpr(in, &dialect.GenericSQLDialect{}); err == nil {
+				t.Errorf("%s: must be error but blank", in)
+			}
+		}
+	})
+}
+
+func TestParser_ParseCopy(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  string
+	}{
+		{
+			name: "from stdin with options",
+			in:   "COPY users (id, name) FROM STDIN WITH (FORMAT csv, HEADER true)",
+			out:  "COPY users (id, name) FROM STDIN WITH (FORMAT csv, HEADER TRUE)",
+		},
+		{
+			name: "to file without WITH",
+			in:   "COPY public.users TO '/tmp/users.csv' (FORMAT csv, DELIMITER ';', FREEZE)",
+			out:  "COPY public.users TO '/tmp/users.csv' WITH (FORMAT csv, DELIMITER ';', FREEZE)",
+		},
+		{
+			name: "query to stdout",
+			in:   "COPY (SELECT id FROM users WHERE active = true) TO STDOUT",
+			out:  "COPY (SELECT id FROM users WHERE active = TRUE) TO STDOUT",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if stmt.ToSQLString() != c.out {
+				t.Errorf("should be %s but %s", c.out, stmt.ToSQLString())
+			}
+		})
+	}
+
+	t.Run("inline data is left to the caller", func(t *testing.T) {
+		in := "COPY users (id, name) FROM STDIN;\n1\talice\n"
+		parser, err := NewParser(bytes.NewBufferString(in), &dialect.PostgresqlDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		stmt, err := parser.ParseStatement()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if tok, _ := parser.peekToken(); tok == nil || tok.Kind != sqltoken.Semicolon {
+			t.Errorf("must stop at the semicolon but %+v", tok)
+		}
+		if stmt.End() != sqltoken.NewPos(1, 33) {
+			t.Errorf("unexpected end %+v", stmt.End())
+		}
+	})
+
+	t.Run("generic dialect", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString("COPY users FROM STDIN"), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseStatement(); err == nil {
+			t.Error("must be error but blank")
+		}
+	})
+}
+
+func TestParser_TableInheritance(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		pos  sqltoken.Pos
+		end  sqltoken.Pos
+	}{
+		{
+			name: "only",
+			in:   "SELECT * FROM ONLY parent_table",
+			pos:  sqltoken.NewPos(1, 15),
+			end:  sqltoken.NewPos(1, 32),
+		},
+		{
+			name: "descendants",
+			in:   "SELECT * FROM parent_table * AS p WHERE p.id = 1",
+			pos:  sqltoken.NewPos(1, 15),
+			end:  sqltoken.NewPos(1, 34),
+		},
+		{
+			name: "only with join",
+			in:   "SELECT * FROM ONLY a INNER JOIN b * ON a.id = b.id",
+			pos:  sqltoken.NewPos(1, 15),
+			end:  sqltoken.NewPos(1, 21),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if stmt.ToSQLString() != c.in {
+				t.Errorf("should be %s but %s", c.in, stmt.ToSQLString())
+			}
+
+			var table *sqlast.Table
+			sqlast.Inspect(stmt, func(node sqlast.Node) bool {
+				if t, ok := node.(*sqlast.Table); ok && table == nil {
+					table = t
+				}
+				return true
+			})
+			if table.Pos() != c.pos || table.End() != c.end {
+				t.Errorf("expected %v-%v but %v-%v", c.pos, c.end, table.Pos(), table.End())
+			}
+		})
+	}
+}
+
+func TestParser_ParseDrop(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  *sqlast.DropStmt
+	}{
+		{
+			name: "tables",
+			in:   "DROP TABLE IF EXISTS a, b CASCADE",
+			out: &sqlast.DropStmt{
+				Drop:     sqltoken.NewPos(1, 1),
+				Kind:     sqlast.DropTable,
+				IfExists: true,
+				Names: []*sqlast.ObjectName{
+					{Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 22), sqltoken.NewPos(1, 23))}},
+					{Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 25), sqltoken.NewPos(1, 26))}},
+				},
+				Behavior:    sqlast.DropCascade,
+				BehaviorPos: sqltoken.NewPos(1, 34),
+			},
+		},
+		{
+			name: "materialized view",
+			in:   "DROP MATERIALIZED VIEW s.v RESTRICT",
+			out: &sqlast.DropStmt{
+				Drop: sqltoken.NewPos(1, 1),
+				Kind: sqlast.DropMaterializedView,
+				Names: []*sqlast.ObjectName{
+					{Idents: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("s", sqltoken.NewPos(1, 24), sqltoken.NewPos(1, 25)),
+						sqlast.NewIdentWithPos("v", sqltoken.NewPos(1, 26), sqltoken.NewPos(1, 27)),
+					}},
+				},
+				Behavior:    sqlast.DropRestrict,
+				BehaviorPos: sqltoken.NewPos(1, 36),
+			},
+		},
+		{
+			name: "index",
+			in:   "DROP INDEX i",
+			out: &sqlast.DropStmt{
+				Drop: sqltoken.NewPos(1, 1),
+				Kind: sqlast.DropIndex,
+				Names: []*sqlast.ObjectName{
+					{Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("i", sqltoken.NewPos(1, 12), sqltoken.NewPos(1, 13))}},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if diff := CompareWithoutMarker(c.out, stmt); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+			if stmt.ToSQLString() != c.in {
+				t.Errorf("should be %s but %s", c.in, stmt.ToSQLString())
+			}
+		})
+	}
+
+	t.Run("unknown object", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString("DROP FUNCTION f"), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseStatement(); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
+
+func TestParser_ParseCreateSequence(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  string
+		ast  sqlast.Stmt
+	}{
+		{
+			name: "options",
+			in:   "CREATE SEQUENCE s INCREMENT 2 START WITH 10 NO MAXVALUE CYCLE",
+			out:  "CREATE SEQUENCE s INCREMENT BY 2 START WITH 10 NO MAXVALUE CYCLE",
+			ast: &sqlast.CreateSequenceStmt{
+				Create: sqltoken.NewPos(1, 1),
+				Name: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("s", sqltoken.NewPos(1, 17), sqltoken.NewPos(1, 18))},
+				},
+				Options: []*sqlast.SequenceOption{
+					{
+						Kind:  sqlast.SequenceIncrement,
+						Value: &sqlast.LongValue{From: sqltoken.NewPos(1, 29), To: sqltoken.NewPos(1, 30), Long: 2},
+						From:  sqltoken.NewPos(1, 19),
+						To:    sqltoken.NewPos(1, 30),
+					},
+					{
+						Kind:  sqlast.SequenceStart,
+						Value: &sqlast.LongValue{From: sqltoken.NewPos(1, 42), To: sqltoken.NewPos(1, 44), Long: 10},
+						From:  sqltoken.NewPos(1, 31),
+						To:    sqltoken.NewPos(1, 44),
+					},
+					{
+						Kind: sqlast.SequenceMaxValue,
+						From: sqltoken.NewPos(1, 45),
+						To:   sqltoken.NewPos(1, 56),
+					},
+					{
+						Kind: sqlast.SequenceCycle,
+						From: sqltoken.NewPos(1, 57),
+						To:   sqltoken.NewPos(1, 62),
+					},
+				},
+			},
+		},
+		{
+			name: "all options",
+			in:   "CREATE SEQUENCE IF NOT EXISTS public.s START WITH 1 INCREMENT BY -1 MINVALUE -100 MAXVALUE 0 CACHE 10 NO CYCLE OWNED BY t.id",
+			out:  "CREATE SEQUENCE IF NOT EXISTS public.s START WITH 1 INCREMENT BY - 1 MINVALUE - 100 MAXVALUE 0 CACHE 10 NO CYCLE OWNED BY t.id",
+		},
+		{
+			name: "owned by none",
+			in:   "CREATE SEQUENCE s NO MINVALUE OWNED BY NONE",
+			out:  "CREATE SEQUENCE s NO MINVALUE OWNED BY NONE",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if c.ast != nil {
+				if diff := CompareWithoutMarker(c.ast, stmt); diff != "" {
+					t.Errorf("diff %s", diff)
+				}
+			}
+			if stmt.ToSQLString() != c.out {
+				t.Errorf("should be %s but %s", c.out, stmt.ToSQLString())
+			}
+		})
+	}
+
+	t.Run("owned without by", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString("CREATE SEQUENCE s OWNED t.c"), &dialect.PostgresqlDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseStatement(); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
+
+func TestParser_AlterTablePartition(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  sqlast.Stmt
+	}{
+		{
+			name: "range partition",
+			in:   "ALTER TABLE m ATTACH PARTITION p FOR VALUES FROM (1) TO (10)",
+			out: &sqlast.AlterTableStmt{
+				Alter: sqltoken.NewPos(1, 1),
+				TableName: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("m", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 14))},
+				},
+				Action: &sqlast.PGAttachPartitionTableAction{
+					Attach: sqltoken.NewPos(1, 15),
+					Name: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("p", sqltoken.NewPos(1, 32), sqltoken.NewPos(1, 33))},
+					},
+					Bound: &sqlast.PartitionBound{
+						From:  sqltoken.NewPos(1, 34),
+						To:    sqltoken.NewPos(1, 61),
+						Lower: []sqlast.Node{&sqlast.LongValue{From: sqltoken.NewPos(1, 51), To: sqltoken.NewPos(1, 52), Long: 1}},
+						Upper: []sqlast.Node{&sqlast.LongValue{From: sqltoken.NewPos(1, 58), To: sqltoken.NewPos(1, 60), Long: 10}},
+					},
+				},
+			},
+		},
+		{
+			name: "list partition",
+			in:   "ALTER TABLE m ATTACH PARTITION p FOR VALUES IN ('a', 'b')",
+			out: &sqlast.AlterTableStmt{
+				Alter: sqltoken.NewPos(1, 1),
+				TableName: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("m", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 14))},
+				},
+				Action: &sqlast.PGAttachPartitionTableAction{
+					Attach: sqltoken.NewPos(1, 15),
+					Name: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("p", sqltoken.NewPos(1, 32), sqltoken.NewPos(1, 33))},
+					},
+					Bound: &sqlast.PartitionBound{
+						From: sqltoken.NewPos(1, 34),
+						To:   sqltoken.NewPos(1, 58),
+						In: []sqlast.Node{
+							&sqlast.SingleQuotedString{From: sqltoken.NewPos(1, 49), To: sqltoken.NewPos(1, 52), String: "a"},
+							&sqlast.SingleQuotedString{From: sqltoken.NewPos(1, 54), To: sqltoken.NewPos(1, 57), String: "b"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "default partition",
+			in:   "ALTER TABLE m ATTACH PARTITION p DEFAULT",
+			out: &sqlast.AlterTableStmt{
+				Alter: sqltoken.NewPos(1, 1),
+				TableName: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("m", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 14))},
+				},
+				Action: &sqlast.PGAttachPartitionTableAction{
+					Attach: sqltoken.NewPos(1, 15),
+					Name: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("p", sqltoken.NewPos(1, 32), sqltoken.NewPos(1, 33))},
+					},
+					Bound: &sqlast.PartitionBound{
+						From:    sqltoken.NewPos(1, 34),
+						To:      sqltoken.NewPos(1, 41),
+						Default: true,
+					},
+				},
+			},
+		},
+		{
+			name: "detach partition",
+			in:   "ALTER TABLE m DETACH PARTITION p",
+			out: &sqlast.AlterTableStmt{
+				Alter: sqltoken.NewPos(1, 1),
+				TableName: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("m", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 14))},
+				},
+				Action: &sqlast.PGDetachPartitionTableAction{
+					Detach: sqltoken.NewPos(1, 15),
+					Name: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("p", sqltoken.NewPos(1, 32), sqltoken.NewPos(1, 33))},
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if diff := CompareWithoutMarker(c.out, stmt); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+			if stmt.ToSQLString() != c.in {
+				t.Errorf("should be %s but %s", c.in, stmt.ToSQLString())
+			}
+		})
+	}
+
+	t.Run("generic dialect", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString("ALTER TABLE m DETACH PARTITION p"), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseStatement(); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
+
+func TestParser_Options(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  string
+		opts []ParserOption
+	}{
+		{
+			name: "trailing comma in select list",
+			in:   "SELECT a, b, FROM t",
+			out:  "SELECT a, b FROM t",
+			opts: []ParserOption{AllowTrailingComma(true)},
+		},
+		{
+			name: "trailing comma at the end of statement",
+			in:   "SELECT a, b,",
+			out:  "SELECT a, b",
+			opts: []ParserOption{AllowTrailingComma(true)},
+		},
+		{
+			name: "trailing comma in expression list",
+			in:   "SELECT a FROM t WHERE a IN (1, 2,)",
+			out:  "SELECT a FROM t WHERE a IN (1, 2)",
+			opts: []ParserOption{AllowTrailingComma(true)},
+		},
+		{
+			name: "double quote strings",
+			in:   `SELECT "a" FROM t WHERE b = "x"`,
+			out:  "SELECT 'a' FROM t WHERE b = 'x'",
+			opts: []ParserOption{AllowDoubleQuoteStrings(true)},
+		},
+		{
+			name: "double quote identifiers by default",
+			in:   `SELECT "a" FROM t WHERE b = "x"`,
+			out:  `SELECT "a" FROM t WHERE b = "x"`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{}, c.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if stmt.ToSQLString() != c.out {
+				t.Errorf("should be %s but %s", c.out, stmt.ToSQLString())
+			}
+		})
+	}
+}
+
+func TestParser_ParseSessionStatements(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  sqlast.Stmt
+	}{
+		{
+			name: "set with =",
+			in:   "SET search_path = public, x",
+			out: &sqlast.SetStmt{
+				Set: sqltoken.NewPos(1, 1),
+				Name: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("search_path", sqltoken.NewPos(1, 5), sqltoken.NewPos(1, 16))},
+				},
+				Eq: true,
+				Values: []sqlast.Node{
+					sqlast.NewIdentWithPos("public", sqltoken.NewPos(1, 19), sqltoken.NewPos(1, 25)),
+					sqlast.NewIdentWithPos("x", sqltoken.NewPos(1, 27), sqltoken.NewPos(1, 28)),
+				},
+			},
+		},
+		{
+			name: "set to default",
+			in:   "SET work_mem TO DEFAULT",
+			out: &sqlast.SetStmt{
+				Set: sqltoken.NewPos(1, 1),
+				Name: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("work_mem", sqltoken.NewPos(1, 5), sqltoken.NewPos(1, 13))},
+				},
+				Default:    true,
+				DefaultPos: sqltoken.NewPos(1, 24),
+			},
+		},
+		{
+			name: "set time zone",
+			in:   "SET TIME ZONE 'UTC'",
+			out: &sqlast.SetStmt{
+				Set:      sqltoken.NewPos(1, 1),
+				TimeZone: true,
+				Values: []sqlast.Node{
+					&sqlast.SingleQuotedString{From: sqltoken.NewPos(1, 15), To: sqltoken.NewPos(1, 20), String: "UTC"},
+				},
+			},
+		},
+		{
+			name: "show all",
+			in:   "SHOW all",
+			out: &sqlast.ShowStmt{
+				Show: sqltoken.NewPos(1, 1),
+				Name: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("all", sqltoken.NewPos(1, 6), sqltoken.NewPos(1, 9))},
+				},
+			},
+		},
+		{
+			name: "reset",
+			in:   "RESET search_path",
+			out: &sqlast.ResetStmt{
+				Reset: sqltoken.NewPos(1, 1),
+				Name: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("search_path", sqltoken.NewPos(1, 7), sqltoken.NewPos(1, 18))},
+				},
+			},
+		},
+		{
+			name: "reset time zone",
+			in:   "RESET TIME ZONE",
+			out: &sqlast.ResetStmt{
+				Reset:    sqltoken.NewPos(1, 1),
+				TimeZone: true,
+				ZonePos:  sqltoken.NewPos(1, 16),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if diff := CompareWithoutMarker(c.out, stmt); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+			if stmt.ToSQLString() != c.in {
+				t.Errorf("should be %s but %s", c.in, stmt.ToSQLString())
+			}
+		})
+	}
+
+	t.Run("reset in generic dialect", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString("RESET search_path"), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseStatement(); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
+
+func TestParser_ParseDo(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  sqlast.Stmt
+	}{
+		{
+			name: "language after body",
+			in:   "DO $$ BEGIN RAISE NOTICE 'hi'; END $$ LANGUAGE plpgsql",
+			out: &sqlast.DoStmt{
+				Do: sqltoken.NewPos(1, 1),
+				Body: &sqlast.DollarQuotedString{
+					From:   sqltoken.NewPos(1, 4),
+					To:     sqltoken.NewPos(1, 38),
+					String: " BEGIN RAISE NOTICE 'hi'; END ",
+				},
+				Language: sqlast.NewIdentWithPos("plpgsql", sqltoken.NewPos(1, 48), sqltoken.NewPos(1, 55)),
+			},
+		},
+		{
+			name: "language before tagged body",
+			in:   "DO LANGUAGE plpgsql $body$\nBEGIN\n  PERFORM 1;\nEND\n$body$",
+			out: &sqlast.DoStmt{
+				Do: sqltoken.NewPos(1, 1),
+				Body: &sqlast.DollarQuotedString{
+					From:   sqltoken.NewPos(1, 21),
+					To:     sqltoken.NewPos(5, 7),
+					Tag:    "body",
+					String: "\nBEGIN\n  PERFORM 1;\nEND\n",
+				},
+				Language:      sqlast.NewIdentWithPos("plpgsql", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 20)),
+				LanguageFirst: true,
+			},
+		},
+		{
+			name: "without language",
+			in:   "DO $$SELECT 1$$",
+			out: &sqlast.DoStmt{
+				Do: sqltoken.NewPos(1, 1),
+				Body: &sqlast.DollarQuotedString{
+					From:   sqltoken.NewPos(1, 4),
+					To:     sqltoken.NewPos(1, 16),
+					String: "SELECT 1",
+				},
+			},
+		},
+		{
+			name: "single-quoted body",
+			in:   "DO 'BEGIN NULL; END'",
+			out: &sqlast.DoStmt{
+				Do: sqltoken.NewPos(1, 1),
+				Body: &sqlast.SingleQuotedString{
+					From:   sqltoken.NewPos(1, 4),
+					To:     sqltoken.NewPos(1, 21),
+					String: "BEGIN NULL; END",
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if diff := CompareWithoutMarker(c.out, stmt); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+			if stmt.ToSQLString() != c.in {
+				t.Errorf("should be %s but %s", c.in, stmt.ToSQLString())
+			}
+		})
+	}
+
+	for _, c := range []struct {
+		name    string
+		in      string
+		dialect dialect.Dialect
+	}{
+		{name: "generic dialect", in: "DO $$SELECT 1$$", dialect: &dialect.GenericSQLDialect{}},
+		{name: "without body", in: "DO LANGUAGE plpgsql", dialect: &dialect.PostgresqlDialect{}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), c.dialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := parser.ParseStatement(); err == nil {
+				t.Error("should be error")
+			}
+		})
+	}
+}
+
+func TestParser_ParseTransactionStatements(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  sqlast.Stmt
+		sql  string // normalized in if it is not blank
+	}{
+		{
+			name: "begin",
+			in:   "BEGIN",
+			out: &sqlast.BeginStmt{
+				Begin: sqltoken.NewPos(1, 1),
+				To:    sqltoken.NewPos(1, 6),
+			},
+		},
+		{
+			name: "begin work",
+			in:   "BEGIN WORK",
+			out: &sqlast.BeginStmt{
+				Begin: sqltoken.NewPos(1, 1),
+				Word:  sqlast.TransactionWordWork,
+				To:    sqltoken.NewPos(1, 11),
+			},
+		},
+		{
+			name: "begin with isolation level",
+			in:   "BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE, READ ONLY",
+			out: &sqlast.BeginStmt{
+				Begin: sqltoken.NewPos(1, 1),
+				Word:  sqlast.TransactionWordTransaction,
+				To:    sqltoken.NewPos(1, 18),
+				Modes: []*sqlast.TransactionMode{
+					{Kind: sqlast.IsolationLevelMode, Level: sqlast.Serializable, From: sqltoken.NewPos(1, 19), To: sqltoken.NewPos(1, 47)},
+					{Kind: sqlast.ReadOnlyMode, From: sqltoken.NewPos(1, 49), To: sqltoken.NewPos(1, 58)},
+				},
+			},
+		},
+		{
+			name: "start transaction",
+			in:   "START TRANSACTION READ WRITE, ISOLATION LEVEL READ COMMITTED",
+			out: &sqlast.BeginStmt{
+				Begin: sqltoken.NewPos(1, 1),
+				Start: true,
+				To:    sqltoken.NewPos(1, 18),
+				Modes: []*sqlast.TransactionMode{
+					{Kind: sqlast.ReadWriteMode, From: sqltoken.NewPos(1, 19), To: sqltoken.NewPos(1, 29)},
+					{Kind: sqlast.IsolationLevelMode, Level: sqlast.ReadCommitted, From: sqltoken.NewPos(1, 31), To: sqltoken.NewPos(1, 61)},
+				},
+			},
+		},
+		{
+			name: "modes without comma",
+			in:   "BEGIN ISOLATION LEVEL REPEATABLE READ NOT DEFERRABLE",
+			out: &sqlast.BeginStmt{
+				Begin: sqltoken.NewPos(1, 1),
+				To:    sqltoken.NewPos(1, 6),
+				Modes: []*sqlast.TransactionMode{
+					{Kind: sqlast.IsolationLevelMode, Level: sqlast.RepeatableRead, From: sqltoken.NewPos(1, 7), To: sqltoken.NewPos(1, 38)},
+					{Kind: sqlast.NotDeferrableMode, From: sqltoken.NewPos(1, 39), To: sqltoken.NewPos(1, 53)},
+				},
+			},
+			sql: "BEGIN ISOLATION LEVEL REPEATABLE READ, NOT DEFERRABLE",
+		},
+		{
+			name: "commit",
+			in:   "COMMIT",
+			out: &sqlast.CommitStmt{
+				Commit: sqltoken.NewPos(1, 1),
+				To:     sqltoken.NewPos(1, 7),
+			},
+		},
+		{
+			name: "commit transaction",
+			in:   "COMMIT TRANSACTION",
+			out: &sqlast.CommitStmt{
+				Commit: sqltoken.NewPos(1, 1),
+				Word:   sqlast.TransactionWordTransaction,
+				To:     sqltoken.NewPos(1, 19),
+			},
+		},
+		{
+			name: "rollback",
+			in:   "ROLLBACK",
+			out: &sqlast.RollbackStmt{
+				Rollback: sqltoken.NewPos(1, 1),
+				To:       sqltoken.NewPos(1, 9),
+			},
+		},
+		{
+			name: "rollback to savepoint",
+			in:   "ROLLBACK WORK TO SAVEPOINT s1",
+			out: &sqlast.RollbackStmt{
+				Rollback:         sqltoken.NewPos(1, 1),
+				Word:             sqlast.TransactionWordWork,
+				To:               sqltoken.NewPos(1, 14),
+				SavepointKeyword: true,
+				Savepoint:        sqlast.NewIdentWithPos("s1", sqltoken.NewPos(1, 28), sqltoken.NewPos(1, 30)),
+			},
+		},
+		{
+			name: "rollback to without savepoint keyword",
+			in:   "ROLLBACK TO s1",
+			out: &sqlast.RollbackStmt{
+				Rollback:  sqltoken.NewPos(1, 1),
+				To:        sqltoken.NewPos(1, 9),
+				Savepoint: sqlast.NewIdentWithPos("s1", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 15)),
+			},
+		},
+		{
+			name: "savepoint",
+			in:   "SAVEPOINT s1",
+			out: &sqlast.SavepointStmt{
+				Savepoint: sqltoken.NewPos(1, 1),
+				Name:      sqlast.NewIdentWithPos("s1", sqltoken.NewPos(1, 11), sqltoken.NewPos(1, 13)),
+			},
+		},
+		{
+			name: "release savepoint",
+			in:   "RELEASE SAVEPOINT s1",
+			out: &sqlast.ReleaseSavepointStmt{
+				Release:          sqltoken.NewPos(1, 1),
+				SavepointKeyword: true,
+				Name:             sqlast.NewIdentWithPos("s1", sqltoken.NewPos(1, 19), sqltoken.NewPos(1, 21)),
+			},
+		},
+		{
+			name: "release",
+			in:   "RELEASE s1",
+			out: &sqlast.ReleaseSavepointStmt{
+				Release: sqltoken.NewPos(1, 1),
+				Name:    sqlast.NewIdentWithPos("s1", sqltoken.NewPos(1, 9), sqltoken.NewPos(1, 11)),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if diff := CompareWithoutMarker(c.out, stmt); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+			sql := c.sql
+			if sql == "" {
+				sql = c.in
+			}
+			if stmt.ToSQLString() != sql {
+				t.Errorf("should be %s but %s", sql, stmt.ToSQLString())
+			}
+		})
+	}
+
+	for _, in := range []string{
+		"BEGIN ISOLATION LEVEL SOMETIMES",
+		"BEGIN READ ONLY,",
+		"START",
+		"ROLLBACK TO",
+	} {
+		t.Run(in, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := parser.ParseStatement(); err == nil {
+				t.Error("should be error")
+			}
+		})
+	}
+}
+
+func TestParser_ParseCursorStatements(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  sqlast.Stmt
+		sql  string
+	}{
+		{
+			name: "declare cursor",
+			in:   "DECLARE c BINARY INSENSITIVE NO SCROLL CURSOR WITH HOLD FOR SELECT * FROM t",
+			out: &sqlast.DeclareCursorStmt{
+				Declare:     sqltoken.NewPos(1, 1),
+				Name:        sqlast.NewIdentWithPos("c", sqltoken.NewPos(1, 9), sqltoken.NewPos(1, 10)),
+				Binary:      true,
+				Insensitive: true,
+				Scroll:      func() *bool { b := false; return &b }(),
+				Hold:        func() *bool { b := true; return &b }(),
+				Query: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.NewPos(1, 61),
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.Wildcard{Wildcard: sqltoken.NewPos(1, 68)},
+							},
+						},
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 75), sqltoken.NewPos(1, 76)),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "fetch next",
+			in:   "FETCH NEXT FROM c",
+			out: &sqlast.FetchStmt{
+				Fetch:     sqltoken.NewPos(1, 1),
+				Direction: sqlast.CursorNext,
+				Cursor:    sqlast.NewIdentWithPos("c", sqltoken.NewPos(1, 17), sqltoken.NewPos(1, 18)),
+			},
+		},
+		{
+			name: "fetch without direction",
+			in:   "FETCH c",
+			out: &sqlast.FetchStmt{
+				Fetch:  sqltoken.NewPos(1, 1),
+				Cursor: sqlast.NewIdentWithPos("c", sqltoken.NewPos(1, 7), sqltoken.NewPos(1, 8)),
+			},
+		},
+		{
+			name: "fetch absolute",
+			in:   "FETCH ABSOLUTE 3 IN c",
+			out: &sqlast.FetchStmt{
+				Fetch:     sqltoken.NewPos(1, 1),
+				Direction: sqlast.CursorAbsolute,
+				Count: &sqlast.LongValue{
+					From: sqltoken.NewPos(1, 16),
+					To:   sqltoken.NewPos(1, 17),
+					Long: 3,
+				},
+				Cursor: sqlast.NewIdentWithPos("c", sqltoken.NewPos(1, 21), sqltoken.NewPos(1, 22)),
+			},
+			sql: "FETCH ABSOLUTE 3 FROM c",
+		},
+		{
+			name: "fetch count",
+			in:   "FETCH 5 c",
+			out: &sqlast.FetchStmt{
+				Fetch:     sqltoken.NewPos(1, 1),
+				Direction: sqlast.CursorCount,
+				Count: &sqlast.LongValue{
+					From: sqltoken.NewPos(1, 7),
+					To:   sqltoken.NewPos(1, 8),
+					Long: 5,
+				},
+				Cursor: sqlast.NewIdentWithPos("c", sqltoken.NewPos(1, 9), sqltoken.NewPos(1, 10)),
+			},
+			sql: "FETCH 5 FROM c",
+		},
+		{
+			name: "move backward all",
+			in:   "MOVE BACKWARD ALL FROM c",
+			out: &sqlast.FetchStmt{
+				Fetch:     sqltoken.NewPos(1, 1),
+				Move:      true,
+				Direction: sqlast.CursorBackward,
+				All:       true,
+				Cursor:    sqlast.NewIdentWithPos("c", sqltoken.NewPos(1, 24), sqltoken.NewPos(1, 25)),
+			},
+		},
+		{
+			name: "close",
+			in:   "CLOSE c",
+			out: &sqlast.CloseStmt{
+				Close:  sqltoken.NewPos(1, 1),
+				Cursor: sqlast.NewIdentWithPos("c", sqltoken.NewPos(1, 7), sqltoken.NewPos(1, 8)),
+			},
+		},
+		{
+			name: "close all",
+			in:   "CLOSE ALL",
+			out: &sqlast.CloseStmt{
+				Close:  sqltoken.NewPos(1, 1),
+				All:    true,
+				AllPos: sqltoken.NewPos(1, 10),
+			},
+		},
+		{
+			name: "update where current of",
+			in:   "UPDATE t SET x = 1 WHERE CURRENT OF cur",
+			out: &sqlast.UpdateStmt{
+				Update: sqltoken.NewPos(1, 1),
+				TableName: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 8), sqltoken.NewPos(1, 9)),
+					},
+				},
+				Assignments: []*sqlast.Assignment{
+					{
+						ID: sqlast.NewIdentWithPos("x", sqltoken.NewPos(1, 14), sqltoken.NewPos(1, 15)),
+						Value: &sqlast.LongValue{
+							From: sqltoken.NewPos(1, 18),
+							To:   sqltoken.NewPos(1, 19),
+							Long: 1,
+						},
+					},
+				},
+				Selection: &sqlast.CurrentOf{
+					Current: sqltoken.NewPos(1, 26),
+					Cursor:  sqlast.NewIdentWithPos("cur", sqltoken.NewPos(1, 37), sqltoken.NewPos(1, 40)),
+				},
+			},
+		},
+		{
+			name: "delete where current of",
+			in:   "DELETE FROM t WHERE CURRENT OF cur",
+			out: &sqlast.DeleteStmt{
+				Delete: sqltoken.NewPos(1, 1),
+				TableName: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 14)),
+					},
+				},
+				Selection: &sqlast.CurrentOf{
+					Current: sqltoken.NewPos(1, 21),
+					Cursor:  sqlast.NewIdentWithPos("cur", sqltoken.NewPos(1, 32), sqltoken.NewPos(1, 35)),
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if diff := CompareWithoutMarker(c.out, stmt); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+			sql := c.sql
+			if sql == "" {
+				sql = c.in
+			}
+			if stmt.ToSQLString() != sql {
+				t.Errorf("should be %s but %s", sql, stmt.ToSQLString())
+			}
+		})
+	}
+
+	t.Run("declare in generic dialect", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString("DECLARE c CURSOR FOR SELECT 1"), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseStatement(); err == nil {
+			t.Error("should be error")
+		}
+	})
+
+	t.Run("incomplete fetch", func(t *testing.T) {
+		for _, in := range []string{"FETCH", "MOVE", "FETCH NEXT FROM"} {
+			parser, err := NewParser(bytes.NewBufferString(in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := parser.ParseStatement(); !errors.Is(err, ErrUnexpectedEOF) {
+				t.Errorf("%s: errors.Is(err, ErrUnexpectedEOF) must be true but err: %v", in, err)
+			}
+		}
+	})
+
+	t.Run("current of in generic dialect", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString("DELETE FROM t WHERE CURRENT OF cur"), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseSQL(); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
+
+func TestParser_MySQLLimitOffset(t *testing.T) {
+	parse := func(t *testing.T, in string, d dialect.Dialect) *sqlast.QueryStmt {
+		t.Helper()
+		parser, err := NewParser(bytes.NewBufferString(in), d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stmt, err := parser.ParseStatement()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		return stmt.(*sqlast.QueryStmt)
+	}
+
+	in := "SELECT a FROM t LIMIT 5, 10"
+	comma := parse(t, in, &dialect.MySQLDialect{})
+	if comma.ToSQLString() != in {
+		t.Errorf("should be %s but %s", in, comma.ToSQLString())
+	}
+	if diff := cmp.Diff(&sqlast.LongValue{From: sqltoken.NewPos(1, 26), To: sqltoken.NewPos(1, 28), Long: 10}, comma.Limit.LimitValue); diff != "" {
+		t.Errorf("diff %s", diff)
+	}
+	if comma.Limit.End() != sqltoken.NewPos(1, 28) {
+		t.Errorf("End must be {1 28} but %v", comma.Limit.End())
+	}
+
+	offset := parse(t, "SELECT a FROM t LIMIT 10 OFFSET 5", &dialect.MySQLDialect{})
+	if comma.Limit.LimitValue.Long != offset.Limit.LimitValue.Long || comma.Limit.OffsetValue.Long != offset.Limit.OffsetValue.Long {
+		t.Errorf("LIMIT 5, 10 must equal LIMIT 10 OFFSET 5 but %s", comma.Limit.ToSQLString())
+	}
+
+	t.Run("comma in generic dialect", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString(in), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseSQL(); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
+
+func TestParser_FetchPercentWithTies(t *testing.T) {
+	cases := []struct {
+		in       string
+		percent  bool
+		withTies bool
+		end      sqltoken.Pos
+	}{
+		{in: "SELECT a FROM t ORDER BY a OFFSET 5 ROWS FETCH NEXT 10 PERCENT ROWS WITH TIES", percent: true, withTies: true, end: sqltoken.NewPos(1, 78)},
+		{in: "SELECT a FROM t ORDER BY a FETCH FIRST 10 PERCENT ROWS ONLY", percent: true, end: sqltoken.NewPos(1, 60)},
+		{in: "SELECT a FROM t ORDER BY a FETCH FIRST 1 ROW WITH TIES", withTies: true, end: sqltoken.NewPos(1, 55)},
+		{in: "SELECT a FROM t ORDER BY a FETCH NEXT ROW WITH TIES", withTies: true, end: sqltoken.NewPos(1, 52)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if stmt.ToSQLString() != c.in {
+				t.Errorf("should be %s but %s", c.in, stmt.ToSQLString())
+			}
+
+			fetch := stmt.(*sqlast.QueryStmt).Fetch
+			if fetch.Percent != c.percent || fetch.WithTies != c.withTies {
+				t.Errorf("PERCENT and WITH TIES must be %v, %v but %v, %v", c.percent, c.withTies, fetch.Percent, fetch.WithTies)
+			}
+			if fetch.End() != c.end {
+				t.Errorf("End must be %v but %v", c.end, fetch.End())
+			}
+		})
+	}
+
+	for _, in := range []string{
+		"SELECT a FROM t FETCH FIRST 1 ROW WITH TIES",
+		"SELECT a FROM t ORDER BY a FETCH FIRST 1 ROW WITH",
+	} {
+		t.Run(in, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := parser.ParseStatement(); err == nil {
+				t.Error("should be error")
+			}
+		})
+	}
+}
+
+func TestParser_OptionalKeywords(t *testing.T) {
+	cases := []struct {
+		in      string
+		dialect dialect.Dialect
+	}{
+		{in: "SELECT * FROM a JOIN b ON a.id = b.id"},
+		{in: "SELECT * FROM a INNER JOIN b ON a.id = b.id"},
+		{in: "SELECT * FROM a LEFT JOIN b ON a.id = b.id"},
+		{in: "SELECT * FROM a LEFT OUTER JOIN b ON a.id = b.id"},
+		{in: "SELECT * FROM a FULL OUTER JOIN b USING (id)"},
+		{in: "SELECT x AS y FROM a AS b"},
+		{in: "SELECT x y FROM a b"},
+		{in: "INSERT INTO t (a) VALUES (1)"},
+		{in: "INSERT INTO t (a) VALUES (1)", dialect: &dialect.MySQLDialect{}},
+		{in: "INSERT t (a) VALUES (1)", dialect: &dialect.MySQLDialect{}},
+		{in: "DELETE FROM t WHERE a = 1"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			d := c.dialect
+			if d == nil {
+				d = &dialect.GenericSQLDialect{}
+			}
+			parser, err := NewParser(bytes.NewBufferString(c.in), d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if stmt.ToSQLString() != c.in {
+				t.Errorf("should be %s but %s", c.in, stmt.ToSQLString())
+			}
+		})
+	}
+
+	// FROM of DELETE and INTO of INSERT are required in the other dialects
+	for _, c := range []struct {
+		in      string
+		dialect dialect.Dialect
+	}{
+		{in: "DELETE t WHERE a = 1", dialect: &dialect.GenericSQLDialect{}},
+		{in: "DELETE t WHERE a = 1", dialect: &dialect.MySQLDialect{}},
+		{in: "DELETE t WHERE a = 1", dialect: &dialect.PostgresqlDialect{}},
+		{in: "INSERT t (a) VALUES (1)", dialect: &dialect.GenericSQLDialect{}},
+		{in: "INSERT t (a) VALUES (1)", dialect: &dialect.PostgresqlDialect{}},
+	} {
+		t.Run(c.in, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), c.dialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := parser.ParseStatement(); err == nil {
+				t.Error("should be error")
+			}
+		})
+	}
+}
+
+func TestParser_MySQLColumnCharset(t *testing.T) {
+	in := "CREATE TABLE t (name character varying(10) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin DEFAULT 'x' NOT NULL, b text CHARSET latin1)"
+	parser, err := NewParser(bytes.NewBufferString(in), &dialect.MySQLDialect{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt, err := parser.ParseStatement()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if stmt.ToSQLString() != in {
+		t.Errorf("should be %s but %s", in, stmt.ToSQLString())
+	}
+
+	col := stmt.(*sqlast.CreateTableStmt).Elements[0].(*sqlast.ColumnDef)
+	if diff := cmp.Diff(&sqlast.ColumnCharset{
+		Character: sqltoken.NewPos(1, 44),
+		Name:      sqlast.NewIdentWithPos("utf8mb4", sqltoken.NewPos(1, 58), sqltoken.NewPos(1, 65)),
+	}, col.Charset); diff != "" {
+		t.Errorf("diff %s", diff)
+	}
+	if diff := cmp.Diff(&sqlast.ColumnCollation{
+		Collate: sqltoken.NewPos(1, 66),
+		Name:    sqlast.NewIdentWithPos("utf8mb4_bin", sqltoken.NewPos(1, 74), sqltoken.NewPos(1, 85)),
+	}, col.Collation); diff != "" {
+		t.Errorf("diff %s", diff)
+	}
+
+	t.Run("after constraints", func(t *testing.T) {
+		in := "CREATE TABLE t (name VARCHAR(10) NOT NULL COLLATE utf8mb4_bin, b text DEFAULT 'x' CHARACTER SET latin1)"
+		parser, err := NewParser(bytes.NewBufferString(in), &dialect.MySQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		stmt, err := parser.ParseStatement()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		out := "CREATE TABLE t (name character varying(10) COLLATE utf8mb4_bin NOT NULL, b text CHARACTER SET latin1 DEFAULT 'x')"
+		if stmt.ToSQLString() != out {
+			t.Errorf("should be %s but %s", out, stmt.ToSQLString())
+		}
+		col := stmt.(*sqlast.CreateTableStmt).Elements[0].(*sqlast.ColumnDef)
+		if col.Collation == nil || col.Collation.Name.Value != "utf8mb4_bin" {
+			t.Errorf("collation must be utf8mb4_bin but %+v", col.Collation)
+		}
+		if col.End() != sqltoken.NewPos(1, 62) {
+			t.Errorf("End must be {1 62} but %v", col.End())
+		}
+	})
+
+	t.Run("collation twice", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString("CREATE TABLE t (name text COLLATE a NOT NULL COLLATE b)"), &dialect.MySQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseStatement(); err == nil {
+			t.Error("should be error")
+		}
+	})
+
+	t.Run("character set in generic dialect", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString(in), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseSQL(); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
+
+func TestParser_GeneratedColumns(t *testing.T) {
+	in := "CREATE TABLE t (a int, c int GENERATED ALWAYS AS (a + 1) STORED, id int GENERATED BY DEFAULT AS IDENTITY (START WITH 10 INCREMENT BY 2) PRIMARY KEY, v int GENERATED ALWAYS AS IDENTITY NOT NULL)"
+	parser, err := NewParser(bytes.NewBufferString(in), &dialect.PostgresqlDialect{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt, err := parser.ParseStatement()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if stmt.ToSQLString() != in {
+		t.Errorf("should be %s but %s", in, stmt.ToSQLString())
+	}
+
+	elems := stmt.(*sqlast.CreateTableStmt).Elements
+	if diff := CompareWithoutMarker(&sqlast.GeneratedColumnSpec{
+		Generated: sqltoken.NewPos(1, 30),
+		Expr: &sqlast.BinaryExpr{
+			Left: sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 51), sqltoken.NewPos(1, 52)),
+			Op: &sqlast.Operator{
+				Type: sqlast.Plus,
+				From: sqltoken.NewPos(1, 53),
+				To:   sqltoken.NewPos(1, 54),
+			},
+			Right: &sqlast.LongValue{
+				From: sqltoken.NewPos(1, 55),
+				To:   sqltoken.NewPos(1, 56),
+				Long: 1,
+			},
+		},
+		RParen:  sqltoken.NewPos(1, 57),
+		Storage: sqlast.GeneratedStored,
+		To:      sqltoken.NewPos(1, 64),
+	}, elems[1].(*sqlast.ColumnDef).Constraints[0].Spec); diff != "" {
+		t.Errorf("diff %s", diff)
+	}
+	if diff := CompareWithoutMarker(&sqlast.IdentityColumnSpec{
+		Generated: sqltoken.NewPos(1, 73),
+		ByDefault: true,
+		Options: []*sqlast.SequenceOption{
+			{
+				Kind: sqlast.SequenceStart,
+				Value: &sqlast.LongValue{
+					From: sqltoken.NewPos(1, 118),
+					To:   sqltoken.NewPos(1, 120),
+					Long: 10,
+				},
+				From: sqltoken.NewPos(1, 107),
+				To:   sqltoken.NewPos(1, 120),
+			},
+			{
+				Kind: sqlast.SequenceIncrement,
+				Value: &sqlast.LongValue{
+					From: sqltoken.NewPos(1, 134),
+					To:   sqltoken.NewPos(1, 135),
+					Long: 2,
+				},
+				From: sqltoken.NewPos(1, 121),
+				To:   sqltoken.NewPos(1, 135),
+			},
+		},
+		To: sqltoken.NewPos(1, 136),
+	}, elems[2].(*sqlast.ColumnDef).Constraints[0].Spec); diff != "" {
+		t.Errorf("diff %s", diff)
+	}
+
+	t.Run("virtual column", func(t *testing.T) {
+		in := "CREATE TABLE t (a int, b int GENERATED ALWAYS AS (a * 2) VIRTUAL)"
+		parser, err := NewParser(bytes.NewBufferString(in), &dialect.MySQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		stmt, err := parser.ParseStatement()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if stmt.ToSQLString() != in {
+			t.Errorf("should be %s but %s", in, stmt.ToSQLString())
+		}
+	})
+
+	errCases := []string{
+		"CREATE TABLE t (a int GENERATED AS (1))",
+		"CREATE TABLE t (a int GENERATED ALWAYS (1))",
+		"CREATE TABLE t (a int GENERATED BY DEFAULT AS (1))",
+		"CREATE TABLE t (a int GENERATED ALWAYS AS 1)",
+		"CREATE TABLE t (a int GENERATED ALWAYS AS IDENTITY (START WITH 1)",
+	}
+	for _, in := range errCases {
+		t.Run(in, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := parser.ParseStatement(); err == nil {
+				t.Error("should be error")
+			}
+		})
+	}
+}
+
+func TestParser_DataModifyingCTE(t *testing.T) {
+	in := "WITH d AS (DELETE FROM t WHERE a = 1 RETURNING *) SELECT * FROM d"
+	parser, err := NewParser(bytes.NewBufferString(in), &dialect.PostgresqlDialect{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt, err := parser.ParseStatement()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if stmt.ToSQLString() != in {
+		t.Errorf("should be %s but %s", in, stmt.ToSQLString())
+	}
+
+	cte := stmt.(*sqlast.QueryStmt).CTEs[0]
+	if _, ok := cte.Stmt.(*sqlast.DeleteStmt); !ok || cte.Query != nil {
+		t.Errorf("must be DELETE but %+v", cte)
+	}
+	if cte.End() != sqltoken.NewPos(1, 50) {
+		t.Errorf("End must be {1 50} but %v", cte.End())
+	}
+
+	t.Run("generic dialect", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString(in), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseSQL(); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
+
+func TestParser_ReturningInto(t *testing.T) {
+	in := "DELETE FROM t WHERE a = 1 RETURNING id, name INTO v_id, v_name"
+	parser, err := NewParser(bytes.NewBufferString(in), &dialect.PostgresqlDialect{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt, err := parser.ParseStatement()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if stmt.ToSQLString() != in {
+		t.Errorf("should be %s but %s", in, stmt.ToSQLString())
+	}
+
+	del := stmt.(*sqlast.DeleteStmt)
+	if diff := cmp.Diff(&sqlast.ReturningInto{
+		Into: sqltoken.NewPos(1, 46),
+		Targets: []sqlast.Node{
+			sqlast.NewIdentWithPos("v_id", sqltoken.NewPos(1, 51), sqltoken.NewPos(1, 55)),
+			sqlast.NewIdentWithPos("v_name", sqltoken.NewPos(1, 57), sqltoken.NewPos(1, 63)),
+		},
+	}, del.ReturningInto); diff != "" {
+		t.Errorf("diff %s", diff)
+	}
+	if del.End() != sqltoken.NewPos(1, 63) {
+		t.Errorf("End must be {1 63} but %v", del.End())
+	}
+
+	for _, c := range []struct {
+		dialect dialect.Dialect
+		in      string
+	}{
+		{dialect: &dialect.PostgresqlDialect{}, in: "INSERT INTO t (a) VALUES (1) RETURNING id INTO v"},
+		{dialect: &dialect.PostgresqlDialect{}, in: "UPDATE t SET a = 1 RETURNING a, b INTO x, y"},
+		{dialect: &dialect.PostgresqlDialect{}, in: "DELETE FROM t RETURNING id INTO STRICT v"},
+		{dialect: &dialect.PostgresqlDialect{}, in: "UPDATE t SET a = 1 RETURNING a, b INTO r.x, r.y"},
+		{dialect: &dialect.OracleDialect{}, in: "DELETE FROM t WHERE a = 1 RETURNING id, name INTO :v_id, :v_name"},
+		{dialect: &dialect.OracleDialect{}, in: "UPDATE t SET a = 1 RETURNING a INTO v_a"},
+	} {
+		in := c.in
+		t.Run(in, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(in), c.dialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if stmt.ToSQLString() != in {
+				t.Errorf("should be %s but %s", in, stmt.ToSQLString())
+			}
+		})
+	}
+
+	t.Run("without variables", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString("DELETE FROM t RETURNING id INTO"), &dialect.PostgresqlDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseSQL(); err == nil {
+			t.Error("should be error")
+		}
+	})
+
+	t.Run("not a variable", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString("DELETE FROM t RETURNING id INTO f(v)"), &dialect.PostgresqlDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseSQL(); err == nil {
+			t.Error("should be error")
+		}
+	})
+
+	t.Run("generic dialect", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString(in), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseSQL(); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
+
+func TestParser_ReturningQualified(t *testing.T) {
+	in := "DELETE FROM a USING b WHERE a.b_id = b.id RETURNING a.id, b.name AS bn, b.*"
+	parser, err := NewParser(bytes.NewBufferString(in), &dialect.PostgresqlDialect{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt, err := parser.ParseStatement()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if stmt.ToSQLString() != in {
+		t.Errorf("should be %s but %s", in, stmt.ToSQLString())
+	}
+
+	expected := []sqlast.SQLSelectItem{
+		&sqlast.UnnamedSelectItem{
+			Node: &sqlast.CompoundIdent{
+				Idents: []*sqlast.Ident{
+					sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 53), sqltoken.NewPos(1, 54)),
+					sqlast.NewIdentWithPos("id", sqltoken.NewPos(1, 55), sqltoken.NewPos(1, 57)),
+				},
+			},
+		},
+		&sqlast.AliasSelectItem{
+			Expr: &sqlast.CompoundIdent{
+				Idents: []*sqlast.Ident{
+					sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 59), sqltoken.NewPos(1, 60)),
+					sqlast.NewIdentWithPos("name", sqltoken.NewPos(1, 61), sqltoken.NewPos(1, 65)),
+				},
+			},
+			Alias: sqlast.NewIdentWithPos("bn", sqltoken.NewPos(1, 69), sqltoken.NewPos(1, 71)),
+		},
+		&sqlast.QualifiedWildcardSelectItem{
+			Prefix: &sqlast.ObjectName{
+				Idents: []*sqlast.Ident{
+					sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 73), sqltoken.NewPos(1, 74)),
+				},
+			},
+		},
+	}
+	del := stmt.(*sqlast.DeleteStmt)
+	if diff := CompareWithoutMarker(expected, del.Returning); diff != "" {
+		t.Errorf("diff %s", diff)
+	}
+	if len(del.Using) != 1 || del.Using[0].ToSQLString() != "b" {
+		t.Errorf("USING must be b but %v", del.Using)
+	}
+	if end := sqltoken.NewPos(1, 76); del.End() != end {
+		t.Errorf("End must be %s but %s", end, del.End())
+	}
+}
+
+func TestParser_CreateViewOptions(t *testing.T) {
+	cases := []struct {
+		name  string
+		in    string
+		check sqlast.ViewCheckOption
+		end   sqltoken.Pos
+	}{
+		{
+			name:  "WITH CASCADED CHECK OPTION",
+			in:    "CREATE VIEW v AS SELECT * FROM t WHERE a > 0 WITH CASCADED CHECK OPTION",
+			check: sqlast.ViewCheckOptionCascaded,
+			end:   sqltoken.NewPos(1, 72),
+		},
+		{
+			name:  "WITH LOCAL CHECK OPTION",
+			in:    "CREATE VIEW v AS SELECT * FROM t WITH LOCAL CHECK OPTION",
+			check: sqlast.ViewCheckOptionLocal,
+			end:   sqltoken.NewPos(1, 57),
+		},
+		{
+			name:  "WITH CHECK OPTION",
+			in:    "CREATE VIEW v AS SELECT * FROM t WITH CHECK OPTION",
+			check: sqlast.ViewCheckOptionDefault,
+			end:   sqltoken.NewPos(1, 51),
+		},
+		{
+			name:  "options",
+			in:    "CREATE VIEW v WITH (security_barrier = TRUE, check_option = 'local') AS SELECT * FROM t",
+			check: sqlast.ViewCheckOptionNone,
+			end:   sqltoken.NewPos(1, 88),
+		},
+		{
+			name:  "options and check option",
+			in:    "CREATE VIEW v WITH (security_barrier) AS SELECT * FROM t WITH CHECK OPTION",
+			check: sqlast.ViewCheckOptionDefault,
+			end:   sqltoken.NewPos(1, 75),
+		},
+		{
+			name:  "materialized view",
+			in:    "CREATE MATERIALIZED VIEW v AS SELECT * FROM t",
+			check: sqlast.ViewCheckOptionNone,
+			end:   sqltoken.NewPos(1, 46),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if stmt.ToSQLString() != c.in {
+				t.Errorf("should be %s but %s", c.in, stmt.ToSQLString())
+			}
+			view := stmt.(*sqlast.CreateViewStmt)
+			if view.CheckOption != c.check {
+				t.Errorf("CheckOption must be %v but %v", c.check, view.CheckOption)
+			}
+			if view.End() != c.end {
+				t.Errorf("End must be %s but %s", c.end, view.End())
+			}
+		})
+	}
+
+	errCases := []struct {
+		name    string
+		in      string
+		dialect dialect.Dialect
+	}{
+		{name: "options in MySQL dialect", in: "CREATE VIEW v WITH (security_barrier) AS SELECT * FROM t", dialect: &dialect.MySQLDialect{}},
+		{name: "CHECK without OPTION", in: "CREATE VIEW v AS SELECT * FROM t WITH CHECK", dialect: &dialect.PostgresqlDialect{}},
+		{name: "materialized view", in: "CREATE MATERIALIZED VIEW v AS SELECT * FROM t WITH CHECK OPTION", dialect: &dialect.PostgresqlDialect{}},
+	}
+	for _, c := range errCases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), c.dialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := parser.ParseStatement(); err == nil {
+				t.Error("should be error")
+			}
+		})
+	}
+}
+
+func TestParser_UnicodeEscapes(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  *sqlast.SingleQuotedString
+		sql  string
+	}{
+		{
+			name: "default escape",
+			in:   `U&'d\0061t\+000061'`,
+			out: &sqlast.SingleQuotedString{
+				From:    sqltoken.NewPos(1, 1),
+				To:      sqltoken.NewPos(1, 20),
+				String:  "data",
+				Unicode: true,
+			},
+			sql: "U&'data'",
+		},
+		{
+			name: "UESCAPE",
+			in:   `U&'d!0061t!!' UESCAPE '!'`,
+			out: &sqlast.SingleQuotedString{
+				From:    sqltoken.NewPos(1, 1),
+				To:      sqltoken.NewPos(1, 26),
+				String:  "dat!",
+				Unicode: true,
+				Escape:  '!',
+			},
+			sql: "U&'dat!!' UESCAPE '!'",
+		},
+		{
+			name: "backslash in value",
+			in:   `U&'\005C'`,
+			out: &sqlast.SingleQuotedString{
+				From:    sqltoken.NewPos(1, 1),
+				To:      sqltoken.NewPos(1, 10),
+				String:  `\`,
+				Unicode: true,
+			},
+			sql: `U&'\\'`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			expr, err := ParseExpr(c.in, &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if diff := cmp.Diff(c.out, expr); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+			if s := expr.ToSQLString(); s != c.sql {
+				t.Errorf("should be %s but %s", c.sql, s)
+			}
+
+			// decoded again to the same value
+			again, err := ParseExpr(c.sql, &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if s := again.(*sqlast.SingleQuotedString).String; s != c.out.String {
+				t.Errorf("should be %s but %s", c.out.String, s)
+			}
+		})
+	}
+
+	t.Run("identifier", func(t *testing.T) {
+		in := `SELECT U&"d\0061t" FROM t`
+		parser, err := NewParser(bytes.NewBufferString(in), &dialect.PostgresqlDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		stmt, err := parser.ParseStatement()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if stmt.ToSQLString() != in {
+			t.Errorf("should be %s but %s", in, stmt.ToSQLString())
+		}
+	})
+
+	errCases := []string{
+		`U&'\00zz'`,
+		`U&'a' UESCAPE 'ab'`,
+		`U&'a' UESCAPE '+'`,
+		`U&'a' UESCAPE`,
+	}
+	for _, in := range errCases {
+		t.Run(in, func(t *testing.T) {
+			if _, err := ParseExpr(in, &dialect.PostgresqlDialect{}); err == nil {
+				t.Error("should be error")
+			}
+		})
+	}
+}
+
+func TestParser_DollarQuotedStrings(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+	}{
+		{name: "select list", in: "SELECT $$x$$"},
+		{name: "tagged in where clause", in: "SELECT * FROM t WHERE a = $x$v$x$"},
+		{name: "function argument", in: "SELECT f($$it's$$, 1)"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if stmt.ToSQLString() != c.in {
+				t.Errorf("should be %s but %s", c.in, stmt.ToSQLString())
+			}
+		})
+	}
+
+	t.Run("node", func(t *testing.T) {
+		expr, err := ParseExpr("$x$v$x$", &dialect.PostgresqlDialect{})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		expected := &sqlast.DollarQuotedString{
+			From:   sqltoken.NewPos(1, 1),
+			To:     sqltoken.NewPos(1, 8),
+			Tag:    "x",
+			String: "v",
+		}
+		if diff := cmp.Diff(expected, expr); diff != "" {
+			t.Errorf("diff %s", diff)
+		}
+	})
+}
+
+func TestParser_OrderedAggregates(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      string
+		dialect dialect.Dialect
+		end     sqltoken.Pos
+	}{
+		{
+			name:    "GROUP_CONCAT",
+			in:      "SELECT GROUP_CONCAT(DISTINCT name ORDER BY name DESC SEPARATOR ';') FROM t GROUP BY a",
+			dialect: &dialect.MySQLDialect{},
+			end:     sqltoken.NewPos(1, 68),
+		},
+		{
+			name:    "LISTAGG",
+			in:      "SELECT LISTAGG(name, ', ') WITHIN GROUP (ORDER BY name, id DESC) FROM t",
+			dialect: &dialect.GenericSQLDialect{},
+			end:     sqltoken.NewPos(1, 65),
+		},
+		{
+			name:    "mode",
+			in:      "SELECT mode() WITHIN GROUP (ORDER BY price) FROM t",
+			dialect: &dialect.PostgresqlDialect{},
+			end:     sqltoken.NewPos(1, 44),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), c.dialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if stmt.ToSQLString() != c.in {
+				t.Errorf("should be %s but %s", c.in, stmt.ToSQLString())
+			}
+
+			body := stmt.(*sqlast.QueryStmt).Body.(*sqlast.SQLSelect)
+			f := body.Projection[0].(*sqlast.UnnamedSelectItem).Node.(*sqlast.Function)
+			if f.End() != c.end {
+				t.Errorf("End must be %s but %s", c.end, f.End())
+			}
+		})
+	}
+
+	errCases := []struct {
+		name    string
+		in      string
+		dialect dialect.Dialect
+	}{
+		{name: "SEPARATOR in generic dialect", in: "SELECT GROUP_CONCAT(a SEPARATOR ',') FROM t", dialect: &dialect.GenericSQLDialect{}},
+		{name: "SEPARATOR without string", in: "SELECT GROUP_CONCAT(a SEPARATOR b) FROM t", dialect: &dialect.MySQLDialect{}},
+		{name: "WITHIN GROUP without ORDER BY", in: "SELECT LISTAGG(a) WITHIN GROUP (a) FROM t", dialect: &dialect.GenericSQLDialect{}},
+		{name: "WITHIN GROUP without parenthesis", in: "SELECT LISTAGG(a) WITHIN GROUP ORDER BY a FROM t", dialect: &dialect.GenericSQLDialect{}},
+	}
+	for _, c := range errCases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), c.dialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := parser.ParseSQL(); err == nil {
+				t.Error("should be error")
+			}
+		})
+	}
+}
+
+func TestParser_AggregateModifiers(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  string
+		end  sqltoken.Pos
+	}{
+		{
+			name: "standard order",
+			in:   "SELECT percentile_cont(1) WITHIN GROUP (ORDER BY x) FILTER (WHERE y > 0) OVER (PARTITION BY z) FROM t",
+			end:  sqltoken.NewPos(1, 95),
+		},
+		{
+			name: "FILTER before WITHIN GROUP",
+			in:   "SELECT percentile_cont(1) FILTER (WHERE y > 0) WITHIN GROUP (ORDER BY x) OVER (PARTITION BY z) FROM t",
+			out:  "SELECT percentile_cont(1) WITHIN GROUP (ORDER BY x) FILTER (WHERE y > 0) OVER (PARTITION BY z) FROM t",
+			end:  sqltoken.NewPos(1, 95),
+		},
+		{
+			name: "FILTER only",
+			in:   "SELECT count(*) FILTER (WHERE y > 0) FROM t",
+			end:  sqltoken.NewPos(1, 37),
+		},
+		{
+			name: "alias named filter",
+			in:   "SELECT count(*) filter FROM t",
+			end:  sqltoken.NewPos(1, 16),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			out := c.out
+			if out == "" {
+				out = c.in
+			}
+			if stmt.ToSQLString() != out {
+				t.Errorf("should be %s but %s", out, stmt.ToSQLString())
+			}
+
+			var f *sqlast.Function
+			sqlast.Inspect(stmt, func(node sqlast.Node) bool {
+				if n, ok := node.(*sqlast.Function); ok && f == nil {
+					f = n
+				}
+				return true
+			})
+			if f.End() != c.end {
+				t.Errorf("End must be %s but %s", c.end, f.End())
+			}
+		})
+	}
+
+	errCases := []struct {
+		name string
+		in   string
+	}{
+		{name: "duplicate FILTER", in: "SELECT count(*) FILTER (WHERE a) FILTER (WHERE b) FROM t"},
+		{name: "duplicate WITHIN GROUP", in: "SELECT mode() WITHIN GROUP (ORDER BY a) WITHIN GROUP (ORDER BY b) FROM t"},
+		{name: "FILTER without WHERE", in: "SELECT count(*) FILTER (a > 0) FROM t"},
+	}
+	for _, c := range errCases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := parser.ParseSQL(); err == nil {
+				t.Error("should be error")
+			}
+		})
+	}
+}
+
+func TestParser_WildcardModifiers(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		item sqlast.SQLSelectItem
+	}{
+		{
+			name: "EXCLUDE",
+			in:   "SELECT * EXCLUDE (x) FROM t",
+			item: &sqlast.WildcardSelectItem{
+				From: sqltoken.NewPos(1, 8),
+				To:   sqltoken.NewPos(1, 9),
+				Exclude: &sqlast.ExcludeColumns{
+					Exclude: sqltoken.NewPos(1, 10),
+					Columns: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("x", sqltoken.NewPos(1, 19), sqltoken.NewPos(1, 20)),
 					},
+					RParen: sqltoken.NewPos(1, 21),
 				},
 			},
-			{
-				name: "pg change type",
-				in: `ALTER TABLE products
-ALTER COLUMN number TYPE numeric(255,10)`,
-				out: &sqlast.AlterTableStmt{
-					Alter: sqltoken.NewPos(1, 1),
-					TableName: &sqlast.ObjectName{
-						Idents: []*sqlast.Ident{
-							sqlast.NewIdentWithPos("products", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 21)),
-						},
-					},
-					Action: &sqlast.AlterColumnTableAction{
-						Alter:      sqltoken.NewPos(2, 1),
-						ColumnName: sqlast.NewIdentWithPos("number", sqltoken.NewPos(2, 14), sqltoken.NewPos(2, 20)),
-						Action: &sqlast.PGAlterDataTypeColumnAction{
-							Type: sqltoken.NewPos(2, 21),
-							DataType: &sqlast.Decimal{
-								Scale:     sqlast.NewSize(10),
-								Precision: sqlast.NewSize(255),
-								Numeric:   sqltoken.NewPos(2, 26),
-								RParen:    sqltoken.NewPos(2, 41),
+		},
+		{
+			name: "REPLACE",
+			in:   "SELECT * REPLACE (y * 2 AS y) FROM t",
+			item: &sqlast.WildcardSelectItem{
+				From: sqltoken.NewPos(1, 8),
+				To:   sqltoken.NewPos(1, 9),
+				Replace: &sqlast.ReplaceColumns{
+					Replace: sqltoken.NewPos(1, 10),
+					Items: []*sqlast.AliasSelectItem{
+						{
+							Expr: &sqlast.BinaryExpr{
+								Left: sqlast.NewIdentWithPos("y", sqltoken.NewPos(1, 19), sqltoken.NewPos(1, 20)),
+								Op: &sqlast.Operator{
+									Type: sqlast.Multiply,
+									From: sqltoken.NewPos(1, 21),
+									To:   sqltoken.NewPos(1, 22),
+								},
+								Right: &sqlast.LongValue{
+									From: sqltoken.NewPos(1, 23),
+									To:   sqltoken.NewPos(1, 24),
+									Long: 2,
+								},
 							},
+							Alias: sqlast.NewIdentWithPos("y", sqltoken.NewPos(1, 28), sqltoken.NewPos(1, 29)),
 						},
 					},
+					RParen: sqltoken.NewPos(1, 30),
 				},
 			},
-		}
-
-		for _, c := range cases {
+		},
+	}
 
-			t.Run(c.name, func(t *testing.T) {
-				if c.skip {
-					t.Skip()
-				}
-				parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
-				if err != nil {
-					t.Fatal(err)
-				}
-				ast, err := parser.ParseStatement()
-				if err != nil {
-					t.Fatalf("%+v", err)
-				}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if stmt.ToSQLString() != c.in {
+				t.Errorf("should be %s but %s", c.in, stmt.ToSQLString())
+			}
+			body := stmt.(*sqlast.QueryStmt).Body.(*sqlast.SQLSelect)
+			if diff := CompareWithoutMarker(c.item, body.Projection[0]); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+		})
+	}
 
-				if diff := CompareWithoutMarker(c.out, ast); diff != "" {
-					t.Errorf("diff %s", diff)
-				}
-			})
+	t.Run("qualified wildcard", func(t *testing.T) {
+		in := "SELECT t.* EXCLUDE (a, b) REPLACE (c || 'x' AS c), u.* FROM t, u"
+		parser, err := NewParser(bytes.NewBufferString(in), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		stmt, err := parser.ParseStatement()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if stmt.ToSQLString() != in {
+			t.Errorf("should be %s but %s", in, stmt.ToSQLString())
 		}
 	})
 
-	t.Run("update", func(t *testing.T) {
-		cases := []struct {
-			name string
-			in   string
-			out  sqlast.Stmt
-			skip bool
-		}{
-			{
-				name: "simple case",
-				in:   "UPDATE customers SET contract_name = 'Alfred Schmidt', city = 'Frankfurt' WHERE customer_id = 1",
-				out: &sqlast.UpdateStmt{
-					Update: sqltoken.NewPos(1, 1),
-					TableName: &sqlast.ObjectName{
-						Idents: []*sqlast.Ident{
-							{
-								Value: "customers",
-								From:  sqltoken.NewPos(1, 8),
-								To:    sqltoken.NewPos(1, 17),
-							},
-						},
+	errCases := []struct {
+		name    string
+		in      string
+		dialect dialect.Dialect
+	}{
+		{name: "EXCLUDE in PostgreSQL dialect", in: "SELECT * EXCLUDE (x) FROM t", dialect: &dialect.PostgresqlDialect{}},
+		{name: "REPLACE in MySQL dialect", in: "SELECT * REPLACE (x AS x) FROM t", dialect: &dialect.MySQLDialect{}},
+		{name: "EXCLUDE without parenthesis", in: "SELECT * EXCLUDE x FROM t", dialect: &dialect.GenericSQLDialect{}},
+		{name: "REPLACE without alias", in: "SELECT * REPLACE (x + 1) FROM t", dialect: &dialect.GenericSQLDialect{}},
+	}
+	for _, c := range errCases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), c.dialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := parser.ParseSQL(); err == nil {
+				t.Error("should be error")
+			}
+		})
+	}
+}
+
+func TestParser_IndexHints(t *testing.T) {
+	cases := []struct {
+		name  string
+		in    string
+		hints []*sqlast.IndexHint
+	}{
+		{
+			name: "USE INDEX FOR JOIN",
+			in:   "SELECT * FROM t USE INDEX FOR JOIN (idx1, idx2) WHERE a = 1",
+			hints: []*sqlast.IndexHint{
+				{
+					Type:    sqlast.UseIndex,
+					TypePos: sqltoken.NewPos(1, 17),
+					For:     sqlast.IndexHintForJoin,
+					Indexes: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("idx1", sqltoken.NewPos(1, 37), sqltoken.NewPos(1, 41)),
+						sqlast.NewIdentWithPos("idx2", sqltoken.NewPos(1, 43), sqltoken.NewPos(1, 47)),
 					},
-					Assignments: []*sqlast.Assignment{
-						{
-							ID: sqlast.NewIdentWithPos("contract_name", sqltoken.NewPos(1, 22), sqltoken.NewPos(1, 35)),
-							Value: &sqlast.SingleQuotedString{
-								From:   sqltoken.NewPos(1, 38),
-								To:     sqltoken.NewPos(1, 54),
-								String: "Alfred Schmidt",
-							},
-						},
-						{
-							ID:    sqlast.NewIdentWithPos("city", sqltoken.NewPos(1, 56), sqltoken.NewPos(1, 60)),
-							Value: &sqlast.SingleQuotedString{String: "Frankfurt", From: sqltoken.NewPos(1, 63), To: sqltoken.NewPos(1, 74)},
-						},
+					RParen: sqltoken.NewPos(1, 48),
+				},
+			},
+		},
+		{
+			name: "multiple hints with alias",
+			in:   "SELECT * FROM t AS a FORCE INDEX (i1) IGNORE KEY FOR ORDER BY (i2) ORDER BY b",
+			hints: []*sqlast.IndexHint{
+				{
+					Type:    sqlast.ForceIndex,
+					TypePos: sqltoken.NewPos(1, 22),
+					Indexes: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("i1", sqltoken.NewPos(1, 35), sqltoken.NewPos(1, 37)),
 					},
-					Selection: &sqlast.BinaryExpr{
-						Op:   &sqlast.Operator{Type: sqlast.Eq, From: sqltoken.NewPos(1, 93), To: sqltoken.NewPos(1, 94)},
-						Left: sqlast.NewIdentWithPos("customer_id", sqltoken.NewPos(1, 81), sqltoken.NewPos(1, 92)),
-						Right: &sqlast.LongValue{
-							From: sqltoken.NewPos(1, 95),
-							To:   sqltoken.NewPos(1, 96),
-							Long: 1,
-						},
+					RParen: sqltoken.NewPos(1, 38),
+				},
+				{
+					Type:    sqlast.IgnoreIndex,
+					TypePos: sqltoken.NewPos(1, 39),
+					Key:     true,
+					For:     sqlast.IndexHintForOrderBy,
+					Indexes: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("i2", sqltoken.NewPos(1, 64), sqltoken.NewPos(1, 66)),
 					},
+					RParen: sqltoken.NewPos(1, 67),
 				},
 			},
-		}
-
-		for _, c := range cases {
+		},
+		{
+			name: "empty USE INDEX",
+			in:   "SELECT * FROM t USE INDEX () JOIN u USE INDEX FOR GROUP BY (i) ON t.a = u.a",
+			hints: []*sqlast.IndexHint{
+				{
+					Type:    sqlast.UseIndex,
+					TypePos: sqltoken.NewPos(1, 17),
+					RParen:  sqltoken.NewPos(1, 29),
+				},
+			},
+		},
+	}
 
-			t.Run(c.name, func(t *testing.T) {
-				if c.skip {
-					t.Skip()
-				}
-				parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
-				if err != nil {
-					t.Fatal(err)
-				}
-				ast, err := parser.ParseStatement()
-				if err != nil {
-					t.Fatal(err)
-				}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.MySQLDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if stmt.ToSQLString() != c.in {
+				t.Errorf("should be %s but %s", c.in, stmt.ToSQLString())
+			}
 
-				if diff := CompareWithoutMarker(c.out, ast); diff != "" {
-					t.Errorf("diff %s", diff)
+			var table *sqlast.Table
+			sqlast.Inspect(stmt, func(node sqlast.Node) bool {
+				if n, ok := node.(*sqlast.Table); ok && table == nil {
+					table = n
 				}
+				return true
 			})
-		}
-	})
-}
-
-func TestParser_ParseSQL(t *testing.T) {
-	in := `
-create table account (
-    account_id serial primary key,
-    name varchar(255) not null,
-    email varchar(255) unique not null,
-    age smallint not null,
-    registered_at timestamp with time zone default current_timestamp
-);
+			if diff := CompareWithoutMarker(c.hints, table.IndexHints); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+			if end := c.hints[len(c.hints)-1].RParen; table.End() != end {
+				t.Errorf("End must be %s but %s", end, table.End())
+			}
+		})
+	}
 
-create table category (
-    category_id serial primary key,
-    name varchar(255) not null
-);
+	errCases := []struct {
+		name    string
+		in      string
+		dialect dialect.Dialect
+	}{
+		{name: "index hint in generic dialect", in: "SELECT * FROM t USE INDEX (i)", dialect: &dialect.GenericSQLDialect{}},
+		{name: "FORCE INDEX without names", in: "SELECT * FROM t FORCE INDEX ()", dialect: &dialect.MySQLDialect{}},
+		{name: "unknown FOR clause", in: "SELECT * FROM t USE INDEX FOR UPDATE (i)", dialect: &dialect.MySQLDialect{}},
+	}
+	for _, c := range errCases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), c.dialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := parser.ParseSQL(); err == nil {
+				t.Error("should be error")
+			}
+		})
+	}
+}
 
-create table item (
-    item_id serial primary key,
-    price int not null,
-    name varchar(255) not null,
-    category_id int references category(category_id),
-    created_at timestamp with time zone default current_timestamp
-);
-`
-	parser, err := NewParser(bytes.NewBufferString(in), &dialect.GenericSQLDialect{})
+func TestParser_OuterJoinMarker(t *testing.T) {
+	in := "SELECT a.name, b.total FROM a, b WHERE a.id = b.id(+) AND b.kind(+) = 1 AND code(+) IS NULL"
+	parser, err := NewParser(bytes.NewBufferString(in), &dialect.OracleDialect{})
 	if err != nil {
-		t.Fatalf("%+v", err)
+		t.Fatal(err)
 	}
-
-	stmts, err := parser.ParseSQL()
+	stmt, err := parser.ParseStatement()
 	if err != nil {
 		t.Fatalf("%+v", err)
 	}
+	if stmt.ToSQLString() != in {
+		t.Errorf("should be %s but %s", in, stmt.ToSQLString())
+	}
 
-	if len(stmts) != 3 {
-		t.Fatal("must be 3 stmts")
+	var columns []*sqlast.OuterJoinColumn
+	sqlast.Inspect(stmt, func(node sqlast.Node) bool {
+		if c, ok := node.(*sqlast.OuterJoinColumn); ok {
+			columns = append(columns, c)
+		}
+		return true
+	})
+	expected := []*sqlast.OuterJoinColumn{
+		{
+			Column: &sqlast.CompoundIdent{
+				Idents: []*sqlast.Ident{
+					sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 47), sqltoken.NewPos(1, 48)),
+					sqlast.NewIdentWithPos("id", sqltoken.NewPos(1, 49), sqltoken.NewPos(1, 51)),
+				},
+			},
+			RParen: sqltoken.NewPos(1, 54),
+		},
+		{
+			Column: &sqlast.CompoundIdent{
+				Idents: []*sqlast.Ident{
+					sqlast.NewIdentWithPos("b", sqltoken.NewPos(1, 59), sqltoken.NewPos(1, 60)),
+					sqlast.NewIdentWithPos("kind", sqltoken.NewPos(1, 61), sqltoken.NewPos(1, 65)),
+				},
+			},
+			RParen: sqltoken.NewPos(1, 68),
+		},
+		{
+			Column: sqlast.NewIdentWithPos("code", sqltoken.NewPos(1, 77), sqltoken.NewPos(1, 81)),
+			RParen: sqltoken.NewPos(1, 84),
+		},
+	}
+	if diff := CompareWithoutMarker(expected, columns); diff != "" {
+		t.Errorf("diff %s", diff)
 	}
-}
 
-func TestParser_ParseFile(t *testing.T) {
+	t.Run("function call", func(t *testing.T) {
+		in := "SELECT abs(+1), nvl(a, 0) FROM t"
+		parser, err := NewParser(bytes.NewBufferString(in), &dialect.OracleDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		stmt, err := parser.ParseStatement()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		sqlast.Inspect(stmt, func(node sqlast.Node) bool {
+			if _, ok := node.(*sqlast.OuterJoinColumn); ok {
+				t.Errorf("should not be outer join marker: %s", node.ToSQLString())
+			}
+			return true
+		})
+	})
+}
 
+func TestParser_ParseCommentOn(t *testing.T) {
 	cases := []struct {
 		name string
-		skip bool
 		in   string
-		out  []*sqlast.CommentGroup
+		out  sqlast.Stmt
 	}{
 		{
-			name: "single line",
-			in: `--comment
-select 1 from test;`,
-			out: []*sqlast.CommentGroup{
-				{
-					List: []*sqlast.Comment{
-						{
-							Text: "comment",
-							From: sqltoken.NewPos(1, 1),
-							To:   sqltoken.NewPos(1, 10),
-						},
+			name: "set comment on column",
+			in:   "COMMENT ON COLUMN t.c IS 'desc'",
+			out: &sqlast.CommentStmt{
+				Comment:    sqltoken.NewPos(1, 1),
+				ObjectKind: sqlast.CommentOnColumn,
+				Name: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 19), sqltoken.NewPos(1, 20)),
+						sqlast.NewIdentWithPos("c", sqltoken.NewPos(1, 21), sqltoken.NewPos(1, 22)),
 					},
 				},
+				Text: &sqlast.SingleQuotedString{
+					From:   sqltoken.NewPos(1, 26),
+					To:     sqltoken.NewPos(1, 32),
+					String: "desc",
+				},
 			},
 		},
 		{
-			name: "multi line",
-			in: `
-create table account (
-    account_id serial primary key,  --aaa
-	/*bbb*/
-    name varchar(255) not null,
-    email /*ccc*/ varchar(255) unique not null --ddd
-);
-
---eee
-
-/*fff
-ggg
-*/
-select 1 from test; --hhh
-/*jjj*/ --kkk
-select 1 from test; /*lll*/ --mmm
---nnn
-`,
-			out: []*sqlast.CommentGroup{
-				{
-					List: []*sqlast.Comment{
-						{
-							Text: "aaa",
-							From: sqltoken.NewPos(3, 37),
-							To:   sqltoken.NewPos(3, 42),
-						},
-					},
-				},
-				{
-					List: []*sqlast.Comment{
-						{
-							Text: "bbb",
-							From: sqltoken.NewPos(4, 5),
-							To:   sqltoken.NewPos(4, 12),
-						},
-					},
-				},
-				{
-					List: []*sqlast.Comment{
-						{
-							Text: "ccc",
-							From: sqltoken.NewPos(6, 11),
-							To:   sqltoken.NewPos(6, 18),
-						},
-					},
-				},
-				{
-					List: []*sqlast.Comment{
-						{
-							Text: "ddd",
-							From: sqltoken.NewPos(6, 48),
-							To:   sqltoken.NewPos(6, 53),
-						},
-					},
-				},
-				{
-					List: []*sqlast.Comment{
-						{
-							Text: "eee",
-							From: sqltoken.NewPos(9, 1),
-							To:   sqltoken.NewPos(9, 6),
-						},
-						{
-							Text: "fff\nggg\n",
-							From: sqltoken.NewPos(11, 1),
-							To:   sqltoken.NewPos(13, 3),
-						},
-					},
-				},
-				{
-					List: []*sqlast.Comment{
-						{
-							Text: "hhh",
-							From: sqltoken.NewPos(14, 21),
-							To:   sqltoken.NewPos(14, 26),
-						},
+			name: "clear comment on table",
+			in:   "COMMENT ON TABLE t IS NULL",
+			out: &sqlast.CommentStmt{
+				Comment:    sqltoken.NewPos(1, 1),
+				ObjectKind: sqlast.CommentOnTable,
+				Name: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("t", sqltoken.NewPos(1, 18), sqltoken.NewPos(1, 19)),
 					},
 				},
-				{
-					List: []*sqlast.Comment{
-						{
-							Text: "jjj",
-							From: sqltoken.NewPos(15, 1),
-							To:   sqltoken.NewPos(15, 8),
-						},
-						{
-							Text: "kkk",
-							From: sqltoken.NewPos(15, 9),
-							To:   sqltoken.NewPos(15, 14),
-						},
+				NullPos: sqltoken.NewPos(1, 27),
+			},
+		},
+		{
+			name: "clear comment on index",
+			in:   "COMMENT ON INDEX idx IS NULL",
+			out: &sqlast.CommentStmt{
+				Comment:    sqltoken.NewPos(1, 1),
+				ObjectKind: sqlast.CommentOnIndex,
+				Name: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("idx", sqltoken.NewPos(1, 18), sqltoken.NewPos(1, 21)),
 					},
 				},
-				{
-					List: []*sqlast.Comment{
-						{
-							Text: "lll",
-							From: sqltoken.NewPos(16, 21),
-							To:   sqltoken.NewPos(16, 28),
-						},
-						{
-							Text: "mmm",
-							From: sqltoken.NewPos(16, 29),
-							To:   sqltoken.NewPos(16, 34),
-						},
+				NullPos: sqltoken.NewPos(1, 29),
+			},
+		},
+		{
+			name: "set comment on view",
+			in:   "COMMENT ON VIEW v IS 'recent orders'",
+			out: &sqlast.CommentStmt{
+				Comment:    sqltoken.NewPos(1, 1),
+				ObjectKind: sqlast.CommentOnView,
+				Name: &sqlast.ObjectName{
+					Idents: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("v", sqltoken.NewPos(1, 17), sqltoken.NewPos(1, 18)),
 					},
 				},
-				{
-					List: []*sqlast.Comment{
-						{
-							Text: "nnn",
-							From: sqltoken.NewPos(17, 1),
-							To:   sqltoken.NewPos(17, 6),
-						},
-					},
+				Text: &sqlast.SingleQuotedString{
+					From:   sqltoken.NewPos(1, 22),
+					To:     sqltoken.NewPos(1, 37),
+					String: "recent orders",
 				},
 			},
 		},
@@ -2088,24 +6555,136 @@ select 1 from test; /*lll*/ --mmm
 
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			if c.skip {
-				t.Skip()
-			}
-			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{}, ParseComment)
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.PostgresqlDialect{})
 			if err != nil {
 				t.Fatal(err)
 			}
-
-			f, err := parser.ParseFile()
+			stmt, err := parser.ParseStatement()
 			if err != nil {
 				t.Fatalf("%+v", err)
 			}
 
-			if diff := cmp.Diff(c.out, f.Comments); diff != "" {
+			if diff := CompareWithoutMarker(c.out, stmt); diff != "" {
 				t.Errorf("diff %s", diff)
 			}
-
+			if stmt.ToSQLString() != c.in {
+				t.Errorf("should be %s but %s", c.in, stmt.ToSQLString())
+			}
+			if stmt.End() != c.out.End() {
+				t.Errorf("End must be %v but %v", c.out.End(), stmt.End())
+			}
 		})
 	}
 
+	t.Run("unsupported object kind", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString("COMMENT ON FUNCTION f IS 'x'"), &dialect.PostgresqlDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseStatement(); err == nil {
+			t.Error("should be error")
+		}
+	})
+
+	t.Run("without ON", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString("COMMENT TABLE t IS NULL"), &dialect.PostgresqlDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = parser.ParseStatement()
+		if err == nil {
+			t.Fatal("should be error")
+		}
+		if msg := err.Error(); !strings.Contains(msg, "expected ON after COMMENT but") || !strings.Contains(msg, "Value:TABLE") {
+			t.Errorf("error must show the unexpected token but %s", msg)
+		}
+	})
+
+	t.Run("incomplete", func(t *testing.T) {
+		for _, in := range []string{"COMMENT", "COMMENT ON", "COMMENT ON TABLE t", "COMMENT ON TABLE t IS"} {
+			parser, err := NewParser(bytes.NewBufferString(in), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := parser.ParseStatement(); !errors.Is(err, ErrUnexpectedEOF) {
+				t.Errorf("%s: errors.Is(err, ErrUnexpectedEOF) must be true but err: %v", in, err)
+			}
+		}
+	})
+
+	t.Run("comment in generic dialect", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString("COMMENT ON TABLE t IS NULL"), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseStatement(); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
+
+func TestParser_ReservedKeywords(t *testing.T) {
+	t.Run("accepted", func(t *testing.T) {
+		cases := []struct {
+			in      string
+			dialect dialect.Dialect
+		}{
+			{in: "CREATE TABLE t (value int, name text, type text, count int)"},
+			{in: "SELECT count(*) AS count FROM t AS value WHERE name = 'x'"},
+			{in: "UPDATE t SET value = 1"},
+			{in: `SELECT a AS "select" FROM "from"`},
+			{in: "UPDATE t SET offset = 1"},
+			{in: "SELECT * FROM t AS user"},
+			{in: "SELECT a AS from"},
+			{in: "SELECT a AS select FROM t AS where"},
+			{in: "CREATE TABLE t (user character varying(10))", dialect: &dialect.MySQLDialect{}},
+			{in: "UPDATE t SET offset = 1", dialect: &dialect.MySQLDialect{}},
+			{in: "SELECT end FROM t", dialect: &dialect.OracleDialect{}},
+		}
+		for _, c := range cases {
+			d := c.dialect
+			if d == nil {
+				d = &dialect.GenericSQLDialect{}
+			}
+			parser, err := NewParser(bytes.NewBufferString(c.in), d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%s: %+v", c.in, err)
+			}
+			if stmt.ToSQLString() != c.in {
+				t.Errorf("should be %s but %s", c.in, stmt.ToSQLString())
+			}
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		cases := []struct {
+			in      string
+			dialect dialect.Dialect
+		}{
+			{in: "CREATE TABLE t (from int)"},
+			{in: "UPDATE t SET order = 1"},
+			{in: "CREATE TABLE t (user varchar(10))", dialect: &dialect.PostgresqlDialect{}},
+			{in: "UPDATE t SET offset = 1", dialect: &dialect.PostgresqlDialect{}},
+			{in: "UPDATE t SET user = 1", dialect: &dialect.OracleDialect{}},
+		}
+		for _, c := range cases {
+			d := c.dialect
+			if d == nil {
+				d = &dialect.GenericSQLDialect{}
+			}
+			parser, err := NewParser(bytes.NewBufferString(c.in), d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := parser.ParseStatement(); err == nil {
+				t.Errorf("%s: should be error", c.in)
+			} else if !strings.Contains(err.Error(), "reserved keyword") {
+				t.Errorf("%s: error must be about the reserved keyword but %v", c.in, err)
+			}
+		}
+	})
 }
@@ -88,6 +88,41 @@ func (s *Ident) End() sqltoken.Pos {
 	return s.To
 }
 
+// Placeholder of prepared statement i.e: `$1`, `?`, `:name`
+type Placeholder struct {
+	Value    string
+	From, To sqltoken.Pos
+}
+
+func (s *Placeholder) ToSQLString() string {
+	return s.Value
+}
+
+func (s *Placeholder) Pos() sqltoken.Pos {
+	return s.From
+}
+
+func (s *Placeholder) End() sqltoken.Pos {
+	return s.To
+}
+
+// DEFAULT keyword used as a value, i.e: INSERT INTO t VALUES (DEFAULT)
+type DefaultExpr struct {
+	From, To sqltoken.Pos
+}
+
+func (s *DefaultExpr) ToSQLString() string {
+	return "DEFAULT"
+}
+
+func (s *DefaultExpr) Pos() sqltoken.Pos {
+	return s.From
+}
+
+func (s *DefaultExpr) End() sqltoken.Pos {
+	return s.To
+}
+
 // `*` Node.
 type Wildcard struct {
 	Wildcard sqltoken.Pos
@@ -150,6 +185,24 @@ func (s *CompoundIdent) ToSQLString() string {
 	return strings.Join(strs, ".")
 }
 
+// column with outer join marker of Oracle, i.e: `b.id(+)`
+type OuterJoinColumn struct {
+	Column Node // *Ident or *CompoundIdent
+	RParen sqltoken.Pos
+}
+
+func (s *OuterJoinColumn) Pos() sqltoken.Pos {
+	return s.Column.Pos()
+}
+
+func (s *OuterJoinColumn) End() sqltoken.Pos {
+	return s.RParen
+}
+
+func (s *OuterJoinColumn) ToSQLString() string {
+	return s.Column.ToSQLString() + "(+)"
+}
+
 // ` X IS NULL`
 type IsNull struct {
 	X Node
@@ -167,7 +220,7 @@ func (s *IsNull) End() sqltoken.Pos {
 }
 
 func (s *IsNull) ToSQLString() string {
-	return fmt.Sprintf("%s IS NULl", s.X.ToSQLString())
+	return fmt.Sprintf("%s IS NULL", s.X.ToSQLString())
 }
 
 // `X IS NOT NULL`
@@ -190,6 +243,28 @@ func (s *IsNotNull) ToSQLString() string {
 	return fmt.Sprintf("%s IS NOT NULL", s.X.ToSQLString())
 }
 
+// `X IS [NOT] TRUE`, `X IS [NOT] FALSE` or `X IS [NOT] UNKNOWN`
+type IsTruthValue struct {
+	X       Node
+	Negated bool
+	Value   Node // *BooleanValue, or *NullValue written as UNKNOWN
+}
+
+func (s *IsTruthValue) Pos() sqltoken.Pos {
+	return s.X.Pos()
+}
+
+func (s *IsTruthValue) End() sqltoken.Pos {
+	return s.Value.End()
+}
+
+func (s *IsTruthValue) ToSQLString() string {
+	if s.Negated {
+		return fmt.Sprintf("%s IS NOT %s", s.X.ToSQLString(), s.Value.ToSQLString())
+	}
+	return fmt.Sprintf("%s IS %s", s.X.ToSQLString(), s.Value.ToSQLString())
+}
+
 // `Expr IN (List...)`
 type InList struct {
 	Expr    Node
@@ -230,6 +305,65 @@ func (s *InSubQuery) ToSQLString() string {
 	return fmt.Sprintf("%s %sIN (%s)", s.Expr.ToSQLString(), negatedString(s.Negated), s.SubQuery.ToSQLString())
 }
 
+// `Left Op ALL|ANY|SOME (Right)`
+// Right is a *SubQuery or a *Nested array expression such as ARRAY[1, 2].
+type QuantifiedComparison struct {
+	Left          Node
+	Op            *Operator
+	Quantifier    ComparisonQuantifier
+	QuantifierPos sqltoken.Pos
+	Right         Node
+}
+
+func (s *QuantifiedComparison) Pos() sqltoken.Pos {
+	return s.Left.Pos()
+}
+
+func (s *QuantifiedComparison) End() sqltoken.Pos {
+	return s.Right.End()
+}
+
+func (s *QuantifiedComparison) ToSQLString() string {
+	return fmt.Sprintf("%s %s %s %s", s.Left.ToSQLString(), s.Op.ToSQLString(), s.Quantifier.ToSQLString(), s.Right.ToSQLString())
+}
+
+// ComparisonQuantifier is ALL, ANY or SOME of quantified comparisons.
+type ComparisonQuantifier int
+
+const (
+	QuantifierAll ComparisonQuantifier = iota
+	QuantifierAny
+	QuantifierSome
+)
+
+func (c ComparisonQuantifier) ToSQLString() string {
+	switch c {
+	case QuantifierAny:
+		return "ANY"
+	case QuantifierSome:
+		return "SOME"
+	}
+	return "ALL"
+}
+
+// `(start, end) OVERLAPS (start, end)`
+type OverlapsExpr struct {
+	Left, Right *RowValueExpr
+	Overlaps    sqltoken.Pos
+}
+
+func (o *OverlapsExpr) Pos() sqltoken.Pos {
+	return o.Left.Pos()
+}
+
+func (o *OverlapsExpr) End() sqltoken.Pos {
+	return o.Right.End()
+}
+
+func (o *OverlapsExpr) ToSQLString() string {
+	return fmt.Sprintf("%s OVERLAPS %s", o.Left.ToSQLString(), o.Right.ToSQLString())
+}
+
 // `Expr [ NOT ] BETWEEN [ LOW expr ] AND [ HIGH expr]`
 type Between struct {
 	Expr    Node
@@ -269,6 +403,25 @@ func (s *BinaryExpr) ToSQLString() string {
 	return fmt.Sprintf("%s %s %s", s.Left.ToSQLString(), s.Op.ToSQLString(), s.Right.ToSQLString())
 }
 
+// `Expr AT TIME ZONE Zone`
+type AtTimeZone struct {
+	Expr Node
+	Zone Node
+	At   sqltoken.Pos // first position of AT keyword
+}
+
+func (a *AtTimeZone) Pos() sqltoken.Pos {
+	return a.Expr.Pos()
+}
+
+func (a *AtTimeZone) End() sqltoken.Pos {
+	return a.Zone.End()
+}
+
+func (a *AtTimeZone) ToSQLString() string {
+	return fmt.Sprintf("%s AT TIME ZONE %s", a.Expr.ToSQLString(), a.Zone.ToSQLString())
+}
+
 // `CAST(Expr AS DataType)`
 type Cast struct {
 	Expr     Node
@@ -289,6 +442,132 @@ func (s *Cast) ToSQLString() string {
 	return fmt.Sprintf("CAST(%s AS %s)", s.Expr.ToSQLString(), s.DateType.ToSQLString())
 }
 
+// TRIM([[Spec] [Chars] FROM] Expr)
+type TrimExpr struct {
+	Trim   sqltoken.Pos // first position of TRIM keyword
+	Spec   TrimSpec
+	Chars  Node // characters to be removed, nil if omitted
+	From   bool // FROM keyword is present, always true if Spec or Chars is present
+	Expr   Node
+	RParen sqltoken.Pos
+}
+
+func (s *TrimExpr) Pos() sqltoken.Pos {
+	return s.Trim
+}
+
+func (s *TrimExpr) End() sqltoken.Pos {
+	return s.RParen
+}
+
+func (s *TrimExpr) ToSQLString() string {
+	str := "TRIM("
+	if s.Spec != TrimSpecNone {
+		str += s.Spec.ToSQLString() + " "
+	}
+	if s.Chars != nil {
+		str += s.Chars.ToSQLString() + " "
+	}
+	if s.From || s.Spec != TrimSpecNone || s.Chars != nil {
+		str += "FROM "
+	}
+	return str + s.Expr.ToSQLString() + ")"
+}
+
+type TrimSpec int
+
+const (
+	TrimSpecNone TrimSpec = iota
+	TrimBoth
+	TrimLeading
+	TrimTrailing
+)
+
+func (t TrimSpec) ToSQLString() string {
+	switch t {
+	case TrimBoth:
+		return "BOTH"
+	case TrimLeading:
+		return "LEADING"
+	case TrimTrailing:
+		return "TRAILING"
+	}
+	return ""
+}
+
+// SUBSTRING(Expr [FROM From] [FOR For]), at least one of From and For is not nil
+type SubstringExpr struct {
+	Substring sqltoken.Pos // first position of SUBSTRING keyword
+	Expr      Node
+	From      Node
+	For       Node
+	RParen    sqltoken.Pos
+}
+
+func (s *SubstringExpr) Pos() sqltoken.Pos {
+	return s.Substring
+}
+
+func (s *SubstringExpr) End() sqltoken.Pos {
+	return s.RParen
+}
+
+func (s *SubstringExpr) ToSQLString() string {
+	str := "SUBSTRING(" + s.Expr.ToSQLString()
+	if s.From != nil {
+		str += " FROM " + s.From.ToSQLString()
+	}
+	if s.For != nil {
+		str += " FOR " + s.For.ToSQLString()
+	}
+	return str + ")"
+}
+
+// EXTRACT(Field FROM Expr)
+type ExtractExpr struct {
+	Extract     sqltoken.Pos // first position of EXTRACT keyword
+	Field       *Ident       // field name as written such as YEAR or EPOCH, nil if FieldString is used
+	FieldString string       // field name written as a string literal such as 'dow' (PostgreSQL)
+	Expr        Node
+	RParen      sqltoken.Pos
+}
+
+func (s *ExtractExpr) Pos() sqltoken.Pos {
+	return s.Extract
+}
+
+func (s *ExtractExpr) End() sqltoken.Pos {
+	return s.RParen
+}
+
+func (s *ExtractExpr) ToSQLString() string {
+	if s.Field == nil {
+		field := &SingleQuotedString{String: s.FieldString}
+		return fmt.Sprintf("EXTRACT(%s FROM %s)", field.ToSQLString(), s.Expr.ToSQLString())
+	}
+	return fmt.Sprintf("EXTRACT(%s FROM %s)", s.Field.ToSQLString(), s.Expr.ToSQLString())
+}
+
+// POSITION(Substr IN Expr)
+type PositionExpr struct {
+	Position sqltoken.Pos // first position of POSITION keyword
+	Substr   Node
+	Expr     Node
+	RParen   sqltoken.Pos
+}
+
+func (s *PositionExpr) Pos() sqltoken.Pos {
+	return s.Position
+}
+
+func (s *PositionExpr) End() sqltoken.Pos {
+	return s.RParen
+}
+
+func (s *PositionExpr) ToSQLString() string {
+	return fmt.Sprintf("POSITION(%s IN %s)", s.Substr.ToSQLString(), s.Expr.ToSQLString())
+}
+
 // (AST)
 type Nested struct {
 	AST            Node
@@ -307,6 +586,25 @@ func (s *Nested) ToSQLString() string {
 	return fmt.Sprintf("(%s)", s.AST.ToSQLString())
 }
 
+// ARRAY[Elements...]
+type ArrayConstructor struct {
+	Array    sqltoken.Pos // first position of ARRAY
+	Elements []Node
+	RBracket sqltoken.Pos
+}
+
+func (s *ArrayConstructor) Pos() sqltoken.Pos {
+	return s.Array
+}
+
+func (s *ArrayConstructor) End() sqltoken.Pos {
+	return s.RBracket
+}
+
+func (s *ArrayConstructor) ToSQLString() string {
+	return fmt.Sprintf("ARRAY[%s]", commaSeparatedString(s.Elements))
+}
+
 // Op Expr
 type UnaryExpr struct {
 	From sqltoken.Pos // first position of Op
@@ -323,14 +621,41 @@ func (s *UnaryExpr) End() sqltoken.Pos {
 }
 
 func (s *UnaryExpr) ToSQLString() string {
+	if s.Op.Type != Not && isInfixExpr(s.Expr) {
+		// prefix + - ~ bind tighter than infix operators
+		return fmt.Sprintf("%s (%s)", s.Op.ToSQLString(), s.Expr.ToSQLString())
+	}
 	return fmt.Sprintf("%s %s", s.Op.ToSQLString(), s.Expr.ToSQLString())
 }
 
+// isInfixExpr reports whether node is an operator application which
+// needs parentheses to be the operand of a prefix operator.
+func isInfixExpr(node Node) bool {
+	switch node.(type) {
+	case *BinaryExpr, *IsNull, *IsNotNull, *InList, *InSubQuery, *Between,
+		*AtTimeZone, *OverlapsExpr, *QuantifiedComparison:
+		return true
+	}
+	return false
+}
+
 // Name(Args...) [OVER (Over)]
 type Function struct {
 	Name       *ObjectName // Function Name
+	Quantifier AggregateQuantifier
 	Args       []Node
-	ArgsRParen sqltoken.Pos // function args RParen position
+	OrderBy    []*OrderByExpr      // ORDER BY in the argument list of ordered aggregates
+	Separator  *SingleQuotedString // SEPARATOR in the argument list of GROUP_CONCAT (MySQL)
+	ArgsRParen sqltoken.Pos        // function args RParen position
+
+	// WITHIN GROUP (ORDER BY WithinGroup) of ordered-set aggregates such as LISTAGG
+	WithinGroup       []*OrderByExpr
+	WithinGroupRParen sqltoken.Pos
+
+	// FILTER (WHERE Filter) of aggregates
+	Filter       Node
+	FilterRParen sqltoken.Pos
+
 	Over       *WindowSpec
 	OverRparen sqltoken.Pos // Over RParen position (if Over is not nil)
 }
@@ -340,14 +665,38 @@ func (s *Function) Pos() sqltoken.Pos {
 }
 
 func (s *Function) End() sqltoken.Pos {
-	if s.Over == nil {
-		return s.ArgsRParen
+	if s.Over != nil {
+		return s.OverRparen
+	}
+	if s.Filter != nil {
+		return s.FilterRParen
 	}
-	return s.OverRparen
+	if len(s.WithinGroup) != 0 {
+		return s.WithinGroupRParen
+	}
+	return s.ArgsRParen
 }
 
 func (s *Function) ToSQLString() string {
-	str := fmt.Sprintf("%s(%s)", s.Name.ToSQLString(), commaSeparatedString(s.Args))
+	args := commaSeparatedString(s.Args)
+	if s.Quantifier != AggregateQuantifierNone {
+		args = s.Quantifier.ToSQLString() + " " + args
+	}
+	if len(s.OrderBy) != 0 {
+		args += " ORDER BY " + commaSeparatedString(s.OrderBy)
+	}
+	if s.Separator != nil {
+		args += " SEPARATOR " + s.Separator.ToSQLString()
+	}
+	str := fmt.Sprintf("%s(%s)", s.Name.ToSQLString(), args)
+
+	if len(s.WithinGroup) != 0 {
+		str += fmt.Sprintf(" WITHIN GROUP (ORDER BY %s)", commaSeparatedString(s.WithinGroup))
+	}
+
+	if s.Filter != nil {
+		str += fmt.Sprintf(" FILTER (WHERE %s)", s.Filter.ToSQLString())
+	}
 
 	if s.Over != nil {
 		str += fmt.Sprintf(" OVER (%s)", s.Over.ToSQLString())
@@ -356,6 +705,48 @@ func (s *Function) ToSQLString() string {
 	return str
 }
 
+// AggregateQuantifier is DISTINCT or ALL at the head of aggregate arguments.
+type AggregateQuantifier int
+
+const (
+	AggregateQuantifierNone AggregateQuantifier = iota
+	AggregateDistinct
+	AggregateAll
+)
+
+func (a AggregateQuantifier) ToSQLString() string {
+	switch a {
+	case AggregateDistinct:
+		return "DISTINCT"
+	case AggregateAll:
+		return "ALL"
+	}
+	return ""
+}
+
+// Name => Value, or Name := Value if ColonEq is true.
+// NamedArg appears in Function.Args after positional arguments.
+type NamedArg struct {
+	Name    *Ident
+	ColonEq bool
+	Value   Node
+}
+
+func (s *NamedArg) Pos() sqltoken.Pos {
+	return s.Name.Pos()
+}
+
+func (s *NamedArg) End() sqltoken.Pos {
+	return s.Value.End()
+}
+
+func (s *NamedArg) ToSQLString() string {
+	if s.ColonEq {
+		return fmt.Sprintf("%s := %s", s.Name.ToSQLString(), s.Value.ToSQLString())
+	}
+	return fmt.Sprintf("%s => %s", s.Name.ToSQLString(), s.Value.ToSQLString())
+}
+
 // CASE [Operand] WHEN Conditions... THEN Results... [ELSE ElseResult] END
 type CaseExpr struct {
 	Case       sqltoken.Pos // first position of CASE keyword
@@ -712,10 +1103,22 @@ func commaSeparatedString(list interface{}) string {
 		for _, l := range s {
 			strs = append(strs, l.ToSQLString())
 		}
+	case []*AliasSelectItem:
+		for _, l := range s {
+			strs = append(strs, l.ToSQLString())
+		}
 	case []*OrderByExpr:
 		for _, l := range s {
 			strs = append(strs, l.ToSQLString())
 		}
+	case []*TransactionMode:
+		for _, l := range s {
+			strs = append(strs, l.ToSQLString())
+		}
+	case []JSONTableColumn:
+		for _, l := range s {
+			strs = append(strs, l.ToSQLString())
+		}
 	case []*ColumnDef:
 		for _, l := range s {
 			strs = append(strs, l.ToSQLString())
@@ -732,6 +1135,18 @@ func commaSeparatedString(list interface{}) string {
 		for _, l := range s {
 			strs = append(strs, l.ToSQLString())
 		}
+	case []*ViewOption:
+		for _, l := range s {
+			strs = append(strs, l.ToSQLString())
+		}
+	case []*CopyOption:
+		for _, l := range s {
+			strs = append(strs, l.ToSQLString())
+		}
+	case []*IndexColumn:
+		for _, l := range s {
+			strs = append(strs, l.ToSQLString())
+		}
 	default:
 		log.Fatalf("unexpected type array %+v", list)
 	}
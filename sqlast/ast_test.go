@@ -0,0 +1,63 @@
+package sqlast
+
+import "testing"
+
+func TestUnaryExpr_ToSQLString(t *testing.T) {
+	cases := []struct {
+		name string
+		in   *UnaryExpr
+		out  string
+	}{
+		{
+			name: "minus",
+			in: &UnaryExpr{
+				Op:   &Operator{Type: Minus},
+				Expr: NewIdent("a"),
+			},
+			out: "- a",
+		},
+		{
+			name: "double negation",
+			in: &UnaryExpr{
+				Op: &Operator{Type: Minus},
+				Expr: &UnaryExpr{
+					Op:   &Operator{Type: Minus},
+					Expr: NewIdent("a"),
+				},
+			},
+			out: "- - a",
+		},
+		{
+			name: "minus of binary expression",
+			in: &UnaryExpr{
+				Op: &Operator{Type: Minus},
+				Expr: &BinaryExpr{
+					Left:  NewIdent("a"),
+					Op:    &Operator{Type: Plus},
+					Right: NewIdent("b"),
+				},
+			},
+			out: "- (a + b)",
+		},
+		{
+			name: "not of binary expression",
+			in: &UnaryExpr{
+				Op: &Operator{Type: Not},
+				Expr: &BinaryExpr{
+					Left:  NewIdent("a"),
+					Op:    &Operator{Type: Eq},
+					Right: NewIdent("b"),
+				},
+			},
+			out: "NOT a = b",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if act := c.in.ToSQLString(); act != c.out {
+				t.Errorf("must be %s but %s", c.out, act)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package sqlast
+
+import (
+	"reflect"
+)
+
+// Clone returns a deep copy of node. Every child node and slice of the
+// copy is newly allocated, while values and positions are kept as is,
+// so the copy can be mutated without affecting the original tree.
+func Clone(node Node) Node {
+	if node == nil {
+		return nil
+	}
+	return cloneValue(reflect.ValueOf(node)).Interface().(Node)
+}
+
+func cloneValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(cloneValue(v.Elem()))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(cloneValue(v.Elem()))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(cloneValue(v.Index(i)))
+		}
+		return c
+	case reflect.Struct:
+		// copy the whole struct first so that unexported fields
+		// (e.g. the ones of time.Time) are kept, then replace exported ones.
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if f := c.Field(i); f.CanSet() {
+				f.Set(cloneValue(v.Field(i)))
+			}
+		}
+		return c
+	default:
+		return v
+	}
+}
@@ -0,0 +1,63 @@
+package sqlast
+
+import (
+	"testing"
+
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+func TestClone(t *testing.T) {
+	orig := &QueryStmt{
+		Body: &SQLSelect{
+			Select: sqltoken.NewPos(1, 1),
+			Projection: []SQLSelectItem{
+				&UnnamedSelectItem{
+					Node: NewIdentWithPos("a", sqltoken.NewPos(1, 8), sqltoken.NewPos(1, 9)),
+				},
+			},
+			FromClause: []TableReference{
+				&Table{
+					Name: NewObjectName("t"),
+				},
+			},
+			WhereClause: &BinaryExpr{
+				Left:  NewIdentWithPos("a", sqltoken.NewPos(1, 23), sqltoken.NewPos(1, 24)),
+				Op:    &Operator{Type: Eq, From: sqltoken.NewPos(1, 25), To: sqltoken.NewPos(1, 26)},
+				Right: &LongValue{Long: 1, From: sqltoken.NewPos(1, 27), To: sqltoken.NewPos(1, 28)},
+			},
+		},
+	}
+	const origSQL = "SELECT a FROM t WHERE a = 1"
+
+	cloned, ok := Clone(orig).(*QueryStmt)
+	if !ok {
+		t.Fatalf("expected *QueryStmt but %T", cloned)
+	}
+	if cloned == orig {
+		t.Fatal("should return a new node")
+	}
+	if cloned.ToSQLString() != origSQL {
+		t.Errorf("expected %s but %s", origSQL, cloned.ToSQLString())
+	}
+	if cloned.Pos() != orig.Pos() || cloned.End() != orig.End() {
+		t.Errorf("positions mismatch: %v-%v, %v-%v", orig.Pos(), orig.End(), cloned.Pos(), cloned.End())
+	}
+
+	sel := cloned.Body.(*SQLSelect)
+	where := sel.WhereClause.(*BinaryExpr)
+	where.Op.Type = NotEq
+	where.Right.(*LongValue).Long = 2
+	sel.Projection[0] = &WildcardSelectItem{}
+	sel.FromClause[0].(*Table).Name.Idents[0].Value = "u"
+
+	if orig.ToSQLString() != origSQL {
+		t.Errorf("original should be unchanged but %s", orig.ToSQLString())
+	}
+	if expect := "SELECT * FROM u WHERE a != 2"; cloned.ToSQLString() != expect {
+		t.Errorf("expected %s but %s", expect, cloned.ToSQLString())
+	}
+
+	if Clone(nil) != nil {
+		t.Error("clone of nil should be nil")
+	}
+}
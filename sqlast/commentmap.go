@@ -142,7 +142,7 @@ func NewCommentMap(file *File) CommentMap {
 
 		switch q.(type) {
 		// Stmts
-		case *QueryStmt, *InsertStmt, *UpdateStmt, *DeleteStmt, *CreateViewStmt, *CreateTableStmt, *AlterTableStmt, *DropTableStmt, *CreateIndexStmt, *DropIndexStmt, *ExplainStmt:
+		case *QueryStmt, *InsertStmt, *UpdateStmt, *DeleteStmt, *CreateViewStmt, *CreateTableStmt, *CreateSequenceStmt, *AlterTableStmt, *DropStmt, *CreateIndexStmt, *ExplainStmt, *SetStmt, *ShowStmt, *ResetStmt, *DoStmt, *DeclareCursorStmt, *FetchStmt, *CloseStmt, *CommentStmt, *BeginStmt, *CommitStmt, *RollbackStmt, *SavepointStmt, *ReleaseSavepointStmt:
 			stack.push(q)
 		// table element
 		case *ColumnDef, *TableConstraint:
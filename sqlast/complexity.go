@@ -0,0 +1,88 @@
+package sqlast
+
+import "strings"
+
+// Metrics is a summary of how complex a statement is.
+type Metrics struct {
+	Joins      int // JOIN clauses including CROSS and NATURAL JOIN
+	Subqueries int // queries nested in another statement, including CTEs
+	Aggregates int // calls of aggregate functions
+	MaxDepth   int // maximum nesting level of queries, 1 for a query without subqueries
+}
+
+// aggregateFunctions are the names of well-known aggregate functions.
+var aggregateFunctions = map[string]struct{}{
+	"ANY_VALUE":       {},
+	"ARRAY_AGG":       {},
+	"AVG":             {},
+	"BIT_AND":         {},
+	"BIT_OR":          {},
+	"BOOL_AND":        {},
+	"BOOL_OR":         {},
+	"COUNT":           {},
+	"EVERY":           {},
+	"GROUP_CONCAT":    {},
+	"JSON_AGG":        {},
+	"JSON_ARRAYAGG":   {},
+	"JSON_OBJECTAGG":  {},
+	"LISTAGG":         {},
+	"MAX":             {},
+	"MIN":             {},
+	"MODE":            {},
+	"PERCENTILE_CONT": {},
+	"PERCENTILE_DISC": {},
+	"STDDEV":          {},
+	"STDDEV_POP":      {},
+	"STDDEV_SAMP":     {},
+	"STRING_AGG":      {},
+	"SUM":             {},
+	"VARIANCE":        {},
+	"VAR_POP":         {},
+	"VAR_SAMP":        {},
+}
+
+// IsAggregate reports whether the function is an aggregate function. Functions with
+// syntax only for aggregates such as DISTINCT, FILTER and WITHIN GROUP are
+// aggregates even if their names are unknown.
+func (s *Function) IsAggregate() bool {
+	if s.Quantifier != AggregateQuantifierNone || s.Filter != nil || len(s.WithinGroup) != 0 {
+		return true
+	}
+	name := s.Name.Idents[len(s.Name.Idents)-1].Value
+	_, ok := aggregateFunctions[strings.ToUpper(name)]
+	return ok
+}
+
+// Complexity returns the metrics of node, which can be used to reject
+// too complex queries before executing them.
+func Complexity(node Node) Metrics {
+	var m Metrics
+	Walk(&complexityVisitor{metrics: &m}, node)
+	return m
+}
+
+type complexityVisitor struct {
+	metrics *Metrics
+	depth   int // nesting level of queries
+}
+
+func (v *complexityVisitor) Visit(node Node) Visitor {
+	switch n := node.(type) {
+	case *QueryStmt:
+		if v.depth > 0 {
+			v.metrics.Subqueries++
+		}
+		c := &complexityVisitor{metrics: v.metrics, depth: v.depth + 1}
+		if c.depth > v.metrics.MaxDepth {
+			v.metrics.MaxDepth = c.depth
+		}
+		return c
+	case *QualifiedJoin, *NaturalJoin, *CrossJoin:
+		v.metrics.Joins++
+	case *Function:
+		if n.IsAggregate() {
+			v.metrics.Aggregates++
+		}
+	}
+	return v
+}
@@ -0,0 +1,126 @@
+package sqlast
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+var posType = reflect.TypeOf(sqltoken.Pos{})
+
+// Fdump writes an indented tree of node to w for human reading.
+// Each node is printed with its type and exported fields, positions are
+// printed as Line:Col and other values are printed inline.
+// Nil fields and empty slices are omitted.
+func Fdump(w io.Writer, node Node) error {
+	d := &dumper{w: w}
+	d.dump(reflect.ValueOf(node), 0)
+	d.printf("\n")
+	return d.err
+}
+
+type dumper struct {
+	w   io.Writer
+	err error
+}
+
+func (d *dumper) printf(format string, args ...interface{}) {
+	if d.err != nil {
+		return
+	}
+	_, d.err = fmt.Fprintf(d.w, format, args...)
+}
+
+func (d *dumper) indent(depth int) {
+	d.printf("%s", strings.Repeat(".  ", depth))
+}
+
+func (d *dumper) dump(v reflect.Value, depth int) {
+	switch v.Kind() {
+	case reflect.Invalid:
+		d.printf("nil")
+	case reflect.Interface:
+		if v.IsNil() {
+			d.printf("nil")
+			return
+		}
+		d.dump(v.Elem(), depth)
+	case reflect.Ptr:
+		if v.IsNil() {
+			d.printf("nil")
+			return
+		}
+		if v.Elem().Kind() != reflect.Struct || !isASTType(v.Elem().Type()) {
+			d.printf("&")
+			d.dump(v.Elem(), depth)
+			return
+		}
+		d.printf("*")
+		d.dump(v.Elem(), depth)
+	case reflect.Slice:
+		d.printf("%s (len = %d) {\n", v.Type(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			d.indent(depth + 1)
+			d.printf("%d: ", i)
+			d.dump(v.Index(i), depth+1)
+			d.printf("\n")
+		}
+		d.indent(depth)
+		d.printf("}")
+	case reflect.Struct:
+		if v.Type() == posType {
+			p := v.Interface().(sqltoken.Pos)
+			d.printf("%d:%d", p.Line, p.Col)
+			return
+		}
+		if !isASTType(v.Type()) {
+			d.printf("%v", v.Interface())
+			return
+		}
+		d.printf("%s {\n", v.Type())
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				// unexported fields and markers of node kinds
+				continue
+			}
+			fv := v.Field(i)
+			if isEmpty(fv) {
+				continue
+			}
+			d.indent(depth + 1)
+			d.printf("%s: ", f.Name)
+			d.dump(fv, depth+1)
+			d.printf("\n")
+		}
+		d.indent(depth)
+		d.printf("}")
+	case reflect.String:
+		d.printf("%q", v.String())
+	default:
+		// enums such as FetchUnit are printed with their SQL representation
+		if s, ok := v.Interface().(interface{ ToSQLString() string }); ok {
+			d.printf("%v (%q)", v.Interface(), s.ToSQLString())
+			return
+		}
+		d.printf("%v", v.Interface())
+	}
+}
+
+func isASTType(t reflect.Type) bool {
+	return t.PkgPath() == reflect.TypeOf(Ident{}).PkgPath()
+}
+
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	case reflect.Slice:
+		return v.Len() == 0
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package sqlast_test
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	"github.com/andreyvit/diff"
+
+	"github.com/akito0107/xsqlparser"
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestFdump(t *testing.T) {
+	src := `SELECT u.id, count(*) AS cnt
+FROM users AS u
+LEFT JOIN orders AS o ON o.user_id = u.id
+WHERE u.name LIKE 'a%' AND o.amount IS NOT NULL
+GROUP BY u.id
+ORDER BY cnt DESC
+LIMIT 10`
+
+	parser, err := xsqlparser.NewParser(bytes.NewBufferString(src), &dialect.GenericSQLDialect{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt, err := parser.ParseStatement()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := sqlast.Fdump(&buf, stmt); err != nil {
+		t.Fatal(err)
+	}
+
+	golden := "testdata/dump.golden"
+	if *update {
+		if err := ioutil.WriteFile(golden, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expect, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(expect) != buf.String() {
+		t.Errorf("diff: %s", diff.LineDiff(string(expect), buf.String()))
+	}
+}
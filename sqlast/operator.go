@@ -36,6 +36,17 @@ const (
 	Not
 	Like
 	NotLike
+	BitwiseNot
+	StringConcat
+	BitwiseAnd
+	BitwiseOr
+	BitwiseXor
+	ShiftLeft
+	ShiftRight
+	RegexpMatch
+	RegexpIMatch
+	NotRegexpMatch
+	NotRegexpIMatch
 	None
 )
 
@@ -66,13 +77,35 @@ func (o *Operator) ToSQLString() string {
 	case And:
 		return "AND"
 	case Or:
-		return "Or"
+		return "OR"
 	case Not:
 		return "NOT"
 	case Like:
 		return "LIKE"
 	case NotLike:
 		return "NOT LIKE"
+	case BitwiseNot:
+		return "~"
+	case StringConcat:
+		return "||"
+	case BitwiseAnd:
+		return "&"
+	case BitwiseOr:
+		return "|"
+	case BitwiseXor:
+		return "^"
+	case ShiftLeft:
+		return "<<"
+	case ShiftRight:
+		return ">>"
+	case RegexpMatch:
+		return "~"
+	case RegexpIMatch:
+		return "~*"
+	case NotRegexpMatch:
+		return "!~"
+	case NotRegexpIMatch:
+		return "!~*"
 	}
 	return ""
 }
@@ -0,0 +1,128 @@
+package sqlast
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+// PlaceholderStyle is a way to write placeholders of prepared statements.
+type PlaceholderStyle int
+
+const (
+	QuestionPlaceholder PlaceholderStyle = iota // ?
+	DollarPlaceholder                           // $1, $2, ...
+	NamedPlaceholder                            // :name
+)
+
+// Style returns the style in which the placeholder is written.
+func (s *Placeholder) Style() PlaceholderStyle {
+	switch {
+	case strings.HasPrefix(s.Value, "$"):
+		return DollarPlaceholder
+	case strings.HasPrefix(s.Value, ":"):
+		return NamedPlaceholder
+	}
+	return QuestionPlaceholder
+}
+
+// Placeholders returns all placeholders in node in the order of their positions.
+func Placeholders(node Node) []*Placeholder {
+	var placeholders []*Placeholder
+	Inspect(node, func(node Node) bool {
+		if p, ok := node.(*Placeholder); ok {
+			placeholders = append(placeholders, p)
+		}
+		return true
+	})
+	sort.SliceStable(placeholders, func(i, j int) bool {
+		return sqltoken.ComparePos(placeholders[i].From, placeholders[j].From) < 0
+	})
+	return placeholders
+}
+
+// ConvertPlaceholders returns a copy of node whose placeholders are written in style.
+// `?` are numbered in the order of positions, and placeholders with the same name
+// get the same number at their first appearance. Numbers of `$N` are kept, and
+// the new numbers are the ones following the largest of them. When converted to
+// NamedPlaceholder, `$N` becomes `:pN` and `?` gets a name in the same way.
+// The names already in use are never reused, so `$N` gets a new number if `:pN`
+// is written in node as well.
+// Converting to QuestionPlaceholder drops names and numbers, so the arguments
+// must be reordered by the caller if `$N` are not in order.
+func ConvertPlaceholders(node Node, style PlaceholderStyle) Node {
+	c := Clone(node)
+	placeholders := Placeholders(c)
+
+	if style == QuestionPlaceholder {
+		for _, p := range placeholders {
+			p.Value = "?"
+		}
+		return c
+	}
+
+	var last int
+	for _, p := range placeholders {
+		if p.Style() != DollarPlaceholder {
+			continue
+		}
+		if n, err := strconv.Atoi(p.Value[1:]); err == nil && n > last {
+			last = n
+		}
+	}
+
+	names := make(map[string]int)
+	next := func() int {
+		last++
+		// skip the names already used when converted to NamedPlaceholder
+		for style == NamedPlaceholder {
+			if _, ok := names[":p"+strconv.Itoa(last)]; !ok {
+				break
+			}
+			last++
+		}
+		return last
+	}
+	for _, p := range placeholders {
+		if p.Style() == NamedPlaceholder {
+			names[p.Value] = 0
+		}
+	}
+
+	// renumbered are the new numbers of `$N` whose `:pN` is already used
+	renumbered := make(map[int]int)
+	for _, p := range placeholders {
+		var n int
+		switch p.Style() {
+		case DollarPlaceholder:
+			n, _ = strconv.Atoi(p.Value[1:])
+			if _, ok := names[":p"+strconv.Itoa(n)]; ok && style == NamedPlaceholder {
+				m, ok := renumbered[n]
+				if !ok {
+					m = next()
+					renumbered[n] = m
+				}
+				n = m
+			}
+		case NamedPlaceholder:
+			if style == NamedPlaceholder {
+				continue
+			}
+			if n = names[p.Value]; n == 0 {
+				n = next()
+				names[p.Value] = n
+			}
+		default:
+			n = next()
+		}
+
+		if style == DollarPlaceholder {
+			p.Value = "$" + strconv.Itoa(n)
+		} else {
+			p.Value = ":p" + strconv.Itoa(n)
+		}
+	}
+	return c
+}
@@ -16,6 +16,9 @@ type QueryStmt struct {
 	Body    SQLSetExpr
 	OrderBy []*OrderByExpr
 	Limit   *LimitExpr
+	Offset  *OffsetExpr
+	Fetch   *FetchExpr
+	Locks   []*LockingClause
 }
 
 func (q *QueryStmt) Pos() sqltoken.Pos {
@@ -27,6 +30,18 @@ func (q *QueryStmt) Pos() sqltoken.Pos {
 }
 
 func (q *QueryStmt) End() sqltoken.Pos {
+	if len(q.Locks) != 0 {
+		return q.Locks[len(q.Locks)-1].End()
+	}
+
+	if q.Fetch != nil {
+		return q.Fetch.End()
+	}
+
+	if q.Offset != nil {
+		return q.Offset.End()
+	}
+
 	if q.Limit != nil {
 		return q.Limit.End()
 	}
@@ -60,6 +75,18 @@ func (q *QueryStmt) ToSQLString() string {
 		query += " " + q.Limit.ToSQLString()
 	}
 
+	if q.Offset != nil {
+		query += " " + q.Offset.ToSQLString()
+	}
+
+	if q.Fetch != nil {
+		query += " " + q.Fetch.ToSQLString()
+	}
+
+	for _, l := range q.Locks {
+		query += " " + l.ToSQLString()
+	}
+
 	return query
 }
 
@@ -67,6 +94,7 @@ func (q *QueryStmt) ToSQLString() string {
 type CTE struct {
 	Alias  *Ident
 	Query  *QueryStmt
+	Stmt   Stmt // INSERT, UPDATE or DELETE of data-modifying CTE (PostgreSQL), nil if Query is not nil
 	RParen sqltoken.Pos
 }
 
@@ -79,6 +107,9 @@ func (c *CTE) End() sqltoken.Pos {
 }
 
 func (c *CTE) ToSQLString() string {
+	if c.Query == nil {
+		return fmt.Sprintf("%s AS (%s)", c.Alias.ToSQLString(), c.Stmt.ToSQLString())
+	}
 	return fmt.Sprintf("%s AS (%s)", c.Alias.ToSQLString(), c.Query.ToSQLString())
 }
 
@@ -121,6 +152,25 @@ func (q *QueryExpr) ToSQLString() string {
 	return fmt.Sprintf("(%s)", q.Query.ToSQLString())
 }
 
+// TABLE Name, the shorthand of SELECT * FROM Name (PostgreSQL)
+type TableQueryExpr struct {
+	sqlSetExpr
+	Table sqltoken.Pos // first position of TABLE keyword
+	Name  *ObjectName
+}
+
+func (t *TableQueryExpr) Pos() sqltoken.Pos {
+	return t.Table
+}
+
+func (t *TableQueryExpr) End() sqltoken.Pos {
+	return t.Name.End()
+}
+
+func (t *TableQueryExpr) ToSQLString() string {
+	return fmt.Sprintf("TABLE %s", t.Name.ToSQLString())
+}
+
 type SetOperationExpr struct {
 	sqlSetExpr
 	Op    SQLSetOperator
@@ -201,7 +251,9 @@ func (IntersectOperator) ToSQLString() string {
 type SQLSelect struct {
 	sqlSetExpr
 	Distinct      bool
+	DistinctOn    []Node // expressions of DISTINCT ON ( ... ), Distinct must be true
 	Projection    []SQLSelectItem
+	Into          *SelectInto // SELECT ... INTO of PostgreSQL
 	FromClause    []TableReference
 	WhereClause   Node
 	GroupByClause []Node
@@ -230,16 +282,26 @@ func (s *SQLSelect) End() sqltoken.Pos {
 		return s.FromClause[len(s.FromClause)-1].End()
 	}
 
+	if s.Into != nil {
+		return s.Into.End()
+	}
+
 	return s.Projection[len(s.Projection)-1].End()
 }
 
 func (s *SQLSelect) ToSQLString() string {
 	q := "SELECT "
-	if s.Distinct {
+	if len(s.DistinctOn) != 0 {
+		q += fmt.Sprintf("DISTINCT ON (%s) ", commaSeparatedString(s.DistinctOn))
+	} else if s.Distinct {
 		q += "DISTINCT "
 	}
 	q += commaSeparatedString(s.Projection)
 
+	if s.Into != nil {
+		q += " " + s.Into.ToSQLString()
+	}
+
 	if len(s.FromClause) != 0 {
 		q += fmt.Sprintf(" FROM %s", commaSeparatedString(s.FromClause))
 	}
@@ -259,6 +321,38 @@ func (s *SQLSelect) ToSQLString() string {
 	return q
 }
 
+// INTO [ TEMPORARY | UNLOGGED ] [ TABLE ] Name
+// TEMP is same as TEMPORARY.
+type SelectInto struct {
+	Into      sqltoken.Pos
+	Temporary bool
+	Unlogged  bool
+	Table     bool
+	Name      *ObjectName
+}
+
+func (s *SelectInto) Pos() sqltoken.Pos {
+	return s.Into
+}
+
+func (s *SelectInto) End() sqltoken.Pos {
+	return s.Name.End()
+}
+
+func (s *SelectInto) ToSQLString() string {
+	str := "INTO "
+	if s.Temporary {
+		str += "TEMPORARY "
+	}
+	if s.Unlogged {
+		str += "UNLOGGED "
+	}
+	if s.Table {
+		str += "TABLE "
+	}
+	return str + s.Name.ToSQLString()
+}
+
 //go:generate genmark -t TableReference -e Node
 
 //go:generate genmark -t TableFactor -e TableReference
@@ -267,15 +361,30 @@ func (s *SQLSelect) ToSQLString() string {
 type Table struct {
 	tableFactor
 	tableReference
+	Only            bool
+	OnlyPos         sqltoken.Pos // first position of ONLY keyword if Only is true
 	Name            *ObjectName
+	Descendants     bool         // trailing `*` of PostgreSQL inheritance
+	DescendantsPos  sqltoken.Pos // last position of `*` if Descendants is true
 	Alias           *Ident
+	ImplicitAlias   bool         // Alias is written without AS keyword
+	AliasColumns    []*Ident     // column aliases of Alias(a, b)
+	ColumnDefs      []*ColumnDef // column definitions of Alias(a int, b text) or AS (a int, b text) of functions
+	AliasRParen     sqltoken.Pos // last position of `)` of AliasColumns or ColumnDefs
 	Args            []Node
-	ArgsRParen      sqltoken.Pos
+	ArgsRParen      sqltoken.Pos // set for a function even if Args is empty
+	WithOrdinality  bool         // WITH ORDINALITY after Args
+	OrdinalityEnd   sqltoken.Pos // last position of ORDINALITY if WithOrdinality is true
 	WithHints       []Node
 	WithHintsRParen sqltoken.Pos
+	Sample          *TableSample
+	IndexHints      []*IndexHint // MySQL only
 }
 
 func (t *Table) Pos() sqltoken.Pos {
+	if t.Only {
+		return t.OnlyPos
+	}
 	return t.Name.Pos()
 }
 
@@ -284,24 +393,67 @@ func (t *Table) End() sqltoken.Pos {
 		return t.WithHintsRParen
 	}
 
+	if t.Sample != nil {
+		return t.Sample.End()
+	}
+
+	if len(t.IndexHints) != 0 {
+		return t.IndexHints[len(t.IndexHints)-1].End()
+	}
+
+	if len(t.AliasColumns) != 0 || len(t.ColumnDefs) != 0 {
+		return t.AliasRParen
+	}
+
 	if t.Alias != nil {
 		return t.Alias.End()
 	}
 
-	if len(t.Args) != 0 {
+	if t.WithOrdinality {
+		return t.OrdinalityEnd
+	}
+
+	if t.isFunction() {
 		return t.ArgsRParen
 	}
 
+	if t.Descendants {
+		return t.DescendantsPos
+	}
+
 	return t.Name.End()
 }
 
 func (t *Table) ToSQLString() string {
 	s := t.Name.ToSQLString()
-	if len(t.Args) != 0 {
+	if t.Only {
+		s = "ONLY " + s
+	}
+	if t.Descendants {
+		s += " *"
+	}
+	if t.isFunction() {
 		s = fmt.Sprintf("%s(%s)", s, commaSeparatedString(t.Args))
 	}
+	if t.WithOrdinality {
+		s += " WITH ORDINALITY"
+	}
 	if t.Alias != nil {
-		s = fmt.Sprintf("%s AS %s", s, t.Alias.ToSQLString())
+		s = fmt.Sprintf("%s %s", s, aliasString(t.Alias, t.ImplicitAlias))
+	} else if len(t.ColumnDefs) != 0 {
+		s += " AS "
+	}
+	if len(t.AliasColumns) != 0 {
+		s = fmt.Sprintf("%s(%s)", s, commaSeparatedString(t.AliasColumns))
+	}
+	if len(t.ColumnDefs) != 0 {
+		s = fmt.Sprintf("%s(%s)", s, commaSeparatedString(t.ColumnDefs))
+	}
+	for _, h := range t.IndexHints {
+		s = fmt.Sprintf("%s %s", s, h.ToSQLString())
+	}
+	if t.Sample != nil {
+		s = fmt.Sprintf("%s %s", s, t.Sample.ToSQLString())
 	}
 	if len(t.WithHints) != 0 {
 		s = fmt.Sprintf("%s WITH (%s)", s, commaSeparatedString(t.WithHints))
@@ -309,15 +461,120 @@ func (t *Table) ToSQLString() string {
 	return s
 }
 
+// isFunction reports whether t is a function call such as generate_series(1, 10).
+func (t *Table) isFunction() bool {
+	return len(t.Args) != 0 || t.ArgsRParen != (sqltoken.Pos{})
+}
+
+// TABLESAMPLE Method ( Args... ) [ REPEATABLE ( Seed ) ]
+type TableSample struct {
+	TableSample sqltoken.Pos // first position of TABLESAMPLE keyword
+	Method      *Ident
+	Args        []Node
+	RParen      sqltoken.Pos
+	Seed        Node         // argument of REPEATABLE, nil if omitted
+	SeedRParen  sqltoken.Pos // position of ')' of REPEATABLE if Seed is not nil
+}
+
+func (t *TableSample) Pos() sqltoken.Pos {
+	return t.TableSample
+}
+
+func (t *TableSample) End() sqltoken.Pos {
+	if t.Seed != nil {
+		return t.SeedRParen
+	}
+	return t.RParen
+}
+
+func (t *TableSample) ToSQLString() string {
+	s := fmt.Sprintf("TABLESAMPLE %s(%s)", t.Method.ToSQLString(), commaSeparatedString(t.Args))
+	if t.Seed != nil {
+		s += fmt.Sprintf(" REPEATABLE (%s)", t.Seed.ToSQLString())
+	}
+	return s
+}
+
+// { USE | IGNORE | FORCE } { INDEX | KEY } [ FOR { JOIN | ORDER BY | GROUP BY } ] ( Indexes... )
+type IndexHint struct {
+	Type    IndexHintType
+	TypePos sqltoken.Pos // first position of USE, IGNORE or FORCE keyword
+	Key     bool         // KEY is written instead of INDEX
+	For     IndexHintFor
+	Indexes []*Ident // empty for `USE INDEX ()`
+	RParen  sqltoken.Pos
+}
+
+func (h *IndexHint) Pos() sqltoken.Pos {
+	return h.TypePos
+}
+
+func (h *IndexHint) End() sqltoken.Pos {
+	return h.RParen
+}
+
+func (h *IndexHint) ToSQLString() string {
+	s := h.Type.ToSQLString()
+	if h.Key {
+		s += " KEY"
+	} else {
+		s += " INDEX"
+	}
+	if h.For != IndexHintForNone {
+		s += " FOR " + h.For.ToSQLString()
+	}
+	return fmt.Sprintf("%s (%s)", s, commaSeparatedString(h.Indexes))
+}
+
+type IndexHintType int
+
+const (
+	UseIndex IndexHintType = iota
+	IgnoreIndex
+	ForceIndex
+)
+
+func (t IndexHintType) ToSQLString() string {
+	switch t {
+	case IgnoreIndex:
+		return "IGNORE"
+	case ForceIndex:
+		return "FORCE"
+	}
+	return "USE"
+}
+
+type IndexHintFor int
+
+const (
+	IndexHintForNone IndexHintFor = iota
+	IndexHintForJoin
+	IndexHintForOrderBy
+	IndexHintForGroupBy
+)
+
+func (f IndexHintFor) ToSQLString() string {
+	switch f {
+	case IndexHintForJoin:
+		return "JOIN"
+	case IndexHintForOrderBy:
+		return "ORDER BY"
+	case IndexHintForGroupBy:
+		return "GROUP BY"
+	}
+	return ""
+}
+
 type Derived struct {
 	tableFactor
 	tableReference
-	Lateral    bool
-	LateralPos sqltoken.Pos // last position of LATERAL keyword if Lateral is true
-	LParen     sqltoken.Pos
-	RParen     sqltoken.Pos
-	SubQuery   *QueryStmt
-	Alias      *Ident
+	Lateral       bool
+	LateralPos    sqltoken.Pos // last position of LATERAL keyword if Lateral is true
+	LParen        sqltoken.Pos
+	RParen        sqltoken.Pos
+	SubQuery      *QueryStmt
+	Alias         *Ident
+	ImplicitAlias bool // Alias is written without AS keyword
 }
 
 func (d *Derived) Pos() sqltoken.Pos {
@@ -344,11 +601,172 @@ func (d *Derived) ToSQLString() string {
 
 	s := fmt.Sprintf("%s(%s)", lateralStr, d.SubQuery.ToSQLString())
 	if d.Alias != nil {
-		s = fmt.Sprintf("%s AS %s", s, d.Alias.ToSQLString())
+		s = fmt.Sprintf("%s %s", s, aliasString(d.Alias, d.ImplicitAlias))
+	}
+	return s
+}
+
+// JSON_TABLE(Expr, Path COLUMNS (Columns...)) [AS] Alias
+type JSONTable struct {
+	tableFactor
+	tableReference
+	JSONTable     sqltoken.Pos // first position of JSON_TABLE
+	Expr          Node
+	Path          *SingleQuotedString
+	Columns       []JSONTableColumn
+	RParen        sqltoken.Pos
+	Alias         *Ident
+	ImplicitAlias bool // Alias is written without AS keyword
+}
+
+func (j *JSONTable) Pos() sqltoken.Pos {
+	return j.JSONTable
+}
+
+func (j *JSONTable) End() sqltoken.Pos {
+	if j.Alias != nil {
+		return j.Alias.End()
+	}
+	return j.RParen
+}
+
+func (j *JSONTable) ToSQLString() string {
+	s := fmt.Sprintf("JSON_TABLE(%s, %s COLUMNS (%s))", j.Expr.ToSQLString(), j.Path.ToSQLString(), commaSeparatedString(j.Columns))
+	if j.Alias != nil {
+		s = fmt.Sprintf("%s %s", s, aliasString(j.Alias, j.ImplicitAlias))
 	}
 	return s
 }
 
+//go:generate genmark -t JSONTableColumn -e Node -o json_table_column
+
+// Name FOR ORDINALITY
+type JSONTableOrdinalityColumn struct {
+	jSONTableColumn
+	Name *Ident
+	To   sqltoken.Pos // last position of ORDINALITY keyword
+}
+
+func (j *JSONTableOrdinalityColumn) Pos() sqltoken.Pos {
+	return j.Name.Pos()
+}
+
+func (j *JSONTableOrdinalityColumn) End() sqltoken.Pos {
+	return j.To
+}
+
+func (j *JSONTableOrdinalityColumn) ToSQLString() string {
+	return j.Name.ToSQLString() + " FOR ORDINALITY"
+}
+
+// Name DataType [ EXISTS ] PATH Path [ OnEmpty ] [ OnError ]
+type JSONTablePathColumn struct {
+	jSONTableColumn
+	Name     *Ident
+	DataType Type
+	Exists   bool
+	Path     *SingleQuotedString
+	OnEmpty  *JSONBehavior
+	OnError  *JSONBehavior
+}
+
+func (j *JSONTablePathColumn) Pos() sqltoken.Pos {
+	return j.Name.Pos()
+}
+
+func (j *JSONTablePathColumn) End() sqltoken.Pos {
+	if j.OnError != nil {
+		return j.OnError.End()
+	}
+	if j.OnEmpty != nil {
+		return j.OnEmpty.End()
+	}
+	return j.Path.End()
+}
+
+func (j *JSONTablePathColumn) ToSQLString() string {
+	s := fmt.Sprintf("%s %s ", j.Name.ToSQLString(), j.DataType.ToSQLString())
+	if j.Exists {
+		s += "EXISTS "
+	}
+	s += "PATH " + j.Path.ToSQLString()
+	if j.OnEmpty != nil {
+		s += " " + j.OnEmpty.ToSQLString()
+	}
+	if j.OnError != nil {
+		s += " " + j.OnError.ToSQLString()
+	}
+	return s
+}
+
+// NESTED [ PATH ] Path COLUMNS (Columns...)
+type JSONTableNestedColumn struct {
+	jSONTableColumn
+	Nested      sqltoken.Pos
+	PathKeyword bool // PATH is written before Path
+	Path        *SingleQuotedString
+	Columns     []JSONTableColumn
+	RParen      sqltoken.Pos
+}
+
+func (j *JSONTableNestedColumn) Pos() sqltoken.Pos {
+	return j.Nested
+}
+
+func (j *JSONTableNestedColumn) End() sqltoken.Pos {
+	return j.RParen
+}
+
+func (j *JSONTableNestedColumn) ToSQLString() string {
+	s := "NESTED "
+	if j.PathKeyword {
+		s += "PATH "
+	}
+	return fmt.Sprintf("%s%s COLUMNS (%s)", s, j.Path.ToSQLString(), commaSeparatedString(j.Columns))
+}
+
+// JSONBehaviorKind is the kind of behaviors of ON EMPTY and ON ERROR.
+type JSONBehaviorKind int
+
+const (
+	JSONBehaviorNull JSONBehaviorKind = iota
+	JSONBehaviorError
+	JSONBehaviorDefault
+)
+
+// { NULL | ERROR | DEFAULT Default } ON { EMPTY | ERROR }
+type JSONBehavior struct {
+	Kind    JSONBehaviorKind
+	Default Node // used if Kind is JSONBehaviorDefault
+	OnError bool // ON ERROR, ON EMPTY otherwise
+	From    sqltoken.Pos
+	To      sqltoken.Pos // last position of EMPTY or ERROR keyword
+}
+
+func (j *JSONBehavior) Pos() sqltoken.Pos {
+	return j.From
+}
+
+func (j *JSONBehavior) End() sqltoken.Pos {
+	return j.To
+}
+
+func (j *JSONBehavior) ToSQLString() string {
+	var s string
+	switch j.Kind {
+	case JSONBehaviorNull:
+		s = "NULL"
+	case JSONBehaviorError:
+		s = "ERROR"
+	case JSONBehaviorDefault:
+		s = "DEFAULT " + j.Default.ToSQLString()
+	}
+	if j.OnError {
+		return s + " ON ERROR"
+	}
+	return s + " ON EMPTY"
+}
+
 //go:generate genmark -t SQLSelectItem -e Node
 
 type UnnamedSelectItem struct {
@@ -370,8 +788,9 @@ func (u *UnnamedSelectItem) ToSQLString() string {
 
 type AliasSelectItem struct {
 	sqlSelectItem
-	Expr  Node
-	Alias *Ident
+	Expr          Node
+	Alias         *Ident
+	ImplicitAlias bool // Alias is written without AS keyword
 }
 
 func (a *AliasSelectItem) Pos() sqltoken.Pos {
@@ -383,13 +802,15 @@ func (a *AliasSelectItem) End() sqltoken.Pos {
 }
 
 func (a *AliasSelectItem) ToSQLString() string {
-	return fmt.Sprintf("%s AS %s", a.Expr.ToSQLString(), a.Alias.ToSQLString())
+	return fmt.Sprintf("%s %s", a.Expr.ToSQLString(), aliasString(a.Alias, a.ImplicitAlias))
 }
 
 // schema.*
 type QualifiedWildcardSelectItem struct {
 	sqlSelectItem
-	Prefix *ObjectName
+	Prefix  *ObjectName
+	Exclude *ExcludeColumns // optional
+	Replace *ReplaceColumns // optional
 }
 
 func (q *QualifiedWildcardSelectItem) Pos() sqltoken.Pos {
@@ -397,19 +818,24 @@ func (q *QualifiedWildcardSelectItem) Pos() sqltoken.Pos {
 }
 
 func (q *QualifiedWildcardSelectItem) End() sqltoken.Pos {
-	return sqltoken.Pos{
+	return wildcardModifiersEnd(sqltoken.Pos{
 		Line: q.Prefix.End().Line,
 		Col:  q.Prefix.End().Col + 2,
-	}
+	}, q.Exclude, q.Replace)
 }
 
 func (q *QualifiedWildcardSelectItem) ToSQLString() string {
-	return fmt.Sprintf("%s.*", q.Prefix.ToSQLString())
+	return fmt.Sprintf("%s.*", q.Prefix.ToSQLString()) + wildcardModifiersString(q.Exclude, q.Replace)
 }
 
+// *
+// Plain `*` is parsed as UnnamedSelectItem of Wildcard, and WildcardSelectItem
+// is used only if it has EXCLUDE or REPLACE modifiers.
 type WildcardSelectItem struct {
 	sqlSelectItem
 	From, To sqltoken.Pos
+	Exclude  *ExcludeColumns // optional
+	Replace  *ReplaceColumns // optional
 }
 
 func (w *WildcardSelectItem) Pos() sqltoken.Pos {
@@ -417,11 +843,70 @@ func (w *WildcardSelectItem) Pos() sqltoken.Pos {
 }
 
 func (w *WildcardSelectItem) End() sqltoken.Pos {
-	return w.To
+	return wildcardModifiersEnd(w.To, w.Exclude, w.Replace)
 }
 
 func (w *WildcardSelectItem) ToSQLString() string {
-	return "*"
+	return "*" + wildcardModifiersString(w.Exclude, w.Replace)
+}
+
+// EXCLUDE (col, ...) modifier of wildcard
+type ExcludeColumns struct {
+	Exclude sqltoken.Pos
+	Columns []*Ident
+	RParen  sqltoken.Pos
+}
+
+func (e *ExcludeColumns) Pos() sqltoken.Pos {
+	return e.Exclude
+}
+
+func (e *ExcludeColumns) End() sqltoken.Pos {
+	return e.RParen
+}
+
+func (e *ExcludeColumns) ToSQLString() string {
+	return fmt.Sprintf("EXCLUDE (%s)", commaSeparatedString(e.Columns))
+}
+
+// REPLACE (expr AS col, ...) modifier of wildcard
+type ReplaceColumns struct {
+	Replace sqltoken.Pos
+	Items   []*AliasSelectItem
+	RParen  sqltoken.Pos
+}
+
+func (r *ReplaceColumns) Pos() sqltoken.Pos {
+	return r.Replace
+}
+
+func (r *ReplaceColumns) End() sqltoken.Pos {
+	return r.RParen
+}
+
+func (r *ReplaceColumns) ToSQLString() string {
+	return fmt.Sprintf("REPLACE (%s)", commaSeparatedString(r.Items))
+}
+
+func wildcardModifiersEnd(end sqltoken.Pos, exclude *ExcludeColumns, replace *ReplaceColumns) sqltoken.Pos {
+	if replace != nil {
+		return replace.End()
+	}
+	if exclude != nil {
+		return exclude.End()
+	}
+	return end
+}
+
+func wildcardModifiersString(exclude *ExcludeColumns, replace *ReplaceColumns) string {
+	var str string
+	if exclude != nil {
+		str += " " + exclude.ToSQLString()
+	}
+	if replace != nil {
+		str += " " + replace.ToSQLString()
+	}
+	return str
 }
 
 type CrossJoin struct {
@@ -639,12 +1124,14 @@ func (o *OrderByExpr) ToSQLString() string {
 }
 
 // LIMIT [ALL | LimitValue ] [ OFFSET OffsetValue]
+// or LIMIT OffsetValue, LimitValue (MySQL)
 type LimitExpr struct {
 	All         bool
 	AllPos      sqltoken.Pos // ALL keyword position if All is true
 	Limit       sqltoken.Pos // Limit keyword position
 	LimitValue  *LongValue
 	OffsetValue *LongValue
+	OffsetComma bool // OffsetValue precedes LimitValue with a comma
 }
 
 func (l *LimitExpr) Pos() sqltoken.Pos {
@@ -656,13 +1143,17 @@ func (l *LimitExpr) End() sqltoken.Pos {
 		return l.AllPos
 	}
 
-	if l.OffsetValue != nil {
+	if l.OffsetValue != nil && !l.OffsetComma {
 		return l.OffsetValue.To
 	}
 	return l.LimitValue.To
 }
 
 func (l *LimitExpr) ToSQLString() string {
+	if l.OffsetComma {
+		return fmt.Sprintf("LIMIT %s, %s", l.OffsetValue.ToSQLString(), l.LimitValue.ToSQLString())
+	}
+
 	str := "LIMIT"
 	if l.All {
 		str += " ALL"
@@ -676,3 +1167,156 @@ func (l *LimitExpr) ToSQLString() string {
 
 	return str
 }
+
+// OFFSET Value [ ROW | ROWS ]
+type OffsetExpr struct {
+	Offset sqltoken.Pos // first position of OFFSET keyword
+	Value  Node
+	Unit   FetchUnit
+	To     sqltoken.Pos // last position of the clause
+}
+
+func (o *OffsetExpr) Pos() sqltoken.Pos {
+	return o.Offset
+}
+
+func (o *OffsetExpr) End() sqltoken.Pos {
+	return o.To
+}
+
+func (o *OffsetExpr) ToSQLString() string {
+	str := "OFFSET " + o.Value.ToSQLString()
+	if o.Unit != FetchUnitNone {
+		str += " " + o.Unit.ToSQLString()
+	}
+	return str
+}
+
+// FETCH { FIRST | NEXT } [ Quantity [ PERCENT ] ] { ROW | ROWS } { ONLY | WITH TIES }
+type FetchExpr struct {
+	Fetch    sqltoken.Pos // first position of FETCH keyword
+	Next     bool         // NEXT is used instead of FIRST
+	Quantity Node         // nil if omitted
+	Percent  bool         // Quantity is a percentage of rows
+	Unit     FetchUnit
+	WithTies bool         // WITH TIES is used instead of ONLY
+	To       sqltoken.Pos // last position of the clause
+}
+
+func (f *FetchExpr) Pos() sqltoken.Pos {
+	return f.Fetch
+}
+
+func (f *FetchExpr) End() sqltoken.Pos {
+	return f.To
+}
+
+func (f *FetchExpr) ToSQLString() string {
+	str := "FETCH FIRST"
+	if f.Next {
+		str = "FETCH NEXT"
+	}
+	if f.Quantity != nil {
+		str += " " + f.Quantity.ToSQLString()
+		if f.Percent {
+			str += " PERCENT"
+		}
+	}
+	str += " " + f.Unit.ToSQLString()
+	if f.WithTies {
+		return str + " WITH TIES"
+	}
+	return str + " ONLY"
+}
+
+type FetchUnit int
+
+const (
+	FetchUnitNone FetchUnit = iota
+	FetchUnitRow
+	FetchUnitRows
+)
+
+func (f FetchUnit) ToSQLString() string {
+	switch f {
+	case FetchUnitRow:
+		return "ROW"
+	case FetchUnitRows:
+		return "ROWS"
+	}
+	return ""
+}
+
+// FOR { UPDATE | NO KEY UPDATE | SHARE | KEY SHARE } [ OF Tables... ] [ NOWAIT | SKIP LOCKED ]
+type LockingClause struct {
+	Strength   LockingStrength
+	Tables     []*ObjectName
+	WaitPolicy LockingWaitPolicy
+	For        sqltoken.Pos // first position of FOR keyword
+	To         sqltoken.Pos // last position of the clause
+}
+
+func (l *LockingClause) Pos() sqltoken.Pos {
+	return l.For
+}
+
+func (l *LockingClause) End() sqltoken.Pos {
+	return l.To
+}
+
+func (l *LockingClause) ToSQLString() string {
+	str := "FOR " + l.Strength.ToSQLString()
+
+	if len(l.Tables) != 0 {
+		str += fmt.Sprintf(" OF %s", commaSeparatedString(l.Tables))
+	}
+
+	switch l.WaitPolicy {
+	case NoWait:
+		str += " NOWAIT"
+	case SkipLocked:
+		str += " SKIP LOCKED"
+	}
+
+	return str
+}
+
+type LockingStrength int
+
+const (
+	ForUpdate LockingStrength = iota
+	ForNoKeyUpdate
+	ForShare
+	ForKeyShare
+)
+
+func (l LockingStrength) ToSQLString() string {
+	switch l {
+	case ForUpdate:
+		return "UPDATE"
+	case ForNoKeyUpdate:
+		return "NO KEY UPDATE"
+	case ForShare:
+		return "SHARE"
+	case ForKeyShare:
+		return "KEY SHARE"
+	default:
+		log.Fatalf("unknown locking strength %d", l)
+	}
+	return ""
+}
+
+type LockingWaitPolicy int
+
+const (
+	DefaultWait LockingWaitPolicy = iota
+	NoWait
+	SkipLocked
+)
+
+func aliasString(alias *Ident, implicit bool) string {
+	if implicit {
+		return alias.ToSQLString()
+	}
+	return "AS " + alias.ToSQLString()
+}
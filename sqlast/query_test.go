@@ -184,6 +184,30 @@ func TestSQLSelect_ToSQLString(t *testing.T) {
 			},
 			out: "SELECT COUNT(customer_id), country FROM customers GROUP BY country HAVING COUNT(customer_id) > 3",
 		},
+		{
+			name: "implicit alias",
+			in: &SQLSelect{
+				Projection: []SQLSelectItem{
+					&AliasSelectItem{
+						Expr:          NewIdent("a"),
+						Alias:         NewIdent("x"),
+						ImplicitAlias: true,
+					},
+					&AliasSelectItem{
+						Expr:  NewIdent("b"),
+						Alias: NewIdent("y"),
+					},
+				},
+				FromClause: []TableReference{
+					&Table{
+						Name:          NewObjectName("t"),
+						Alias:         NewIdent("s"),
+						ImplicitAlias: true,
+					},
+				},
+			},
+			out: "SELECT a x, b AS y FROM t s",
+		},
 	}
 
 	for _, c := range cases {
@@ -428,6 +452,34 @@ func TestSQLQuery_ToSQLString(t *testing.T) {
 			out: "SELECT CASE WHEN expr1 = '1' THEN 'test1' WHEN expr2 = '2' THEN 'test2' ELSE 'other' END AS alias " +
 				"FROM user WHERE id BETWEEN 1 AND 2",
 		},
+		{
+			name: "locking clauses",
+			in: &QueryStmt{
+				Body: &SQLSelect{
+					Projection: []SQLSelectItem{
+						&UnnamedSelectItem{Node: NewIdent("id")},
+					},
+					FromClause: []TableReference{
+						&Table{
+							Name: NewObjectName("jobs"),
+						},
+					},
+				},
+				Limit: &LimitExpr{LimitValue: NewLongValue(10)},
+				Locks: []*LockingClause{
+					{
+						Strength:   ForUpdate,
+						Tables:     []*ObjectName{NewObjectName("jobs")},
+						WaitPolicy: SkipLocked,
+					},
+					{
+						Strength:   ForKeyShare,
+						WaitPolicy: NoWait,
+					},
+				},
+			},
+			out: "SELECT id FROM jobs LIMIT 10 FOR UPDATE OF jobs SKIP LOCKED FOR KEY SHARE NOWAIT",
+		},
 	}
 
 	for _, c := range cases {
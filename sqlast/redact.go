@@ -0,0 +1,81 @@
+package sqlast
+
+import (
+	"reflect"
+)
+
+var placeholderType = reflect.TypeOf(&Placeholder{})
+
+// Redact returns a copy of node in which every string, number, date/time and
+// placeholder literal is replaced with the `?` placeholder, so the result of
+// ToSQLString is a parameterized skeleton of the query which is safe to log.
+// Keywords, identifiers, booleans and NULL are kept as they are.
+// Integers and strings held in fields of a concrete literal type, such as the
+// count of LIMIT or the path of JSON_TABLE, are marked as Redacted instead.
+func Redact(node Node) Node {
+	if node == nil {
+		return nil
+	}
+	if isRedactedLiteral(node) {
+		return redactedPlaceholder(node)
+	}
+	c := Clone(node)
+	redactValue(reflect.ValueOf(c))
+	return c
+}
+
+func isRedactedLiteral(node Node) bool {
+	switch node.(type) {
+	case *LongValue, *DoubleValue, *SingleQuotedString, *DollarQuotedString, *NationalStringLiteral,
+		*DateValue, *TimeValue, *DateTimeValue, *TimestampValue, *TypedLiteral, *Placeholder:
+		return true
+	}
+	return false
+}
+
+func redactedPlaceholder(node Node) *Placeholder {
+	return &Placeholder{
+		Value: "?",
+		From:  node.Pos(),
+		To:    node.End(),
+	}
+}
+
+func redactValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			redactValue(v.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			redactField(v.Index(i))
+		}
+	case reflect.Struct:
+		switch n := v.Addr().Interface().(type) {
+		case *LongValue:
+			n.Redacted = true
+			return
+		case *SingleQuotedString:
+			n.Redacted = true
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Field(i); f.CanSet() {
+				redactField(f)
+			}
+		}
+	}
+}
+
+// redactField replaces the literal in f if f is an interface which can hold
+// the placeholder, or redacts the children of f otherwise.
+func redactField(f reflect.Value) {
+	if f.Kind() == reflect.Interface && !f.IsNil() && placeholderType.Implements(f.Type()) {
+		if n, ok := f.Interface().(Node); ok && isRedactedLiteral(n) {
+			f.Set(reflect.ValueOf(redactedPlaceholder(n)))
+			return
+		}
+	}
+	redactValue(f)
+}
@@ -0,0 +1,99 @@
+package sqlast
+
+import (
+	"strings"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+// RenameIdent replaces every identifier under node whose name is from with to.
+// Names are compared without quotes, and case-insensitively unless matchCase is true,
+// in which case they are compared after folded by the rules of d.
+// Only the last part of qualified names such as `t.col` and `schema.table` is renamed.
+// The new name keeps the quote style of the old one, and is quoted with the quote of d
+// if it is a reserved keyword or not a plain identifier. The name in quotes is folded
+// by d as if to was written without quotes, so that it is the same identifier.
+// Positions of renamed identifiers are kept as they are in the source.
+func RenameIdent(node Node, from, to string, matchCase bool, d dialect.Dialect) {
+	target := d.NormalizeIdent(from, false)
+	rename := func(ident *Ident) {
+		quote, name := splitQuote(ident.Value)
+		if matchCase && d.NormalizeIdent(name, quote != 0) != target {
+			return
+		}
+		if !matchCase && !strings.EqualFold(name, from) {
+			return
+		}
+		ident.Value = quoteIdent(to, quote, d)
+	}
+
+	Inspect(node, func(node Node) bool {
+		switch n := node.(type) {
+		case *Ident:
+			rename(n)
+		case *CompoundIdent:
+			rename(n.Idents[len(n.Idents)-1])
+			return false
+		case *ObjectName:
+			rename(n.Idents[len(n.Idents)-1])
+			return false
+		}
+		return true
+	})
+}
+
+// splitQuote returns the opening quote and the name of a quoted identifier.
+// The quote is 0 if the identifier is not quoted.
+func splitQuote(value string) (rune, string) {
+	if len(value) < 2 {
+		return 0, value
+	}
+	switch value[0] {
+	case '"', '`', '[':
+		end := closingQuote(rune(value[0]))
+		name := value[1 : len(value)-1]
+		return rune(value[0]), strings.ReplaceAll(name, string(end)+string(end), string(end))
+	}
+	return 0, value
+}
+
+func quoteIdent(name string, quote rune, d dialect.Dialect) string {
+	if quote == 0 {
+		if isPlainIdent(name, d) && !d.IsReservedKeyword(name) {
+			return name
+		}
+		quote = delimiter(d)
+	}
+	name = d.NormalizeIdent(name, false)
+	end := string(closingQuote(quote))
+	return string(quote) + strings.ReplaceAll(name, end, end+end) + end
+}
+
+func isPlainIdent(name string, d dialect.Dialect) bool {
+	for i, r := range name {
+		if i == 0 && !d.IsIdentifierStart(r) {
+			return false
+		}
+		if i != 0 && !d.IsIdentifierPart(r) {
+			return false
+		}
+	}
+	return name != ""
+}
+
+// delimiter returns the quote of delimited identifiers of d.
+func delimiter(d dialect.Dialect) rune {
+	for _, q := range []rune{'"', '`', '['} {
+		if d.IsDelimitedIdentifierStart(q) {
+			return q
+		}
+	}
+	return '"'
+}
+
+func closingQuote(quote rune) rune {
+	if quote == '[' {
+		return ']'
+	}
+	return quote
+}
@@ -14,10 +14,13 @@ import (
 type InsertStmt struct {
 	stmt
 	Insert            sqltoken.Pos // first position of INSERT keyword
+	ImplicitInto      bool         // written without INTO keyword (MySQL)
 	TableName         *ObjectName
 	Columns           []*Ident
 	Source            InsertSource  // Insert Source [SubQuery or Constructor]
 	UpdateAssignments []*Assignment // MySQL only (ON DUPLICATED KEYS)
+	Returning         []SQLSelectItem
+	ReturningInto     *ReturningInto // PL/pgSQL and PL/SQL only
 }
 
 func (i *InsertStmt) Pos() sqltoken.Pos {
@@ -25,6 +28,13 @@ func (i *InsertStmt) Pos() sqltoken.Pos {
 }
 
 func (i *InsertStmt) End() sqltoken.Pos {
+	if i.ReturningInto != nil {
+		return i.ReturningInto.End()
+	}
+	if len(i.Returning) != 0 {
+		return i.Returning[len(i.Returning)-1].End()
+	}
+
 	if len(i.UpdateAssignments) != 0 {
 		return i.UpdateAssignments[len(i.UpdateAssignments)-1].End()
 	}
@@ -33,7 +43,11 @@ func (i *InsertStmt) End() sqltoken.Pos {
 }
 
 func (i *InsertStmt) ToSQLString() string {
-	str := fmt.Sprintf("INSERT INTO %s ", i.TableName.ToSQLString())
+	str := "INSERT INTO "
+	if i.ImplicitInto {
+		str = "INSERT "
+	}
+	str += i.TableName.ToSQLString() + " "
 	if len(i.Columns) != 0 {
 		str += fmt.Sprintf("(%s) ", commaSeparatedString(i.Columns))
 	}
@@ -44,6 +58,8 @@ func (i *InsertStmt) ToSQLString() string {
 		str += " ON DUPLICATE KEY UPDATE " + commaSeparatedString(i.UpdateAssignments)
 	}
 
+	str += returningString(i.Returning, i.ReturningInto)
+
 	return str
 }
 
@@ -94,6 +110,25 @@ func (c *ConstructorSource) ToSQLString() string {
 	return str
 }
 
+// DEFAULT VALUES
+type DefaultValuesSource struct {
+	insertSource
+	Default sqltoken.Pos // first position of DEFAULT keyword
+	Values  sqltoken.Pos // last position of VALUES keyword
+}
+
+func (d *DefaultValuesSource) Pos() sqltoken.Pos {
+	return d.Default
+}
+
+func (d *DefaultValuesSource) End() sqltoken.Pos {
+	return d.Values
+}
+
+func (d *DefaultValuesSource) ToSQLString() string {
+	return "DEFAULT VALUES"
+}
+
 type RowValueExpr struct {
 	Values         []Node
 	LParen, RParen sqltoken.Pos
@@ -111,53 +146,89 @@ func (r *RowValueExpr) ToSQLString() string {
 	return fmt.Sprintf("(%s)", commaSeparatedString(r.Values))
 }
 
-// TODO Remove CopyStmt
+// COPY { TableName [ ( Columns ) ] | ( Query ) } { FROM | TO } { STDIN | STDOUT | 'filename' }
+// [ [ WITH ] ( Options ) ]
+// Inline data following `FROM STDIN` is not a part of the statement.
 type CopyStmt struct {
 	stmt
 	Copy      sqltoken.Pos
-	TableName *ObjectName
+	TableName *ObjectName // nil if Query is not nil
 	Columns   []*Ident
-	Values    []*string
+	Query     *QueryStmt
+	To        bool // direction is TO if true, FROM otherwise
+	Target    Node // *Ident of STDIN or STDOUT, or *SingleQuotedString of the file name
+	Options   []*CopyOption
+	RParen    sqltoken.Pos // position of ')' of Options if Options is not blank
 }
 
 func (c *CopyStmt) Pos() sqltoken.Pos {
 	return c.Copy
 }
 
-// TODO
 func (c *CopyStmt) End() sqltoken.Pos {
-	panic("not implemented")
+	if len(c.Options) != 0 {
+		return c.RParen
+	}
+	return c.Target.End()
 }
 
 func (c *CopyStmt) ToSQLString() string {
-	str := fmt.Sprintf("COPY %s", c.TableName.ToSQLString())
-	if len(c.Columns) != 0 {
-		str += fmt.Sprintf(" (%s)", commaSeparatedString(c.Columns))
+	str := "COPY "
+	if c.Query != nil {
+		str += fmt.Sprintf("(%s)", c.Query.ToSQLString())
+	} else {
+		str += c.TableName.ToSQLString()
+		if len(c.Columns) != 0 {
+			str += fmt.Sprintf(" (%s)", commaSeparatedString(c.Columns))
+		}
 	}
-	str += " FROM stdin; "
 
-	if len(c.Values) != 0 {
-		var valuestrs []string
-		for _, v := range c.Values {
-			if v == nil {
-				valuestrs = append(valuestrs, "\\N")
-			} else {
-				valuestrs = append(valuestrs, *v)
-			}
-		}
-		str += fmt.Sprintf("\n%s", strings.Join(valuestrs, "\t"))
+	if c.To {
+		str += " TO "
+	} else {
+		str += " FROM "
+	}
+	str += c.Target.ToSQLString()
+
+	if len(c.Options) != 0 {
+		str += fmt.Sprintf(" WITH (%s)", commaSeparatedString(c.Options))
 	}
-	str += "\n\\."
 
 	return str
 }
 
+// Name [ Value ] in the option list of COPY
+type CopyOption struct {
+	Name  *Ident
+	Value Node // nil if omitted
+}
+
+func (c *CopyOption) Pos() sqltoken.Pos {
+	return c.Name.Pos()
+}
+
+func (c *CopyOption) End() sqltoken.Pos {
+	if c.Value != nil {
+		return c.Value.End()
+	}
+	return c.Name.End()
+}
+
+func (c *CopyOption) ToSQLString() string {
+	if c.Value != nil {
+		return fmt.Sprintf("%s %s", c.Name.ToSQLString(), c.Value.ToSQLString())
+	}
+	return c.Name.ToSQLString()
+}
+
 type UpdateStmt struct {
 	stmt
-	Update      sqltoken.Pos
-	TableName   *ObjectName
-	Assignments []*Assignment
-	Selection   Node
+	Update        sqltoken.Pos
+	TableName     *ObjectName
+	Assignments   []*Assignment
+	Selection     Node
+	Returning     []SQLSelectItem
+	ReturningInto *ReturningInto // PL/pgSQL and PL/SQL only
 }
 
 func (u *UpdateStmt) Pos() sqltoken.Pos {
@@ -165,6 +236,13 @@ func (u *UpdateStmt) Pos() sqltoken.Pos {
 }
 
 func (u *UpdateStmt) End() sqltoken.Pos {
+	if u.ReturningInto != nil {
+		return u.ReturningInto.End()
+	}
+	if len(u.Returning) != 0 {
+		return u.Returning[len(u.Returning)-1].End()
+	}
+
 	if u.Selection != nil {
 		return u.Selection.End()
 	}
@@ -180,15 +258,19 @@ func (u *UpdateStmt) ToSQLString() string {
 	if u.Selection != nil {
 		str += fmt.Sprintf(" WHERE %s", u.Selection.ToSQLString())
 	}
+	str += returningString(u.Returning, u.ReturningInto)
 
 	return str
 }
 
 type DeleteStmt struct {
 	stmt
-	Delete    sqltoken.Pos
-	TableName *ObjectName
-	Selection Node
+	Delete        sqltoken.Pos
+	TableName     *ObjectName
+	Using         []TableReference // USING from_item, ...
+	Selection     Node
+	Returning     []SQLSelectItem
+	ReturningInto *ReturningInto // PL/pgSQL and PL/SQL only
 }
 
 func (d *DeleteStmt) Pos() sqltoken.Pos {
@@ -196,29 +278,99 @@ func (d *DeleteStmt) Pos() sqltoken.Pos {
 }
 
 func (d *DeleteStmt) End() sqltoken.Pos {
+	if d.ReturningInto != nil {
+		return d.ReturningInto.End()
+	}
+	if len(d.Returning) != 0 {
+		return d.Returning[len(d.Returning)-1].End()
+	}
+
 	if d.Selection != nil {
 		return d.Selection.End()
 	}
 
+	if len(d.Using) != 0 {
+		return d.Using[len(d.Using)-1].End()
+	}
+
 	return d.TableName.End()
 }
 
 func (d *DeleteStmt) ToSQLString() string {
 	str := fmt.Sprintf("DELETE FROM %s", d.TableName.ToSQLString())
 
+	if len(d.Using) != 0 {
+		str += fmt.Sprintf(" USING %s", commaSeparatedString(d.Using))
+	}
 	if d.Selection != nil {
 		str += fmt.Sprintf(" WHERE %s", d.Selection.ToSQLString())
 	}
+	str += returningString(d.Returning, d.ReturningInto)
 
 	return str
 }
 
+// CURRENT OF Cursor, the condition of positioned UPDATE and DELETE (PostgreSQL)
+type CurrentOf struct {
+	Current sqltoken.Pos // first position of CURRENT keyword
+	Cursor  *Ident
+}
+
+func (c *CurrentOf) Pos() sqltoken.Pos {
+	return c.Current
+}
+
+func (c *CurrentOf) End() sqltoken.Pos {
+	return c.Cursor.End()
+}
+
+func (c *CurrentOf) ToSQLString() string {
+	return fmt.Sprintf("CURRENT OF %s", c.Cursor.ToSQLString())
+}
+
+// returningString returns RETURNING clause of INSERT, UPDATE and DELETE with a leading space.
+func returningString(items []SQLSelectItem, into *ReturningInto) string {
+	if len(items) == 0 {
+		return ""
+	}
+	str := " RETURNING " + commaSeparatedString(items)
+	if into != nil {
+		str += " " + into.ToSQLString()
+	}
+	return str
+}
+
+// INTO Targets after RETURNING, which assigns the returned values to variables
+type ReturningInto struct {
+	Into    sqltoken.Pos
+	Strict  bool   // INTO STRICT (PL/pgSQL)
+	Targets []Node // *Ident, *CompoundIdent, or *Placeholder for bind variables
+}
+
+func (r *ReturningInto) Pos() sqltoken.Pos {
+	return r.Into
+}
+
+func (r *ReturningInto) End() sqltoken.Pos {
+	return r.Targets[len(r.Targets)-1].End()
+}
+
+func (r *ReturningInto) ToSQLString() string {
+	if r.Strict {
+		return "INTO STRICT " + commaSeparatedString(r.Targets)
+	}
+	return "INTO " + commaSeparatedString(r.Targets)
+}
+
 type CreateViewStmt struct {
 	stmt
-	Create       sqltoken.Pos
-	Name         *ObjectName
-	Query        *QueryStmt
-	Materialized bool
+	Create         sqltoken.Pos
+	Name           *ObjectName
+	Options        []*ViewOption // WITH ( Options ) before AS (PostgreSQL)
+	Query          *QueryStmt
+	Materialized   bool
+	CheckOption    ViewCheckOption
+	CheckOptionEnd sqltoken.Pos // last position of OPTION if CheckOption is specified
 }
 
 func (c *CreateViewStmt) Pos() sqltoken.Pos {
@@ -226,6 +378,9 @@ func (c *CreateViewStmt) Pos() sqltoken.Pos {
 }
 
 func (c *CreateViewStmt) End() sqltoken.Pos {
+	if c.CheckOption != ViewCheckOptionNone {
+		return c.CheckOptionEnd
+	}
 	return c.Query.End()
 }
 
@@ -234,7 +389,60 @@ func (c *CreateViewStmt) ToSQLString() string {
 	if c.Materialized {
 		modifier = " MATERIALIZED"
 	}
-	return fmt.Sprintf("CREATE%s VIEW %s AS %s", modifier, c.Name.ToSQLString(), c.Query.ToSQLString())
+	var options string
+	if len(c.Options) != 0 {
+		options = fmt.Sprintf(" WITH (%s)", commaSeparatedString(c.Options))
+	}
+	str := fmt.Sprintf("CREATE%s VIEW %s%s AS %s", modifier, c.Name.ToSQLString(), options, c.Query.ToSQLString())
+	if c.CheckOption != ViewCheckOptionNone {
+		str += " " + c.CheckOption.ToSQLString()
+	}
+	return str
+}
+
+// Name [ = Value ] in WITH ( ... ) of CREATE VIEW, e.g. security_barrier = true
+type ViewOption struct {
+	Name  *Ident
+	Value Node // nil if omitted
+}
+
+func (v *ViewOption) Pos() sqltoken.Pos {
+	return v.Name.Pos()
+}
+
+func (v *ViewOption) End() sqltoken.Pos {
+	if v.Value != nil {
+		return v.Value.End()
+	}
+	return v.Name.End()
+}
+
+func (v *ViewOption) ToSQLString() string {
+	if v.Value != nil {
+		return fmt.Sprintf("%s = %s", v.Name.ToSQLString(), v.Value.ToSQLString())
+	}
+	return v.Name.ToSQLString()
+}
+
+type ViewCheckOption int
+
+const (
+	ViewCheckOptionNone ViewCheckOption = iota
+	ViewCheckOptionDefault
+	ViewCheckOptionCascaded
+	ViewCheckOptionLocal
+)
+
+func (v ViewCheckOption) ToSQLString() string {
+	switch v {
+	case ViewCheckOptionDefault:
+		return "WITH CHECK OPTION"
+	case ViewCheckOptionCascaded:
+		return "WITH CASCADED CHECK OPTION"
+	case ViewCheckOptionLocal:
+		return "WITH LOCAL CHECK OPTION"
+	}
+	return ""
 }
 
 type CreateTableStmt struct {
@@ -245,6 +453,12 @@ type CreateTableStmt struct {
 	Location  *string
 	NotExists bool
 	Options   []TableOption
+	// CREATE TABLE Name [ ( Columns ) ] AS Query [ WITH [ NO ] DATA ]
+	// Elements is empty if Query is not nil.
+	Columns  []*Ident
+	Query    *QueryStmt
+	WithData *bool        // nil if WITH [ NO ] DATA is omitted
+	DataEnd  sqltoken.Pos // end position of DATA if WithData is not nil
 }
 
 func (c *CreateTableStmt) Pos() sqltoken.Pos {
@@ -252,6 +466,12 @@ func (c *CreateTableStmt) Pos() sqltoken.Pos {
 }
 
 func (c *CreateTableStmt) End() sqltoken.Pos {
+	if c.Query != nil {
+		if c.WithData != nil {
+			return c.DataEnd
+		}
+		return c.Query.End()
+	}
 	return c.Elements[len(c.Elements)-1].End()
 }
 
@@ -260,6 +480,21 @@ func (c *CreateTableStmt) ToSQLString() string {
 	if c.NotExists {
 		ifNotExists = "IF NOT EXISTS "
 	}
+	if c.Query != nil {
+		sql := fmt.Sprintf("CREATE TABLE %s%s", ifNotExists, c.Name.ToSQLString())
+		if len(c.Columns) != 0 {
+			sql += fmt.Sprintf(" (%s)", commaSeparatedString(c.Columns))
+		}
+		sql += " AS " + c.Query.ToSQLString()
+		if c.WithData != nil {
+			if *c.WithData {
+				sql += " WITH DATA"
+			} else {
+				sql += " WITH NO DATA"
+			}
+		}
+		return sql
+	}
 	sql := fmt.Sprintf("CREATE TABLE %s%s (%s)", ifNotExists, c.Name.ToSQLString(), commaSeparatedString(c.Elements))
 
 	if len(c.Options) != 0 {
@@ -407,6 +642,8 @@ type ColumnDef struct {
 	Name                 *Ident
 	DataType             Type
 	Default              Node
+	Charset              *ColumnCharset         // CHARACTER SET of the column (MySQL)
+	Collation            *ColumnCollation       // COLLATE of the column
 	MyDataTypeDecoration []MyDataTypeDecoration // DataType Decoration for MySQL eg. AUTO_INCREMENT currently, only supports AUTO_INCREMENT
 	Constraints          []*ColumnConstraint
 }
@@ -416,11 +653,39 @@ func (c *ColumnDef) Pos() sqltoken.Pos {
 }
 
 func (c *ColumnDef) End() sqltoken.Pos {
-	return c.Constraints[len(c.Constraints)-1].End()
+	// attributes after the data type may be written in any order
+	end := c.DataType.End()
+	last := func(n Node) {
+		if sqltoken.ComparePos(n.End(), end) > 0 {
+			end = n.End()
+		}
+	}
+	if len(c.Constraints) != 0 {
+		last(c.Constraints[len(c.Constraints)-1])
+	}
+	if len(c.MyDataTypeDecoration) != 0 {
+		last(c.MyDataTypeDecoration[len(c.MyDataTypeDecoration)-1])
+	}
+	if c.Default != nil {
+		last(c.Default)
+	}
+	if c.Collation != nil {
+		last(c.Collation)
+	}
+	if c.Charset != nil {
+		last(c.Charset)
+	}
+	return end
 }
 
 func (c *ColumnDef) ToSQLString() string {
 	str := fmt.Sprintf("%s %s", c.Name.ToSQLString(), c.DataType.ToSQLString())
+	if c.Charset != nil {
+		str += " " + c.Charset.ToSQLString()
+	}
+	if c.Collation != nil {
+		str += " " + c.Collation.ToSQLString()
+	}
 	if c.Default != nil {
 		str += fmt.Sprintf(" DEFAULT %s", c.Default.ToSQLString())
 	}
@@ -455,6 +720,46 @@ func (a *AutoIncrement) End() sqltoken.Pos {
 	return a.Increment
 }
 
+// `CHARACTER SET Name` or `CHARSET Name`
+type ColumnCharset struct {
+	Character sqltoken.Pos // first position of CHARACTER or CHARSET
+	Short     bool         // CHARSET
+	Name      *Ident
+}
+
+func (c *ColumnCharset) Pos() sqltoken.Pos {
+	return c.Character
+}
+
+func (c *ColumnCharset) End() sqltoken.Pos {
+	return c.Name.End()
+}
+
+func (c *ColumnCharset) ToSQLString() string {
+	if c.Short {
+		return "CHARSET " + c.Name.ToSQLString()
+	}
+	return "CHARACTER SET " + c.Name.ToSQLString()
+}
+
+// `COLLATE Name`
+type ColumnCollation struct {
+	Collate sqltoken.Pos
+	Name    *Ident
+}
+
+func (c *ColumnCollation) Pos() sqltoken.Pos {
+	return c.Collate
+}
+
+func (c *ColumnCollation) End() sqltoken.Pos {
+	return c.Name.End()
+}
+
+func (c *ColumnCollation) ToSQLString() string {
+	return "COLLATE " + c.Name.ToSQLString()
+}
+
 type ColumnConstraint struct {
 	Name       *Ident
 	Constraint sqltoken.Pos
@@ -532,11 +837,17 @@ func (u *UniqueColumnSpec) ToSQLString() string {
 	}
 }
 
+// REFERENCES TableName [ ( Columns ) ] [ MATCH { FULL | PARTIAL | SIMPLE } ]
+// [ ON DELETE action ] [ ON UPDATE action ]
 type ReferencesColumnSpec struct {
 	References sqltoken.Pos
-	RParen     sqltoken.Pos
+	RParen     sqltoken.Pos // position of ')' if Columns is not blank
 	TableName  *ObjectName
 	Columns    []*Ident
+	Match      ReferenceMatch
+	OnDelete   ReferentialAction
+	OnUpdate   ReferentialAction
+	To         sqltoken.Pos // last position of the spec
 }
 
 func (r *ReferencesColumnSpec) Pos() sqltoken.Pos {
@@ -544,11 +855,72 @@ func (r *ReferencesColumnSpec) Pos() sqltoken.Pos {
 }
 
 func (r *ReferencesColumnSpec) End() sqltoken.Pos {
-	return r.RParen
+	return r.To
 }
 
 func (r *ReferencesColumnSpec) ToSQLString() string {
-	return fmt.Sprintf("REFERENCES %s(%s)", r.TableName.ToSQLString(), commaSeparatedString(r.Columns))
+	str := "REFERENCES " + r.TableName.ToSQLString()
+	if len(r.Columns) != 0 {
+		str += fmt.Sprintf("(%s)", commaSeparatedString(r.Columns))
+	}
+	if r.Match != MatchNone {
+		str += " MATCH " + r.Match.ToSQLString()
+	}
+	if r.OnDelete != NoReferentialAction {
+		str += " ON DELETE " + r.OnDelete.ToSQLString()
+	}
+	if r.OnUpdate != NoReferentialAction {
+		str += " ON UPDATE " + r.OnUpdate.ToSQLString()
+	}
+	return str
+}
+
+type ReferenceMatch int
+
+const (
+	MatchNone ReferenceMatch = iota
+	MatchFull
+	MatchPartial
+	MatchSimple
+)
+
+func (r ReferenceMatch) ToSQLString() string {
+	switch r {
+	case MatchFull:
+		return "FULL"
+	case MatchPartial:
+		return "PARTIAL"
+	case MatchSimple:
+		return "SIMPLE"
+	}
+	return ""
+}
+
+type ReferentialAction int
+
+const (
+	NoReferentialAction ReferentialAction = iota
+	ReferentialNoAction
+	ReferentialRestrict
+	ReferentialCascade
+	ReferentialSetNull
+	ReferentialSetDefault
+)
+
+func (r ReferentialAction) ToSQLString() string {
+	switch r {
+	case ReferentialNoAction:
+		return "NO ACTION"
+	case ReferentialRestrict:
+		return "RESTRICT"
+	case ReferentialCascade:
+		return "CASCADE"
+	case ReferentialSetNull:
+		return "SET NULL"
+	case ReferentialSetDefault:
+		return "SET DEFAULT"
+	}
+	return ""
 }
 
 type CheckColumnSpec struct {
@@ -569,6 +941,80 @@ func (c *CheckColumnSpec) ToSQLString() string {
 	return fmt.Sprintf("CHECK(%s)", c.Expr.ToSQLString())
 }
 
+// GENERATED ALWAYS AS (Expr) [ STORED | VIRTUAL ]
+type GeneratedColumnSpec struct {
+	Generated sqltoken.Pos
+	Expr      Node
+	RParen    sqltoken.Pos
+	Storage   GeneratedStorage
+	To        sqltoken.Pos // last position of the spec
+}
+
+func (g *GeneratedColumnSpec) Pos() sqltoken.Pos {
+	return g.Generated
+}
+
+func (g *GeneratedColumnSpec) End() sqltoken.Pos {
+	return g.To
+}
+
+func (g *GeneratedColumnSpec) ToSQLString() string {
+	str := fmt.Sprintf("GENERATED ALWAYS AS (%s)", g.Expr.ToSQLString())
+	if g.Storage != GeneratedStorageNone {
+		str += " " + g.Storage.ToSQLString()
+	}
+	return str
+}
+
+type GeneratedStorage int
+
+const (
+	GeneratedStorageNone GeneratedStorage = iota
+	GeneratedStored
+	GeneratedVirtual
+)
+
+func (g GeneratedStorage) ToSQLString() string {
+	switch g {
+	case GeneratedStored:
+		return "STORED"
+	case GeneratedVirtual:
+		return "VIRTUAL"
+	}
+	return ""
+}
+
+// GENERATED { ALWAYS | BY DEFAULT } AS IDENTITY [ ( Options ) ]
+type IdentityColumnSpec struct {
+	Generated sqltoken.Pos
+	ByDefault bool
+	Options   []*SequenceOption
+	To        sqltoken.Pos // last position of IDENTITY or ')'
+}
+
+func (i *IdentityColumnSpec) Pos() sqltoken.Pos {
+	return i.Generated
+}
+
+func (i *IdentityColumnSpec) End() sqltoken.Pos {
+	return i.To
+}
+
+func (i *IdentityColumnSpec) ToSQLString() string {
+	str := "GENERATED ALWAYS AS IDENTITY"
+	if i.ByDefault {
+		str = "GENERATED BY DEFAULT AS IDENTITY"
+	}
+	if len(i.Options) != 0 {
+		opts := make([]string, 0, len(i.Options))
+		for _, o := range i.Options {
+			opts = append(opts, o.ToSQLString())
+		}
+		str += fmt.Sprintf(" (%s)", strings.Join(opts, " "))
+	}
+	return str
+}
+
 //TODO remove
 type FileFormat int
 
@@ -726,6 +1172,7 @@ type PGAlterDataTypeColumnAction struct {
 	alterColumnAction
 	Type     sqltoken.Pos
 	DataType Type
+	Using    Node // optional conversion expression
 }
 
 func (p *PGAlterDataTypeColumnAction) Pos() sqltoken.Pos {
@@ -733,10 +1180,16 @@ func (p *PGAlterDataTypeColumnAction) Pos() sqltoken.Pos {
 }
 
 func (p *PGAlterDataTypeColumnAction) End() sqltoken.Pos {
+	if p.Using != nil {
+		return p.Using.End()
+	}
 	return p.DataType.End()
 }
 
 func (p *PGAlterDataTypeColumnAction) ToSQLString() string {
+	if p.Using != nil {
+		return fmt.Sprintf("TYPE %s USING %s", p.DataType.ToSQLString(), p.Using.ToSQLString())
+	}
 	return fmt.Sprintf("TYPE %s", p.DataType.ToSQLString())
 }
 
@@ -847,123 +1300,1010 @@ func (d *DropConstraintTableAction) ToSQLString() string {
 	return fmt.Sprintf("DROP CONSTRAINT %s%s", d.Name.ToSQLString(), cascade)
 }
 
-type DropTableStmt struct {
-	stmt
-	TableNames []*ObjectName
-	Cascade    bool
-	CascadePos sqltoken.Pos
-	IfExists   bool
-	Drop       sqltoken.Pos
+// postgres only
+type PGAttachPartitionTableAction struct {
+	alterTableAction
+	Attach sqltoken.Pos
+	Name   *ObjectName
+	Bound  *PartitionBound
 }
 
-func (d *DropTableStmt) Pos() sqltoken.Pos {
-	return d.Drop
+func (p *PGAttachPartitionTableAction) Pos() sqltoken.Pos {
+	return p.Attach
 }
 
-func (d *DropTableStmt) End() sqltoken.Pos {
-	if d.Cascade {
-		return d.CascadePos
-	}
-
-	return d.TableNames[len(d.TableNames)-1].End()
+func (p *PGAttachPartitionTableAction) End() sqltoken.Pos {
+	return p.Bound.End()
 }
 
-func (d *DropTableStmt) ToSQLString() string {
-	var ifexists string
-	if d.IfExists {
-		ifexists = "IF EXISTS "
-	}
-
-	var cascade string
-	if d.Cascade {
-		cascade = " CASCADE"
-	}
+func (p *PGAttachPartitionTableAction) ToSQLString() string {
+	return fmt.Sprintf("ATTACH PARTITION %s %s", p.Name.ToSQLString(), p.Bound.ToSQLString())
+}
 
-	return fmt.Sprintf("DROP TABLE %s%s%s", ifexists, commaSeparatedString(d.TableNames), cascade)
+// postgres only
+type PGDetachPartitionTableAction struct {
+	alterTableAction
+	Detach sqltoken.Pos
+	Name   *ObjectName
 }
 
-type CreateIndexStmt struct {
-	Create sqltoken.Pos
-	stmt
-	TableName   *ObjectName
-	IsUnique    bool
-	IndexName   *Ident
-	MethodName  *Ident
-	ColumnNames []*Ident
-	RParen      sqltoken.Pos
-	Selection   Node
+func (p *PGDetachPartitionTableAction) Pos() sqltoken.Pos {
+	return p.Detach
 }
 
-func (c *CreateIndexStmt) Pos() sqltoken.Pos {
-	return c.Create
+func (p *PGDetachPartitionTableAction) End() sqltoken.Pos {
+	return p.Name.End()
 }
 
-func (c *CreateIndexStmt) End() sqltoken.Pos {
-	if c.Selection != nil {
-		return c.Selection.End()
-	}
+func (p *PGDetachPartitionTableAction) ToSQLString() string {
+	return fmt.Sprintf("DETACH PARTITION %s", p.Name.ToSQLString())
+}
 
-	return c.RParen
+// FOR VALUES IN (In...) | FOR VALUES FROM (Lower...) TO (Upper...) | DEFAULT
+type PartitionBound struct {
+	From    sqltoken.Pos // first position of FOR or DEFAULT keyword
+	To      sqltoken.Pos // last position of the bound
+	Default bool
+	In      []Node
+	Lower   []Node
+	Upper   []Node
 }
 
-func (c *CreateIndexStmt) ToSQLString() string {
-	var uniqueStr string
-	if c.IsUnique {
-		uniqueStr = "UNIQUE "
-	}
-	str := fmt.Sprintf("CREATE %sINDEX", uniqueStr)
+func (p *PartitionBound) Pos() sqltoken.Pos {
+	return p.From
+}
 
-	if c.IndexName != nil {
-		str = fmt.Sprintf("%s %s ON %s", str, c.IndexName.ToSQLString(), c.TableName.ToSQLString())
-	} else {
-		str = fmt.Sprintf("%s ON %s", str, c.TableName.ToSQLString())
-	}
+func (p *PartitionBound) End() sqltoken.Pos {
+	return p.To
+}
 
-	if c.MethodName != nil {
-		str = fmt.Sprintf("%s USING %s", str, c.MethodName.ToSQLString())
+func (p *PartitionBound) ToSQLString() string {
+	if p.Default {
+		return "DEFAULT"
 	}
-
-	str = fmt.Sprintf("%s (%s)", str, commaSeparatedString(c.ColumnNames))
-
-	if c.Selection != nil {
-		str = fmt.Sprintf("%s WHERE %s", str, c.Selection.ToSQLString())
+	if p.In != nil {
+		return fmt.Sprintf("FOR VALUES IN (%s)", commaSeparatedString(p.In))
 	}
-
-	return str
+	return fmt.Sprintf("FOR VALUES FROM (%s) TO (%s)", commaSeparatedString(p.Lower), commaSeparatedString(p.Upper))
 }
 
-type DropIndexStmt struct {
+// DROP Kind [IF EXISTS] Names... [CASCADE | RESTRICT]
+type DropStmt struct {
 	stmt
-	Drop       sqltoken.Pos
-	IndexNames []*Ident
+	Drop        sqltoken.Pos // first position of DROP keyword
+	Kind        DropObjectKind
+	IfExists    bool
+	Names       []*ObjectName
+	Behavior    DropBehavior
+	BehaviorPos sqltoken.Pos // last position of CASCADE or RESTRICT if Behavior is specified
 }
 
-func (d *DropIndexStmt) Pos() sqltoken.Pos {
+func (d *DropStmt) Pos() sqltoken.Pos {
 	return d.Drop
 }
 
-func (d *DropIndexStmt) End() sqltoken.Pos {
-	return d.IndexNames[len(d.IndexNames)-1].End()
-}
+func (d *DropStmt) End() sqltoken.Pos {
+	if d.Behavior != DropBehaviorNone {
+		return d.BehaviorPos
+	}
 
-func (s *DropIndexStmt) ToSQLString() string {
-	return fmt.Sprintf("DROP INDEX %s", commaSeparatedString(s.IndexNames))
+	return d.Names[len(d.Names)-1].End()
 }
 
-type ExplainStmt struct {
-	stmt
-	Stmt    Stmt
-	Explain sqltoken.Pos
+func (d *DropStmt) ToSQLString() string {
+	str := fmt.Sprintf("DROP %s ", d.Kind.ToSQLString())
+	if d.IfExists {
+		str += "IF EXISTS "
+	}
+	str += commaSeparatedString(d.Names)
+	if d.Behavior != DropBehaviorNone {
+		str += " " + d.Behavior.ToSQLString()
+	}
+	return str
 }
 
-func (e *ExplainStmt) Pos() sqltoken.Pos {
-	return e.Explain
-}
+// DropTableStmt is the former name of DropStmt for DROP TABLE.
+//
+// Deprecated: use DropStmt with DropTable.
+type DropTableStmt = DropStmt
 
-func (e *ExplainStmt) End() sqltoken.Pos {
-	return e.Stmt.End()
-}
+// DropIndexStmt is the former name of DropStmt for DROP INDEX.
+//
+// Deprecated: use DropStmt with DropIndex.
+type DropIndexStmt = DropStmt
 
-func (e *ExplainStmt) ToSQLString() string {
-	return fmt.Sprintf("EXPLAIN %s", e.Stmt.ToSQLString())
+type DropObjectKind int
+
+const (
+	DropTable DropObjectKind = iota
+	DropView
+	DropMaterializedView
+	DropIndex
+)
+
+func (k DropObjectKind) ToSQLString() string {
+	switch k {
+	case DropTable:
+		return "TABLE"
+	case DropView:
+		return "VIEW"
+	case DropMaterializedView:
+		return "MATERIALIZED VIEW"
+	case DropIndex:
+		return "INDEX"
+	}
+	return ""
+}
+
+type DropBehavior int
+
+const (
+	DropBehaviorNone DropBehavior = iota
+	DropCascade
+	DropRestrict
+)
+
+func (b DropBehavior) ToSQLString() string {
+	switch b {
+	case DropCascade:
+		return "CASCADE"
+	case DropRestrict:
+		return "RESTRICT"
+	}
+	return ""
+}
+
+// CREATE SEQUENCE [IF NOT EXISTS] Name [Options...]
+type CreateSequenceStmt struct {
+	stmt
+	Create    sqltoken.Pos
+	NotExists bool
+	Name      *ObjectName
+	Options   []*SequenceOption
+}
+
+func (c *CreateSequenceStmt) Pos() sqltoken.Pos {
+	return c.Create
+}
+
+func (c *CreateSequenceStmt) End() sqltoken.Pos {
+	if len(c.Options) != 0 {
+		return c.Options[len(c.Options)-1].End()
+	}
+	return c.Name.End()
+}
+
+func (c *CreateSequenceStmt) ToSQLString() string {
+	str := "CREATE SEQUENCE "
+	if c.NotExists {
+		str += "IF NOT EXISTS "
+	}
+	str += c.Name.ToSQLString()
+	for _, o := range c.Options {
+		str += " " + o.ToSQLString()
+	}
+	return str
+}
+
+// SequenceOption is an option of CREATE SEQUENCE, kept in the written order.
+type SequenceOption struct {
+	Kind     SequenceOptionKind
+	Value    Node // nil for NO MINVALUE, NO MAXVALUE, [NO] CYCLE and OWNED BY NONE
+	From, To sqltoken.Pos
+}
+
+func (s *SequenceOption) Pos() sqltoken.Pos {
+	return s.From
+}
+
+func (s *SequenceOption) End() sqltoken.Pos {
+	return s.To
+}
+
+func (s *SequenceOption) ToSQLString() string {
+	switch s.Kind {
+	case SequenceStart:
+		return "START WITH " + s.Value.ToSQLString()
+	case SequenceIncrement:
+		return "INCREMENT BY " + s.Value.ToSQLString()
+	case SequenceMinValue:
+		if s.Value == nil {
+			return "NO MINVALUE"
+		}
+		return "MINVALUE " + s.Value.ToSQLString()
+	case SequenceMaxValue:
+		if s.Value == nil {
+			return "NO MAXVALUE"
+		}
+		return "MAXVALUE " + s.Value.ToSQLString()
+	case SequenceCache:
+		return "CACHE " + s.Value.ToSQLString()
+	case SequenceCycle:
+		return "CYCLE"
+	case SequenceNoCycle:
+		return "NO CYCLE"
+	case SequenceOwnedBy:
+		if s.Value == nil {
+			return "OWNED BY NONE"
+		}
+		return "OWNED BY " + s.Value.ToSQLString()
+	}
+	return ""
+}
+
+type SequenceOptionKind int
+
+const (
+	SequenceStart SequenceOptionKind = iota
+	SequenceIncrement
+	SequenceMinValue
+	SequenceMaxValue
+	SequenceCache
+	SequenceCycle
+	SequenceNoCycle
+	SequenceOwnedBy
+)
+
+type CreateIndexStmt struct {
+	Create sqltoken.Pos
+	stmt
+	TableName  *ObjectName
+	IsUnique   bool
+	IndexName  *Ident
+	MethodName *Ident
+	Columns    []*IndexColumn
+	RParen     sqltoken.Pos
+	Selection  Node
+}
+
+func (c *CreateIndexStmt) Pos() sqltoken.Pos {
+	return c.Create
+}
+
+func (c *CreateIndexStmt) End() sqltoken.Pos {
+	if c.Selection != nil {
+		return c.Selection.End()
+	}
+
+	return c.RParen
+}
+
+func (c *CreateIndexStmt) ToSQLString() string {
+	var uniqueStr string
+	if c.IsUnique {
+		uniqueStr = "UNIQUE "
+	}
+	str := fmt.Sprintf("CREATE %sINDEX", uniqueStr)
+
+	if c.IndexName != nil {
+		str = fmt.Sprintf("%s %s ON %s", str, c.IndexName.ToSQLString(), c.TableName.ToSQLString())
+	} else {
+		str = fmt.Sprintf("%s ON %s", str, c.TableName.ToSQLString())
+	}
+
+	if c.MethodName != nil {
+		str = fmt.Sprintf("%s USING %s", str, c.MethodName.ToSQLString())
+	}
+
+	str = fmt.Sprintf("%s (%s)", str, commaSeparatedString(c.Columns))
+
+	if c.Selection != nil {
+		str = fmt.Sprintf("%s WHERE %s", str, c.Selection.ToSQLString())
+	}
+
+	return str
+}
+
+// Column of CREATE INDEX: { Name | Expr } [ OpClass ] [ ASC | DESC ] [ NULLS { FIRST | LAST } ]
+type IndexColumn struct {
+	Name        *Ident       // nil if Expr is used
+	Expr        Node         // function call or expression in parentheses such as lower(c) or (a + b)
+	OpClass     *ObjectName  // operator class of PostgreSQL, nil if omitted
+	OrderingPos sqltoken.Pos // last position of ASC / DESC keyword if ASC != nil
+	ASC         *bool
+	NullsPos    sqltoken.Pos // last position of FIRST / LAST keyword if NullsFirst != nil
+	NullsFirst  *bool
+}
+
+func (i *IndexColumn) Pos() sqltoken.Pos {
+	if i.Expr != nil {
+		return i.Expr.Pos()
+	}
+	return i.Name.Pos()
+}
+
+func (i *IndexColumn) End() sqltoken.Pos {
+	if i.NullsFirst != nil {
+		return i.NullsPos
+	}
+	if i.ASC != nil {
+		return i.OrderingPos
+	}
+	if i.OpClass != nil {
+		return i.OpClass.End()
+	}
+	if i.Expr != nil {
+		return i.Expr.End()
+	}
+	return i.Name.End()
+}
+
+func (i *IndexColumn) ToSQLString() string {
+	var str string
+	if i.Expr != nil {
+		str = i.Expr.ToSQLString()
+	} else {
+		str = i.Name.ToSQLString()
+	}
+	if i.OpClass != nil {
+		str += " " + i.OpClass.ToSQLString()
+	}
+	if i.ASC != nil {
+		if *i.ASC {
+			str += " ASC"
+		} else {
+			str += " DESC"
+		}
+	}
+	if i.NullsFirst != nil {
+		if *i.NullsFirst {
+			str += " NULLS FIRST"
+		} else {
+			str += " NULLS LAST"
+		}
+	}
+	return str
+}
+
+type ExplainStmt struct {
+	stmt
+	Stmt    Stmt
+	Explain sqltoken.Pos
+}
+
+func (e *ExplainStmt) Pos() sqltoken.Pos {
+	return e.Explain
+}
+
+func (e *ExplainStmt) End() sqltoken.Pos {
+	return e.Stmt.End()
+}
+
+func (e *ExplainStmt) ToSQLString() string {
+	return fmt.Sprintf("EXPLAIN %s", e.Stmt.ToSQLString())
+}
+
+// PREPARE Name [ ( ParamTypes... ) ] AS Stmt
+type PrepareStmt struct {
+	stmt
+	Prepare    sqltoken.Pos
+	Name       *Ident
+	ParamTypes []Type
+	Stmt       Stmt
+}
+
+func (p *PrepareStmt) Pos() sqltoken.Pos {
+	return p.Prepare
+}
+
+func (p *PrepareStmt) End() sqltoken.Pos {
+	return p.Stmt.End()
+}
+
+func (p *PrepareStmt) ToSQLString() string {
+	str := "PREPARE " + p.Name.ToSQLString()
+	if len(p.ParamTypes) != 0 {
+		types := make([]string, 0, len(p.ParamTypes))
+		for _, t := range p.ParamTypes {
+			types = append(types, t.ToSQLString())
+		}
+		str += fmt.Sprintf(" (%s)", strings.Join(types, ", "))
+	}
+	return fmt.Sprintf("%s AS %s", str, p.Stmt.ToSQLString())
+}
+
+// EXECUTE Name [ ( Args... ) ]
+type ExecuteStmt struct {
+	stmt
+	Execute sqltoken.Pos
+	Name    *Ident
+	Args    []Node
+	RParen  sqltoken.Pos // position of ')' if Args is not blank
+}
+
+func (e *ExecuteStmt) Pos() sqltoken.Pos {
+	return e.Execute
+}
+
+func (e *ExecuteStmt) End() sqltoken.Pos {
+	if len(e.Args) != 0 {
+		return e.RParen
+	}
+	return e.Name.End()
+}
+
+func (e *ExecuteStmt) ToSQLString() string {
+	if len(e.Args) != 0 {
+		return fmt.Sprintf("EXECUTE %s (%s)", e.Name.ToSQLString(), commaSeparatedString(e.Args))
+	}
+	return fmt.Sprintf("EXECUTE %s", e.Name.ToSQLString())
+}
+
+// SET Name { TO | = } { Values... | DEFAULT }
+// SET TIME ZONE { Value | DEFAULT }
+type SetStmt struct {
+	stmt
+	Set        sqltoken.Pos
+	Name       *ObjectName // nil if TimeZone is true
+	TimeZone   bool
+	Eq         bool   // written with = instead of TO
+	Values     []Node // nil if Default is true
+	Default    bool
+	DefaultPos sqltoken.Pos // last position of DEFAULT keyword if Default is true
+}
+
+func (s *SetStmt) Pos() sqltoken.Pos {
+	return s.Set
+}
+
+func (s *SetStmt) End() sqltoken.Pos {
+	if s.Default {
+		return s.DefaultPos
+	}
+	return s.Values[len(s.Values)-1].End()
+}
+
+func (s *SetStmt) ToSQLString() string {
+	str := "SET TIME ZONE "
+	if !s.TimeZone {
+		if s.Eq {
+			str = fmt.Sprintf("SET %s = ", s.Name.ToSQLString())
+		} else {
+			str = fmt.Sprintf("SET %s TO ", s.Name.ToSQLString())
+		}
+	}
+	if s.Default {
+		return str + "DEFAULT"
+	}
+	return str + commaSeparatedString(s.Values)
+}
+
+// SHOW { Name | ALL | TIME ZONE }
+type ShowStmt struct {
+	stmt
+	Show     sqltoken.Pos
+	Name     *ObjectName // nil if TimeZone is true, ALL is also kept as Name
+	TimeZone bool
+	ZonePos  sqltoken.Pos // last position of ZONE keyword if TimeZone is true
+}
+
+func (s *ShowStmt) Pos() sqltoken.Pos {
+	return s.Show
+}
+
+func (s *ShowStmt) End() sqltoken.Pos {
+	if s.TimeZone {
+		return s.ZonePos
+	}
+	return s.Name.End()
+}
+
+func (s *ShowStmt) ToSQLString() string {
+	if s.TimeZone {
+		return "SHOW TIME ZONE"
+	}
+	return fmt.Sprintf("SHOW %s", s.Name.ToSQLString())
+}
+
+// RESET { Name | ALL | TIME ZONE }
+// postgres only
+type ResetStmt struct {
+	stmt
+	Reset    sqltoken.Pos
+	Name     *ObjectName // nil if TimeZone is true, ALL is also kept as Name
+	TimeZone bool
+	ZonePos  sqltoken.Pos // last position of ZONE keyword if TimeZone is true
+}
+
+func (r *ResetStmt) Pos() sqltoken.Pos {
+	return r.Reset
+}
+
+func (r *ResetStmt) End() sqltoken.Pos {
+	if r.TimeZone {
+		return r.ZonePos
+	}
+	return r.Name.End()
+}
+
+func (r *ResetStmt) ToSQLString() string {
+	if r.TimeZone {
+		return "RESET TIME ZONE"
+	}
+	return fmt.Sprintf("RESET %s", r.Name.ToSQLString())
+}
+
+// DO [LANGUAGE Language] Body, or DO Body LANGUAGE Language
+// The body is kept as it is without being parsed.
+// postgres only
+type DoStmt struct {
+	stmt
+	Do            sqltoken.Pos
+	Body          Node   // *DollarQuotedString or *SingleQuotedString
+	Language      *Ident // nil if omitted
+	LanguageFirst bool   // LANGUAGE precedes Body
+}
+
+func (d *DoStmt) Pos() sqltoken.Pos {
+	return d.Do
+}
+
+func (d *DoStmt) End() sqltoken.Pos {
+	if d.Language != nil && !d.LanguageFirst {
+		return d.Language.End()
+	}
+	return d.Body.End()
+}
+
+func (d *DoStmt) ToSQLString() string {
+	if d.Language == nil {
+		return fmt.Sprintf("DO %s", d.Body.ToSQLString())
+	}
+	if d.LanguageFirst {
+		return fmt.Sprintf("DO LANGUAGE %s %s", d.Language.ToSQLString(), d.Body.ToSQLString())
+	}
+	return fmt.Sprintf("DO %s LANGUAGE %s", d.Body.ToSQLString(), d.Language.ToSQLString())
+}
+
+// CommentObjectKind is the kind of object of COMMENT ON.
+type CommentObjectKind int
+
+const (
+	CommentOnTable CommentObjectKind = iota
+	CommentOnColumn
+	CommentOnIndex
+	CommentOnView
+)
+
+func (c CommentObjectKind) ToSQLString() string {
+	switch c {
+	case CommentOnColumn:
+		return "COLUMN"
+	case CommentOnIndex:
+		return "INDEX"
+	case CommentOnView:
+		return "VIEW"
+	}
+	return "TABLE"
+}
+
+// COMMENT ON ObjectKind Name IS { Text | NULL }
+// postgres only
+type CommentStmt struct {
+	stmt
+	Comment    sqltoken.Pos
+	ObjectKind CommentObjectKind
+	Name       *ObjectName         // table.column for COLUMN
+	Text       *SingleQuotedString // nil if the comment is removed with NULL
+	NullPos    sqltoken.Pos        // last position of NULL keyword if Text is nil
+}
+
+func (c *CommentStmt) Pos() sqltoken.Pos {
+	return c.Comment
+}
+
+func (c *CommentStmt) End() sqltoken.Pos {
+	if c.Text == nil {
+		return c.NullPos
+	}
+	return c.Text.End()
+}
+
+func (c *CommentStmt) ToSQLString() string {
+	text := "NULL"
+	if c.Text != nil {
+		text = c.Text.ToSQLString()
+	}
+	return fmt.Sprintf("COMMENT ON %s %s IS %s", c.ObjectKind.ToSQLString(), c.Name.ToSQLString(), text)
+}
+
+// DEALLOCATE [ PREPARE ] { Name | ALL }
+type DeallocateStmt struct {
+	stmt
+	Deallocate sqltoken.Pos
+	Name       *Ident // nil if All is true
+	All        bool
+	AllPos     sqltoken.Pos // last position of ALL keyword if All is true
+}
+
+func (d *DeallocateStmt) Pos() sqltoken.Pos {
+	return d.Deallocate
+}
+
+func (d *DeallocateStmt) End() sqltoken.Pos {
+	if d.All {
+		return d.AllPos
+	}
+	return d.Name.End()
+}
+
+func (d *DeallocateStmt) ToSQLString() string {
+	if d.All {
+		return "DEALLOCATE ALL"
+	}
+	return fmt.Sprintf("DEALLOCATE %s", d.Name.ToSQLString())
+}
+
+// DECLARE Name [ BINARY ] [ ASENSITIVE | INSENSITIVE ] [ [ NO ] SCROLL ] CURSOR
+// [ { WITH | WITHOUT } HOLD ] FOR Query
+type DeclareCursorStmt struct {
+	stmt
+	Declare     sqltoken.Pos
+	Name        *Ident
+	Binary      bool
+	Asensitive  bool
+	Insensitive bool
+	Scroll      *bool // nil if omitted, false if NO SCROLL
+	Hold        *bool // nil if omitted, true if WITH HOLD and false if WITHOUT HOLD
+	Query       *QueryStmt
+}
+
+func (d *DeclareCursorStmt) Pos() sqltoken.Pos {
+	return d.Declare
+}
+
+func (d *DeclareCursorStmt) End() sqltoken.Pos {
+	return d.Query.End()
+}
+
+func (d *DeclareCursorStmt) ToSQLString() string {
+	str := fmt.Sprintf("DECLARE %s ", d.Name.ToSQLString())
+	if d.Binary {
+		str += "BINARY "
+	}
+	if d.Asensitive {
+		str += "ASENSITIVE "
+	}
+	if d.Insensitive {
+		str += "INSENSITIVE "
+	}
+	if d.Scroll != nil {
+		if !*d.Scroll {
+			str += "NO "
+		}
+		str += "SCROLL "
+	}
+	str += "CURSOR "
+	if d.Hold != nil {
+		if *d.Hold {
+			str += "WITH HOLD "
+		} else {
+			str += "WITHOUT HOLD "
+		}
+	}
+	return str + "FOR " + d.Query.ToSQLString()
+}
+
+// CursorDirection is the direction of FETCH and MOVE.
+type CursorDirection int
+
+const (
+	CursorDirectionNone CursorDirection = iota
+	CursorNext
+	CursorPrior
+	CursorFirst
+	CursorLast
+	CursorAbsolute // ABSOLUTE Count
+	CursorRelative // RELATIVE Count
+	CursorCount    // Count without keyword
+	CursorAll
+	CursorForward  // FORWARD [ Count | ALL ]
+	CursorBackward // BACKWARD [ Count | ALL ]
+)
+
+func (c CursorDirection) ToSQLString() string {
+	switch c {
+	case CursorNext:
+		return "NEXT"
+	case CursorPrior:
+		return "PRIOR"
+	case CursorFirst:
+		return "FIRST"
+	case CursorLast:
+		return "LAST"
+	case CursorAbsolute:
+		return "ABSOLUTE"
+	case CursorRelative:
+		return "RELATIVE"
+	case CursorAll:
+		return "ALL"
+	case CursorForward:
+		return "FORWARD"
+	case CursorBackward:
+		return "BACKWARD"
+	}
+	return ""
+}
+
+// { FETCH | MOVE } [ Direction [ Count | ALL ] ] [ FROM | IN ] Cursor
+type FetchStmt struct {
+	stmt
+	Fetch     sqltoken.Pos // first position of FETCH or MOVE keyword
+	Move      bool
+	Direction CursorDirection
+	Count     Node // nil if omitted
+	All       bool // FORWARD ALL or BACKWARD ALL
+	Cursor    *Ident
+}
+
+func (f *FetchStmt) Pos() sqltoken.Pos {
+	return f.Fetch
+}
+
+func (f *FetchStmt) End() sqltoken.Pos {
+	return f.Cursor.End()
+}
+
+func (f *FetchStmt) ToSQLString() string {
+	str := "FETCH "
+	if f.Move {
+		str = "MOVE "
+	}
+	if f.Direction != CursorDirectionNone && f.Direction != CursorCount {
+		str += f.Direction.ToSQLString() + " "
+	}
+	if f.Count != nil {
+		str += f.Count.ToSQLString() + " "
+	}
+	if f.All {
+		str += "ALL "
+	}
+	if f.Direction != CursorDirectionNone {
+		str += "FROM "
+	}
+	return str + f.Cursor.ToSQLString()
+}
+
+// CLOSE { Cursor | ALL }
+type CloseStmt struct {
+	stmt
+	Close  sqltoken.Pos
+	Cursor *Ident // nil if All is true
+	All    bool
+	AllPos sqltoken.Pos // last position of ALL keyword if All is true
+}
+
+func (c *CloseStmt) Pos() sqltoken.Pos {
+	return c.Close
+}
+
+func (c *CloseStmt) End() sqltoken.Pos {
+	if c.All {
+		return c.AllPos
+	}
+	return c.Cursor.End()
+}
+
+func (c *CloseStmt) ToSQLString() string {
+	if c.All {
+		return "CLOSE ALL"
+	}
+	return fmt.Sprintf("CLOSE %s", c.Cursor.ToSQLString())
+}
+
+// TransactionWord is the optional WORK or TRANSACTION keyword of
+// transaction control statements.
+type TransactionWord int
+
+const (
+	TransactionWordNone TransactionWord = iota
+	TransactionWordWork
+	TransactionWordTransaction
+)
+
+func (t TransactionWord) ToSQLString() string {
+	switch t {
+	case TransactionWordWork:
+		return "WORK"
+	case TransactionWordTransaction:
+		return "TRANSACTION"
+	}
+	return ""
+}
+
+// transactionString returns keyword followed by word if it is written.
+func transactionString(keyword string, word TransactionWord) string {
+	if word == TransactionWordNone {
+		return keyword
+	}
+	return keyword + " " + word.ToSQLString()
+}
+
+// BEGIN [ WORK | TRANSACTION ] [ Modes... ]
+// START TRANSACTION [ Modes... ]
+type BeginStmt struct {
+	stmt
+	Begin sqltoken.Pos // first position of BEGIN or START keyword
+	Start bool         // START TRANSACTION
+	Word  TransactionWord
+	Modes []*TransactionMode
+	To    sqltoken.Pos // last position of the keywords before Modes
+}
+
+func (b *BeginStmt) Pos() sqltoken.Pos {
+	return b.Begin
+}
+
+func (b *BeginStmt) End() sqltoken.Pos {
+	if len(b.Modes) != 0 {
+		return b.Modes[len(b.Modes)-1].End()
+	}
+	return b.To
+}
+
+func (b *BeginStmt) ToSQLString() string {
+	str := transactionString("BEGIN", b.Word)
+	if b.Start {
+		str = "START TRANSACTION"
+	}
+	if len(b.Modes) != 0 {
+		str += " " + commaSeparatedString(b.Modes)
+	}
+	return str
+}
+
+// TransactionModeKind is the kind of transaction modes.
+type TransactionModeKind int
+
+const (
+	IsolationLevelMode TransactionModeKind = iota
+	ReadOnlyMode
+	ReadWriteMode
+	DeferrableMode
+	NotDeferrableMode
+)
+
+// IsolationLevel is the transaction isolation level of ISOLATION LEVEL.
+type IsolationLevel int
+
+const (
+	Serializable IsolationLevel = iota
+	RepeatableRead
+	ReadCommitted
+	ReadUncommitted
+)
+
+func (i IsolationLevel) ToSQLString() string {
+	switch i {
+	case RepeatableRead:
+		return "REPEATABLE READ"
+	case ReadCommitted:
+		return "READ COMMITTED"
+	case ReadUncommitted:
+		return "READ UNCOMMITTED"
+	}
+	return "SERIALIZABLE"
+}
+
+// ISOLATION LEVEL Level | READ { ONLY | WRITE } | [ NOT ] DEFERRABLE
+type TransactionMode struct {
+	Kind     TransactionModeKind
+	Level    IsolationLevel // used if Kind is IsolationLevelMode
+	From, To sqltoken.Pos
+}
+
+func (t *TransactionMode) Pos() sqltoken.Pos {
+	return t.From
+}
+
+func (t *TransactionMode) End() sqltoken.Pos {
+	return t.To
+}
+
+func (t *TransactionMode) ToSQLString() string {
+	switch t.Kind {
+	case ReadOnlyMode:
+		return "READ ONLY"
+	case ReadWriteMode:
+		return "READ WRITE"
+	case DeferrableMode:
+		return "DEFERRABLE"
+	case NotDeferrableMode:
+		return "NOT DEFERRABLE"
+	}
+	return "ISOLATION LEVEL " + t.Level.ToSQLString()
+}
+
+// COMMIT [ WORK | TRANSACTION ]
+type CommitStmt struct {
+	stmt
+	Commit sqltoken.Pos
+	Word   TransactionWord
+	To     sqltoken.Pos // last position of the statement
+}
+
+func (c *CommitStmt) Pos() sqltoken.Pos {
+	return c.Commit
+}
+
+func (c *CommitStmt) End() sqltoken.Pos {
+	return c.To
+}
+
+func (c *CommitStmt) ToSQLString() string {
+	return transactionString("COMMIT", c.Word)
+}
+
+// ROLLBACK [ WORK | TRANSACTION ] [ TO [ SAVEPOINT ] Savepoint ]
+type RollbackStmt struct {
+	stmt
+	Rollback         sqltoken.Pos
+	Word             TransactionWord
+	Savepoint        *Ident       // nil if the whole transaction is rolled back
+	SavepointKeyword bool         // SAVEPOINT is written before Savepoint
+	To               sqltoken.Pos // last position of ROLLBACK or Word if Savepoint is nil
+}
+
+func (r *RollbackStmt) Pos() sqltoken.Pos {
+	return r.Rollback
+}
+
+func (r *RollbackStmt) End() sqltoken.Pos {
+	if r.Savepoint != nil {
+		return r.Savepoint.End()
+	}
+	return r.To
+}
+
+func (r *RollbackStmt) ToSQLString() string {
+	str := transactionString("ROLLBACK", r.Word)
+	if r.Savepoint == nil {
+		return str
+	}
+	if r.SavepointKeyword {
+		return fmt.Sprintf("%s TO SAVEPOINT %s", str, r.Savepoint.ToSQLString())
+	}
+	return fmt.Sprintf("%s TO %s", str, r.Savepoint.ToSQLString())
+}
+
+// SAVEPOINT Name
+type SavepointStmt struct {
+	stmt
+	Savepoint sqltoken.Pos
+	Name      *Ident
+}
+
+func (s *SavepointStmt) Pos() sqltoken.Pos {
+	return s.Savepoint
+}
+
+func (s *SavepointStmt) End() sqltoken.Pos {
+	return s.Name.End()
+}
+
+func (s *SavepointStmt) ToSQLString() string {
+	return fmt.Sprintf("SAVEPOINT %s", s.Name.ToSQLString())
+}
+
+// RELEASE [ SAVEPOINT ] Name
+type ReleaseSavepointStmt struct {
+	stmt
+	Release          sqltoken.Pos
+	SavepointKeyword bool // SAVEPOINT is written before Name
+	Name             *Ident
+}
+
+func (r *ReleaseSavepointStmt) Pos() sqltoken.Pos {
+	return r.Release
+}
+
+func (r *ReleaseSavepointStmt) End() sqltoken.Pos {
+	return r.Name.End()
+}
+
+func (r *ReleaseSavepointStmt) ToSQLString() string {
+	if r.SavepointKeyword {
+		return fmt.Sprintf("RELEASE SAVEPOINT %s", r.Name.ToSQLString())
+	}
+	return fmt.Sprintf("RELEASE %s", r.Name.ToSQLString())
 }
@@ -574,49 +574,49 @@ func TestSQLCreateIndex_ToSQLString(t *testing.T) {
 		{
 			name: "create index",
 			in: &CreateIndexStmt{
-				TableName:   NewObjectName("customers"),
-				ColumnNames: []*Ident{NewIdent("name")},
+				TableName: NewObjectName("customers"),
+				Columns:   []*IndexColumn{{Name: NewIdent("name")}},
 			},
 			out: "CREATE INDEX ON customers (name)",
 		},
 		{
 			name: "create unique index",
 			in: &CreateIndexStmt{
-				TableName:   NewObjectName("customers"),
-				IsUnique:    true,
-				ColumnNames: []*Ident{NewIdent("name")},
+				TableName: NewObjectName("customers"),
+				IsUnique:  true,
+				Columns:   []*IndexColumn{{Name: NewIdent("name")}},
 			},
 			out: "CREATE UNIQUE INDEX ON customers (name)",
 		},
 		{
 			name: "create index with name",
 			in: &CreateIndexStmt{
-				TableName:   NewObjectName("customers"),
-				IndexName:   NewIdent("customers_idx"),
-				IsUnique:    true,
-				ColumnNames: []*Ident{NewIdent("name"), NewIdent("email")},
+				TableName: NewObjectName("customers"),
+				IndexName: NewIdent("customers_idx"),
+				IsUnique:  true,
+				Columns:   []*IndexColumn{{Name: NewIdent("name")}, {Name: NewIdent("email")}},
 			},
 			out: "CREATE UNIQUE INDEX customers_idx ON customers (name, email)",
 		},
 		{
 			name: "create index with name",
 			in: &CreateIndexStmt{
-				TableName:   NewObjectName("customers"),
-				IndexName:   NewIdent("customers_idx"),
-				IsUnique:    true,
-				MethodName:  NewIdent("gist"),
-				ColumnNames: []*Ident{NewIdent("name")},
+				TableName:  NewObjectName("customers"),
+				IndexName:  NewIdent("customers_idx"),
+				IsUnique:   true,
+				MethodName: NewIdent("gist"),
+				Columns:    []*IndexColumn{{Name: NewIdent("name")}},
 			},
 			out: "CREATE UNIQUE INDEX customers_idx ON customers USING gist (name)",
 		},
 		{
 			name: "create partial index with name",
 			in: &CreateIndexStmt{
-				TableName:   NewObjectName("customers"),
-				IndexName:   NewIdent("customers_idx"),
-				IsUnique:    true,
-				MethodName:  NewIdent("gist"),
-				ColumnNames: []*Ident{NewIdent("name")},
+				TableName:  NewObjectName("customers"),
+				IndexName:  NewIdent("customers_idx"),
+				IsUnique:   true,
+				MethodName: NewIdent("gist"),
+				Columns:    []*IndexColumn{{Name: NewIdent("name")}},
 				Selection: &BinaryExpr{
 					Left:  NewIdent("name"),
 					Op:    &Operator{Type: Eq},
@@ -625,6 +625,17 @@ func TestSQLCreateIndex_ToSQLString(t *testing.T) {
 			},
 			out: "CREATE UNIQUE INDEX customers_idx ON customers USING gist (name) WHERE name = 'test'",
 		},
+		{
+			name: "create index with operator class",
+			in: &CreateIndexStmt{
+				TableName: NewObjectName("customers"),
+				Columns: []*IndexColumn{
+					{Name: NewIdent("name"), OpClass: NewObjectName("text_pattern_ops"), ASC: func() *bool { b := false; return &b }()},
+					{Name: NewIdent("email"), ASC: func() *bool { b := true; return &b }()},
+				},
+			},
+			out: "CREATE INDEX ON customers (name text_pattern_ops DESC, email ASC)",
+		},
 	}
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
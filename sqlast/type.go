@@ -447,8 +447,10 @@ func (*Bytea) ToSQLString() string {
 	return "bytea"
 }
 
+// Ty[Size], Ty is also an Array for a multi-dimensional array.
 type Array struct {
 	Ty     Type
+	Size   *uint // nil if the dimension is not sized
 	RParen sqltoken.Pos
 }
 
@@ -461,6 +463,9 @@ func (a *Array) End() sqltoken.Pos {
 }
 
 func (a *Array) ToSQLString() string {
+	if a.Size != nil {
+		return fmt.Sprintf("%s[%d]", a.Ty.ToSQLString(), *a.Size)
+	}
 	return fmt.Sprintf("%s[]", a.Ty.ToSQLString())
 }
 
@@ -15,6 +15,7 @@ type Value interface {
 type LongValue struct {
 	From, To sqltoken.Pos
 	Long     int64
+	Redacted bool // printed as the ? placeholder, set by Redact
 }
 
 func NewLongValue(i int64) *LongValue {
@@ -36,6 +37,9 @@ func (l *LongValue) Value() interface{} {
 }
 
 func (l *LongValue) ToSQLString() string {
+	if l.Redacted {
+		return "?"
+	}
 	return fmt.Sprintf("%d", l.Long)
 }
 
@@ -69,6 +73,9 @@ func (d *DoubleValue) ToSQLString() string {
 type SingleQuotedString struct {
 	From, To sqltoken.Pos
 	String   string
+	Unicode  bool // U&'...' of PostgreSQL, and String holds the decoded value
+	Escape   rune // escape character given by UESCAPE, 0 for the default `\`
+	Redacted bool // printed as the ? placeholder, set by Redact
 }
 
 func NewSingleQuotedString(str string) *SingleQuotedString {
@@ -90,7 +97,39 @@ func (s *SingleQuotedString) Value() interface{} {
 }
 
 func (s *SingleQuotedString) ToSQLString() string {
-	return fmt.Sprintf("'%s'", s.String)
+	if s.Redacted {
+		return "?"
+	}
+	if !s.Unicode {
+		return fmt.Sprintf("'%s'", s.String)
+	}
+	if s.Escape == 0 {
+		return fmt.Sprintf("U&'%s'", sqltoken.EncodeUnicodeEscapes(s.String, '\\'))
+	}
+	return fmt.Sprintf("U&'%s' UESCAPE '%c'", sqltoken.EncodeUnicodeEscapes(s.String, s.Escape), s.Escape)
+}
+
+// DollarQuotedString is a string literal quoted with $tag$ of PostgreSQL.
+type DollarQuotedString struct {
+	From, To sqltoken.Pos
+	Tag      string // empty for $$
+	String   string
+}
+
+func (d *DollarQuotedString) Pos() sqltoken.Pos {
+	return d.From
+}
+
+func (d *DollarQuotedString) End() sqltoken.Pos {
+	return d.To
+}
+
+func (d *DollarQuotedString) Value() interface{} {
+	return d.String
+}
+
+func (d *DollarQuotedString) ToSQLString() string {
+	return "$" + d.Tag + "$" + d.String + "$" + d.Tag + "$"
 }
 
 type NationalStringLiteral struct {
@@ -144,7 +183,10 @@ func (b *BooleanValue) Value() interface{} {
 }
 
 func (b *BooleanValue) ToSQLString() string {
-	return fmt.Sprintf("%t", b.Boolean)
+	if b.Boolean {
+		return "TRUE"
+	}
+	return "FALSE"
 }
 
 type DateValue struct {
@@ -247,8 +289,53 @@ func (t *TimestampValue) ToSQLString() string {
 	return t.Timestamp.Format("2006-01-02 15:04:05")
 }
 
+type TypedLiteralType int
+
+const (
+	DateLiteral TypedLiteralType = iota
+	TimeLiteral
+	TimestampLiteral
+)
+
+func (t TypedLiteralType) ToSQLString() string {
+	switch t {
+	case DateLiteral:
+		return "DATE"
+	case TimeLiteral:
+		return "TIME"
+	case TimestampLiteral:
+		return "TIMESTAMP"
+	}
+	return ""
+}
+
+// TypedLiteral is a string literal prefixed with its type, e.g. DATE '2020-01-01'.
+// The string is kept as it is written.
+type TypedLiteral struct {
+	From    sqltoken.Pos // first position of the type keyword
+	Type    TypedLiteralType
+	Literal *SingleQuotedString
+}
+
+func (t *TypedLiteral) Pos() sqltoken.Pos {
+	return t.From
+}
+
+func (t *TypedLiteral) End() sqltoken.Pos {
+	return t.Literal.End()
+}
+
+func (t *TypedLiteral) Value() interface{} {
+	return t.Literal.String
+}
+
+func (t *TypedLiteral) ToSQLString() string {
+	return fmt.Sprintf("%s %s", t.Type.ToSQLString(), t.Literal.ToSQLString())
+}
+
 type NullValue struct {
 	From, To sqltoken.Pos
+	Unknown  bool // written as UNKNOWN, the null value of boolean after IS [NOT]
 }
 
 func NewNullValue() *NullValue {
@@ -268,5 +355,8 @@ func (n *NullValue) Value() interface{} {
 }
 
 func (n *NullValue) ToSQLString() string {
+	if n.Unknown {
+		return "UNKNOWN"
+	}
 	return "NULL"
 }
@@ -2,6 +2,7 @@ package sqlast
 
 import (
 	"log"
+	"strings"
 )
 
 type Visitor interface {
@@ -21,7 +22,7 @@ func walkASTNodeLists(v Visitor, list []Node) {
 }
 
 func Walk(v Visitor, node Node) {
-	if v := v.Visit(node); v == nil {
+	if v = v.Visit(node); v == nil {
 		return
 	}
 
@@ -32,22 +33,38 @@ func Walk(v Visitor, node Node) {
 		}
 	case *Ident:
 		// nothing to do
+	case *Placeholder:
+		// nothing to do
+	case *DefaultExpr:
+		// nothing to do
 	case *Wildcard:
 		// nothing to do
 	case *QualifiedWildcard:
 		walkIdentLists(v, n.Idents)
 	case *CompoundIdent:
 		walkIdentLists(v, n.Idents)
+	case *OuterJoinColumn:
+		Walk(v, n.Column)
 	case *IsNull:
 		Walk(v, n.X)
 	case *IsNotNull:
 		Walk(v, n.X)
+	case *IsTruthValue:
+		Walk(v, n.X)
+		Walk(v, n.Value)
 	case *InList:
 		Walk(v, n.Expr)
 		walkASTNodeLists(v, n.List)
 	case *InSubQuery:
 		Walk(v, n.Expr)
 		Walk(v, n.SubQuery)
+	case *OverlapsExpr:
+		Walk(v, n.Left)
+		Walk(v, n.Right)
+	case *QuantifiedComparison:
+		Walk(v, n.Left)
+		Walk(v, n.Op)
+		Walk(v, n.Right)
 	case *Between:
 		Walk(v, n.Expr)
 		Walk(v, n.Low)
@@ -56,9 +73,35 @@ func Walk(v Visitor, node Node) {
 		Walk(v, n.Left)
 		Walk(v, n.Op)
 		Walk(v, n.Right)
+	case *AtTimeZone:
+		Walk(v, n.Expr)
+		Walk(v, n.Zone)
 	case *Cast:
 		Walk(v, n.Expr)
 		Walk(v, n.DateType)
+	case *TrimExpr:
+		if n.Chars != nil {
+			Walk(v, n.Chars)
+		}
+		Walk(v, n.Expr)
+	case *SubstringExpr:
+		Walk(v, n.Expr)
+		if n.From != nil {
+			Walk(v, n.From)
+		}
+		if n.For != nil {
+			Walk(v, n.For)
+		}
+	case *ExtractExpr:
+		if n.Field != nil {
+			Walk(v, n.Field)
+		}
+		Walk(v, n.Expr)
+	case *PositionExpr:
+		Walk(v, n.Substr)
+		Walk(v, n.Expr)
+	case *ArrayConstructor:
+		walkASTNodeLists(v, n.Elements)
 	case *Nested:
 		Walk(v, n.AST)
 	case *UnaryExpr:
@@ -67,11 +110,33 @@ func Walk(v Visitor, node Node) {
 	case *Function:
 		Walk(v, n.Name)
 		walkASTNodeLists(v, n.Args)
+		for _, o := range n.OrderBy {
+			Walk(v, o)
+		}
+		if n.Separator != nil {
+			Walk(v, n.Separator)
+		}
+		for _, o := range n.WithinGroup {
+			Walk(v, o)
+		}
+		if n.Filter != nil {
+			Walk(v, n.Filter)
+		}
 		if n.Over != nil {
 			Walk(v, n.Over)
 		}
+	case *NamedArg:
+		Walk(v, n.Name)
+		Walk(v, n.Value)
 	case *CaseExpr:
-		Walk(v, n.Operand)
+		if n.Operand != nil {
+			Walk(v, n.Operand)
+		}
+		walkASTNodeLists(v, n.Conditions)
+		walkASTNodeLists(v, n.Results)
+		if n.ElseResult != nil {
+			Walk(v, n.ElseResult)
+		}
 	case *Exists:
 		Walk(v, n.Query)
 	case *SubQuery:
@@ -115,13 +180,32 @@ func Walk(v Visitor, node Node) {
 		if n.Limit != nil {
 			Walk(v, n.Limit)
 		}
+		if n.Offset != nil {
+			Walk(v, n.Offset)
+		}
+		if n.Fetch != nil {
+			Walk(v, n.Fetch)
+		}
+		for _, l := range n.Locks {
+			Walk(v, l)
+		}
+	case *LockingClause:
+		for _, t := range n.Tables {
+			Walk(v, t)
+		}
 	case *CTE:
-		Walk(v, n.Query)
+		if n.Query != nil {
+			Walk(v, n.Query)
+		} else {
+			Walk(v, n.Stmt)
+		}
 		Walk(v, n.Alias)
 	case *SelectExpr:
 		Walk(v, n.Select)
 	case *QueryExpr:
 		Walk(v, n.Query)
+	case *TableQueryExpr:
+		Walk(v, n.Name)
 	case *SetOperationExpr:
 		Walk(v, n.Op)
 		Walk(v, n.Left)
@@ -133,9 +217,13 @@ func Walk(v Visitor, node Node) {
 	case *IntersectOperator:
 		// nothing to do
 	case *SQLSelect:
+		walkASTNodeLists(v, n.DistinctOn)
 		for _, p := range n.Projection {
 			Walk(v, p)
 		}
+		if n.Into != nil {
+			Walk(v, n.Into)
+		}
 		if len(n.FromClause) != 0 {
 			for _, f := range n.FromClause {
 				Walk(v, f)
@@ -148,6 +236,8 @@ func Walk(v Visitor, node Node) {
 		if n.HavingClause != nil {
 			Walk(v, n.HavingClause)
 		}
+	case *SelectInto:
+		Walk(v, n.Name)
 	case *QualifiedJoin:
 		Walk(v, n.LeftElement)
 		Walk(v, n.Type)
@@ -171,13 +261,63 @@ func Walk(v Visitor, node Node) {
 		if n.Alias != nil {
 			Walk(v, n.Alias)
 		}
+		walkIdentLists(v, n.AliasColumns)
+		for _, d := range n.ColumnDefs {
+			Walk(v, d)
+		}
 		walkASTNodeLists(v, n.Args)
 		walkASTNodeLists(v, n.WithHints)
+		if n.Sample != nil {
+			Walk(v, n.Sample)
+		}
+		for _, h := range n.IndexHints {
+			Walk(v, h)
+		}
+	case *TableSample:
+		Walk(v, n.Method)
+		walkASTNodeLists(v, n.Args)
+		if n.Seed != nil {
+			Walk(v, n.Seed)
+		}
+	case *IndexHint:
+		for _, i := range n.Indexes {
+			Walk(v, i)
+		}
 	case *Derived:
 		Walk(v, n.SubQuery)
 		if n.Alias != nil {
 			Walk(v, n.Alias)
 		}
+	case *JSONTable:
+		Walk(v, n.Expr)
+		Walk(v, n.Path)
+		for _, c := range n.Columns {
+			Walk(v, c)
+		}
+		if n.Alias != nil {
+			Walk(v, n.Alias)
+		}
+	case *JSONTableOrdinalityColumn:
+		Walk(v, n.Name)
+	case *JSONTablePathColumn:
+		Walk(v, n.Name)
+		Walk(v, n.DataType)
+		Walk(v, n.Path)
+		if n.OnEmpty != nil {
+			Walk(v, n.OnEmpty)
+		}
+		if n.OnError != nil {
+			Walk(v, n.OnError)
+		}
+	case *JSONTableNestedColumn:
+		Walk(v, n.Path)
+		for _, c := range n.Columns {
+			Walk(v, c)
+		}
+	case *JSONBehavior:
+		if n.Default != nil {
+			Walk(v, n.Default)
+		}
 	case *UnnamedSelectItem:
 		Walk(v, n.Node)
 	case *AliasSelectItem:
@@ -185,8 +325,27 @@ func Walk(v Visitor, node Node) {
 		Walk(v, n.Alias)
 	case *QualifiedWildcardSelectItem:
 		Walk(v, n.Prefix)
+		if n.Exclude != nil {
+			Walk(v, n.Exclude)
+		}
+		if n.Replace != nil {
+			Walk(v, n.Replace)
+		}
 	case *WildcardSelectItem:
-		// nothing to do
+		if n.Exclude != nil {
+			Walk(v, n.Exclude)
+		}
+		if n.Replace != nil {
+			Walk(v, n.Replace)
+		}
+	case *ExcludeColumns:
+		for _, c := range n.Columns {
+			Walk(v, c)
+		}
+	case *ReplaceColumns:
+		for _, i := range n.Items {
+			Walk(v, i)
+		}
 	case *OrderByExpr:
 		Walk(v, n.Expr)
 	case *LimitExpr:
@@ -196,6 +355,12 @@ func Walk(v Visitor, node Node) {
 		if n.OffsetValue != nil {
 			Walk(v, n.OffsetValue)
 		}
+	case *OffsetExpr:
+		Walk(v, n.Value)
+	case *FetchExpr:
+		if n.Quantity != nil {
+			Walk(v, n.Quantity)
+		}
 	case *CharType:
 		// nothing to do
 	case *VarcharType:
@@ -250,6 +415,12 @@ func Walk(v Visitor, node Node) {
 		for _, a := range n.UpdateAssignments {
 			Walk(v, a)
 		}
+		for _, r := range n.Returning {
+			Walk(v, r)
+		}
+		if n.ReturningInto != nil {
+			Walk(v, n.ReturningInto)
+		}
 
 	case *ConstructorSource:
 		for _, r := range n.Rows {
@@ -261,28 +432,84 @@ func Walk(v Visitor, node Node) {
 		}
 	case *SubQuerySource:
 		Walk(v, n.SubQuery)
+	case *DefaultValuesSource:
+		// nothing to do
 	case *CopyStmt:
-		Walk(v, n.TableName)
+		if n.TableName != nil {
+			Walk(v, n.TableName)
+		}
 		walkIdentLists(v, n.Columns)
+		if n.Query != nil {
+			Walk(v, n.Query)
+		}
+		Walk(v, n.Target)
+		for _, o := range n.Options {
+			Walk(v, o)
+		}
+	case *CopyOption:
+		Walk(v, n.Name)
+		if n.Value != nil {
+			Walk(v, n.Value)
+		}
 	case *UpdateStmt:
 		Walk(v, n.TableName)
 		for _, a := range n.Assignments {
 			Walk(v, a)
 		}
-		Walk(v, n.Selection)
+		if n.Selection != nil {
+			Walk(v, n.Selection)
+		}
+		for _, r := range n.Returning {
+			Walk(v, r)
+		}
+		if n.ReturningInto != nil {
+			Walk(v, n.ReturningInto)
+		}
 	case *DeleteStmt:
 		Walk(v, n.TableName)
+		for _, u := range n.Using {
+			Walk(v, u)
+		}
 		if n.Selection != nil {
 			Walk(v, n.Selection)
 		}
+		for _, r := range n.Returning {
+			Walk(v, r)
+		}
+		if n.ReturningInto != nil {
+			Walk(v, n.ReturningInto)
+		}
+	case *ReturningInto:
+		walkASTNodeLists(v, n.Targets)
 	case *CreateViewStmt:
 		Walk(v, n.Name)
+		for _, o := range n.Options {
+			Walk(v, o)
+		}
 		Walk(v, n.Query)
+	case *ViewOption:
+		Walk(v, n.Name)
+		if n.Value != nil {
+			Walk(v, n.Value)
+		}
+	case *CreateSequenceStmt:
+		Walk(v, n.Name)
+		for _, o := range n.Options {
+			Walk(v, o)
+		}
+	case *SequenceOption:
+		if n.Value != nil {
+			Walk(v, n.Value)
+		}
 	case *CreateTableStmt:
 		Walk(v, n.Name)
 		for _, e := range n.Elements {
 			Walk(v, e)
 		}
+		walkIdentLists(v, n.Columns)
+		if n.Query != nil {
+			Walk(v, n.Query)
+		}
 	case *Assignment:
 		Walk(v, n.ID)
 		Walk(v, n.Value)
@@ -304,12 +531,22 @@ func Walk(v Visitor, node Node) {
 	case *ColumnDef:
 		Walk(v, n.Name)
 		Walk(v, n.DataType)
+		if n.Charset != nil {
+			Walk(v, n.Charset)
+		}
+		if n.Collation != nil {
+			Walk(v, n.Collation)
+		}
 		if n.Default != nil {
 			Walk(v, n.Default)
 		}
 		for _, c := range n.Constraints {
 			Walk(v, c)
 		}
+	case *ColumnCharset:
+		Walk(v, n.Name)
+	case *ColumnCollation:
+		Walk(v, n.Name)
 	case *ColumnConstraint:
 		if n.Name != nil {
 			Walk(v, n.Name)
@@ -324,6 +561,12 @@ func Walk(v Visitor, node Node) {
 		walkIdentLists(v, n.Columns)
 	case *CheckColumnSpec:
 		Walk(v, n.Expr)
+	case *GeneratedColumnSpec:
+		Walk(v, n.Expr)
+	case *IdentityColumnSpec:
+		for _, o := range n.Options {
+			Walk(v, o)
+		}
 	case *AlterTableStmt:
 		Walk(v, n.TableName)
 		Walk(v, n.Action)
@@ -338,6 +581,9 @@ func Walk(v Visitor, node Node) {
 		// nothing to do
 	case *PGAlterDataTypeColumnAction:
 		Walk(v, n.DataType)
+		if n.Using != nil {
+			Walk(v, n.Using)
+		}
 	case *PGSetNotNullColumnAction:
 		// nothing to do
 	case *PGDropNotNullColumnAction:
@@ -348,9 +594,18 @@ func Walk(v Visitor, node Node) {
 		Walk(v, n.Constraint)
 	case *DropConstraintTableAction:
 		Walk(v, n.Name)
-	case *DropTableStmt:
-		for _, t := range n.TableNames {
-			Walk(v, t)
+	case *PGAttachPartitionTableAction:
+		Walk(v, n.Name)
+		Walk(v, n.Bound)
+	case *PGDetachPartitionTableAction:
+		Walk(v, n.Name)
+	case *PartitionBound:
+		walkASTNodeLists(v, n.In)
+		walkASTNodeLists(v, n.Lower)
+		walkASTNodeLists(v, n.Upper)
+	case *DropStmt:
+		for _, name := range n.Names {
+			Walk(v, name)
 		}
 	case *CreateIndexStmt:
 		Walk(v, n.TableName)
@@ -360,20 +615,96 @@ func Walk(v Visitor, node Node) {
 		if n.MethodName != nil {
 			Walk(v, n.MethodName)
 		}
-		walkIdentLists(v, n.ColumnNames)
+		for _, c := range n.Columns {
+			Walk(v, c)
+		}
 		if n.Selection != nil {
 			Walk(v, n.Selection)
 		}
-	case *DropIndexStmt:
-		walkIdentLists(v, n.IndexNames)
+	case *IndexColumn:
+		if n.Expr != nil {
+			Walk(v, n.Expr)
+		} else {
+			Walk(v, n.Name)
+		}
+		if n.OpClass != nil {
+			Walk(v, n.OpClass)
+		}
 	case *ExplainStmt:
 		Walk(v, n.Stmt)
+	case *PrepareStmt:
+		Walk(v, n.Name)
+		for _, t := range n.ParamTypes {
+			Walk(v, t)
+		}
+		Walk(v, n.Stmt)
+	case *ExecuteStmt:
+		Walk(v, n.Name)
+		walkASTNodeLists(v, n.Args)
+	case *DeallocateStmt:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+	case *SetStmt:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+		walkASTNodeLists(v, n.Values)
+	case *ShowStmt:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+	case *ResetStmt:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+	case *DoStmt:
+		Walk(v, n.Body)
+		if n.Language != nil {
+			Walk(v, n.Language)
+		}
+	case *DeclareCursorStmt:
+		Walk(v, n.Name)
+		Walk(v, n.Query)
+	case *FetchStmt:
+		if n.Count != nil {
+			Walk(v, n.Count)
+		}
+		Walk(v, n.Cursor)
+	case *CloseStmt:
+		if n.Cursor != nil {
+			Walk(v, n.Cursor)
+		}
+	case *CurrentOf:
+		Walk(v, n.Cursor)
+	case *BeginStmt:
+		for _, m := range n.Modes {
+			Walk(v, m)
+		}
+	case *TransactionMode, *CommitStmt:
+		// nothing to do
+	case *RollbackStmt:
+		if n.Savepoint != nil {
+			Walk(v, n.Savepoint)
+		}
+	case *SavepointStmt:
+		Walk(v, n.Name)
+	case *ReleaseSavepointStmt:
+		Walk(v, n.Name)
+	case *CommentStmt:
+		Walk(v, n.Name)
+		if n.Text != nil {
+			Walk(v, n.Text)
+		}
 	case *Operator:
 		// nothing to do
+	case *TypedLiteral:
+		Walk(v, n.Literal)
 	case *NullValue,
 		*LongValue,
 		*DoubleValue,
 		*SingleQuotedString,
+		*DollarQuotedString,
 		*NationalStringLiteral,
 		*BooleanValue,
 		*DateValue,
@@ -400,3 +731,43 @@ func (f inspector) Visit(node Node) Visitor {
 func Inspect(node Node, f func(node Node) bool) {
 	Walk(inspector(f), node)
 }
+
+// FunctionCalls returns all function calls in node in the order of Walk,
+// including the ones in subqueries, CTEs and arguments of other functions.
+func FunctionCalls(node Node) []*Function {
+	var calls []*Function
+	Inspect(node, func(node Node) bool {
+		if f, ok := node.(*Function); ok {
+			calls = append(calls, f)
+		}
+		return true
+	})
+	return calls
+}
+
+// IsReadOnly reports whether node only reads data. It is true for queries,
+// EXPLAIN of them and COPY ... TO STDOUT, and false for any other statement
+// including DDL. Queries are not read-only if they have a data-modifying CTE,
+// SELECT INTO, which creates a table, or a locking clause such as FOR UPDATE.
+// Function calls are not inspected, so functions with side effects like
+// nextval are not detected.
+func IsReadOnly(node Node) bool {
+	readOnly := true
+	Inspect(node, func(node Node) bool {
+		switch n := node.(type) {
+		case *File, *QueryStmt, *ExplainStmt:
+			// inspect children
+		case *CopyStmt:
+			// COPY ... TO a file writes it on the server
+			if t, ok := n.Target.(*Ident); !n.To || !ok || !strings.EqualFold(t.Value, "STDOUT") {
+				readOnly = false
+			}
+		case *SelectInto, *LockingClause:
+			readOnly = false
+		case Stmt:
+			readOnly = false
+		}
+		return readOnly
+	})
+	return readOnly
+}
@@ -130,22 +130,38 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		a.applyList(n, "Stmts")
 	case *sqlast.Ident:
 		// nothing to do
+	case *sqlast.Placeholder:
+		// nothing to do
+	case *sqlast.DefaultExpr:
+		// nothing to do
 	case *sqlast.Wildcard:
 		// nothing to do
 	case *sqlast.QualifiedWildcard:
 		a.applyList(n, "Idents")
 	case *sqlast.CompoundIdent:
 		a.applyList(n, "Idents")
+	case *sqlast.OuterJoinColumn:
+		a.apply(n, "Column", nil, n.Column)
 	case *sqlast.IsNull:
 		a.apply(n, "X", nil, n.X)
 	case *sqlast.IsNotNull:
 		a.apply(n, "X", nil, n.X)
+	case *sqlast.IsTruthValue:
+		a.apply(n, "X", nil, n.X)
+		a.apply(n, "Value", nil, n.Value)
 	case *sqlast.InList:
 		a.apply(n, "Expr", nil, n.Expr)
 		a.applyList(n, "List")
 	case *sqlast.InSubQuery:
 		a.apply(n, "Expr", nil, n.Expr)
 		a.apply(n, "SubQuery", nil, n.SubQuery)
+	case *sqlast.OverlapsExpr:
+		a.apply(n, "Left", nil, n.Left)
+		a.apply(n, "Right", nil, n.Right)
+	case *sqlast.QuantifiedComparison:
+		a.apply(n, "Left", nil, n.Left)
+		a.apply(n, "Op", nil, n.Op)
+		a.apply(n, "Right", nil, n.Right)
 	case *sqlast.Between:
 		a.apply(n, "Expr", nil, n.Expr)
 		a.apply(n, "Low", nil, n.Low)
@@ -154,9 +170,35 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		a.apply(n, "Left", nil, n.Left)
 		a.apply(n, "Op", nil, n.Op)
 		a.apply(n, "Right", nil, n.Right)
+	case *sqlast.AtTimeZone:
+		a.apply(n, "Expr", nil, n.Expr)
+		a.apply(n, "Zone", nil, n.Zone)
 	case *sqlast.Cast:
 		a.apply(n, "Expr", nil, n.Expr)
 		a.apply(n, "DateType", nil, n.DateType)
+	case *sqlast.TrimExpr:
+		if n.Chars != nil {
+			a.apply(n, "Chars", nil, n.Chars)
+		}
+		a.apply(n, "Expr", nil, n.Expr)
+	case *sqlast.SubstringExpr:
+		a.apply(n, "Expr", nil, n.Expr)
+		if n.From != nil {
+			a.apply(n, "From", nil, n.From)
+		}
+		if n.For != nil {
+			a.apply(n, "For", nil, n.For)
+		}
+	case *sqlast.ExtractExpr:
+		if n.Field != nil {
+			a.apply(n, "Field", nil, n.Field)
+		}
+		a.apply(n, "Expr", nil, n.Expr)
+	case *sqlast.PositionExpr:
+		a.apply(n, "Substr", nil, n.Substr)
+		a.apply(n, "Expr", nil, n.Expr)
+	case *sqlast.ArrayConstructor:
+		a.applyList(n, "Elements")
 	case *sqlast.Nested:
 		a.apply(n, "AST", nil, n.AST)
 	case *sqlast.UnaryExpr:
@@ -165,9 +207,20 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 	case *sqlast.Function:
 		a.apply(n, "Name", nil, n.Name)
 		a.applyList(n, "Args")
+		a.applyList(n, "OrderBy")
+		if n.Separator != nil {
+			a.apply(n, "Separator", nil, n.Separator)
+		}
+		a.applyList(n, "WithinGroup")
+		if n.Filter != nil {
+			a.apply(n, "Filter", nil, n.Filter)
+		}
 		if n.Over != nil {
 			a.apply(n, "Over", nil, n.Over)
 		}
+	case *sqlast.NamedArg:
+		a.apply(n, "Name", nil, n.Name)
+		a.apply(n, "Value", nil, n.Value)
 	case *sqlast.CaseExpr:
 		a.apply(n, "Operand", nil, n.Operand)
 	case *sqlast.Exists:
@@ -202,13 +255,28 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		if n.Limit != nil {
 			a.apply(n, "Limit", nil, n.Limit)
 		}
+		if n.Offset != nil {
+			a.apply(n, "Offset", nil, n.Offset)
+		}
+		if n.Fetch != nil {
+			a.apply(n, "Fetch", nil, n.Fetch)
+		}
+		a.applyList(n, "Locks")
+	case *sqlast.LockingClause:
+		a.applyList(n, "Tables")
 	case *sqlast.CTE:
-		a.apply(n, "QueryStmt", nil, n.Query)
+		if n.Query != nil {
+			a.apply(n, "Query", nil, n.Query)
+		} else {
+			a.apply(n, "Stmt", nil, n.Stmt)
+		}
 		a.apply(n, "Alias", nil, n.Alias)
 	case *sqlast.SelectExpr:
 		a.apply(n, "Select", nil, n.Select)
 	case *sqlast.QueryExpr:
 		a.apply(n, "QueryStmt", nil, n.Query)
+	case *sqlast.TableQueryExpr:
+		a.apply(n, "Name", nil, n.Name)
 	case *sqlast.SetOperationExpr:
 		a.apply(n, "Op", nil, n.Op)
 		a.apply(n, "Left", nil, n.Left)
@@ -220,7 +288,11 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 	case *sqlast.IntersectOperator:
 		// nothing to do
 	case *sqlast.SQLSelect:
+		a.applyList(n, "DistinctOn")
 		a.applyList(n, "Projection")
+		if n.Into != nil {
+			a.apply(n, "Into", nil, n.Into)
+		}
 		a.applyList(n, "FromClause")
 		if n.WhereClause != nil {
 			a.apply(n, "WhereClause", nil, n.WhereClause)
@@ -229,6 +301,8 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		if n.HavingClause != nil {
 			a.apply(n, "HavingClause", nil, n.HavingClause)
 		}
+	case *sqlast.SelectInto:
+		a.apply(n, "Name", nil, n.Name)
 	case *sqlast.QualifiedJoin:
 		a.apply(n, "LeftElement", nil, n.LeftElement)
 		a.apply(n, "Type", nil, n.Type)
@@ -252,13 +326,53 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		if n.Alias != nil {
 			a.apply(n, "Alias", nil, n.Alias)
 		}
+		a.applyList(n, "AliasColumns")
+		a.applyList(n, "ColumnDefs")
 		a.applyList(n, "Args")
 		a.applyList(n, "WithHints")
+		if n.Sample != nil {
+			a.apply(n, "Sample", nil, n.Sample)
+		}
+		a.applyList(n, "IndexHints")
+	case *sqlast.TableSample:
+		a.apply(n, "Method", nil, n.Method)
+		a.applyList(n, "Args")
+		if n.Seed != nil {
+			a.apply(n, "Seed", nil, n.Seed)
+		}
+	case *sqlast.IndexHint:
+		a.applyList(n, "Indexes")
 	case *sqlast.Derived:
 		a.apply(n, "SubQuery", nil, n.SubQuery)
 		if n.Alias != nil {
 			a.apply(n, "Alias", nil, n.Alias)
 		}
+	case *sqlast.JSONTable:
+		a.apply(n, "Expr", nil, n.Expr)
+		a.apply(n, "Path", nil, n.Path)
+		a.applyList(n, "Columns")
+		if n.Alias != nil {
+			a.apply(n, "Alias", nil, n.Alias)
+		}
+	case *sqlast.JSONTableOrdinalityColumn:
+		a.apply(n, "Name", nil, n.Name)
+	case *sqlast.JSONTablePathColumn:
+		a.apply(n, "Name", nil, n.Name)
+		a.apply(n, "DataType", nil, n.DataType)
+		a.apply(n, "Path", nil, n.Path)
+		if n.OnEmpty != nil {
+			a.apply(n, "OnEmpty", nil, n.OnEmpty)
+		}
+		if n.OnError != nil {
+			a.apply(n, "OnError", nil, n.OnError)
+		}
+	case *sqlast.JSONTableNestedColumn:
+		a.apply(n, "Path", nil, n.Path)
+		a.applyList(n, "Columns")
+	case *sqlast.JSONBehavior:
+		if n.Default != nil {
+			a.apply(n, "Default", nil, n.Default)
+		}
 	case *sqlast.UnnamedSelectItem:
 		a.apply(n, "Node", nil, n.Node)
 	case *sqlast.AliasSelectItem:
@@ -266,8 +380,23 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		a.apply(n, "Alias", nil, n.Alias)
 	case *sqlast.QualifiedWildcardSelectItem:
 		a.apply(n, "Prefix", nil, n.Prefix)
+		if n.Exclude != nil {
+			a.apply(n, "Exclude", nil, n.Exclude)
+		}
+		if n.Replace != nil {
+			a.apply(n, "Replace", nil, n.Replace)
+		}
 	case *sqlast.WildcardSelectItem:
-		// nothing to do
+		if n.Exclude != nil {
+			a.apply(n, "Exclude", nil, n.Exclude)
+		}
+		if n.Replace != nil {
+			a.apply(n, "Replace", nil, n.Replace)
+		}
+	case *sqlast.ExcludeColumns:
+		a.applyList(n, "Columns")
+	case *sqlast.ReplaceColumns:
+		a.applyList(n, "Items")
 	case *sqlast.OrderByExpr:
 		a.apply(n, "Expr", nil, n.Expr)
 	case *sqlast.LimitExpr:
@@ -277,6 +406,12 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		if n.OffsetValue != nil {
 			a.apply(n, "OffsetValue", nil, n.OffsetValue)
 		}
+	case *sqlast.OffsetExpr:
+		a.apply(n, "Value", nil, n.Value)
+	case *sqlast.FetchExpr:
+		if n.Quantity != nil {
+			a.apply(n, "Quantity", nil, n.Quantity)
+		}
 	case *sqlast.CharType:
 		// nothing to do
 	case *sqlast.VarcharType:
@@ -328,30 +463,76 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		a.applyList(n, "Columns")
 		a.apply(n, "Source", nil, n.Source)
 		a.applyList(n, "UpdateAssignments")
+		a.applyList(n, "Returning")
+		if n.ReturningInto != nil {
+			a.apply(n, "ReturningInto", nil, n.ReturningInto)
+		}
 	case *sqlast.ConstructorSource:
 		a.applyList(n, "Rows")
 	case *sqlast.RowValueExpr:
 		a.applyList(n, "Values")
 	case *sqlast.SubQuerySource:
 		a.apply(n, "SubQuery", nil, n.SubQuery)
+	case *sqlast.DefaultValuesSource:
+		// nothing to do
 	case *sqlast.CopyStmt:
-		a.apply(n, "TableName", nil, n.TableName)
+		if n.TableName != nil {
+			a.apply(n, "TableName", nil, n.TableName)
+		}
 		a.applyList(n, "Columns")
+		if n.Query != nil {
+			a.apply(n, "Query", nil, n.Query)
+		}
+		a.apply(n, "Target", nil, n.Target)
+		a.applyList(n, "Options")
+	case *sqlast.CopyOption:
+		a.apply(n, "Name", nil, n.Name)
+		if n.Value != nil {
+			a.apply(n, "Value", nil, n.Value)
+		}
 	case *sqlast.UpdateStmt:
 		a.apply(n, "TableName", nil, n.TableName)
 		a.applyList(n, "Assignments")
 		a.apply(n, "Selection", nil, n.Selection)
+		a.applyList(n, "Returning")
+		if n.ReturningInto != nil {
+			a.apply(n, "ReturningInto", nil, n.ReturningInto)
+		}
 	case *sqlast.DeleteStmt:
 		a.apply(n, "TableName", nil, n.TableName)
+		a.applyList(n, "Using")
 		if n.Selection != nil {
 			a.apply(n, "Selection", nil, n.Selection)
 		}
+		a.applyList(n, "Returning")
+		if n.ReturningInto != nil {
+			a.apply(n, "ReturningInto", nil, n.ReturningInto)
+		}
+	case *sqlast.ReturningInto:
+		a.applyList(n, "Targets")
 	case *sqlast.CreateViewStmt:
 		a.apply(n, "Name", nil, n.Name)
-		a.apply(n, "QueryStmt", nil, n.Query)
+		a.applyList(n, "Options")
+		a.apply(n, "Query", nil, n.Query)
+	case *sqlast.ViewOption:
+		a.apply(n, "Name", nil, n.Name)
+		if n.Value != nil {
+			a.apply(n, "Value", nil, n.Value)
+		}
+	case *sqlast.CreateSequenceStmt:
+		a.apply(n, "Name", nil, n.Name)
+		a.applyList(n, "Options")
+	case *sqlast.SequenceOption:
+		if n.Value != nil {
+			a.apply(n, "Value", nil, n.Value)
+		}
 	case *sqlast.CreateTableStmt:
 		a.apply(n, "Name", nil, n.Name)
 		a.applyList(n, "Elements")
+		a.applyList(n, "Columns")
+		if n.Query != nil {
+			a.apply(n, "Query", nil, n.Query)
+		}
 	case *sqlast.Assignment:
 		a.apply(n, "ID", nil, n.ID)
 		a.apply(n, "Value", nil, n.Value)
@@ -373,10 +554,20 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 	case *sqlast.ColumnDef:
 		a.apply(n, "Name", nil, n.Name)
 		a.apply(n, "DataType", nil, n.DataType)
+		if n.Charset != nil {
+			a.apply(n, "Charset", nil, n.Charset)
+		}
+		if n.Collation != nil {
+			a.apply(n, "Collation", nil, n.Collation)
+		}
 		if n.Default != nil {
 			a.apply(n, "Default", nil, n.Default)
 		}
 		a.applyList(n, "Constraints")
+	case *sqlast.ColumnCharset:
+		a.apply(n, "Name", nil, n.Name)
+	case *sqlast.ColumnCollation:
+		a.apply(n, "Name", nil, n.Name)
 	case *sqlast.ColumnConstraint:
 		if n.Name != nil {
 			a.apply(n, "Name", nil, n.Name)
@@ -391,6 +582,10 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		a.applyList(n, "Columns")
 	case *sqlast.CheckColumnSpec:
 		a.apply(n, "Expr", nil, n.Expr)
+	case *sqlast.GeneratedColumnSpec:
+		a.apply(n, "Expr", nil, n.Expr)
+	case *sqlast.IdentityColumnSpec:
+		a.applyList(n, "Options")
 	case *sqlast.AlterTableStmt:
 		a.apply(n, "TableName", nil, n.TableName)
 		a.apply(n, "Action", nil, n.Action)
@@ -405,6 +600,9 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		// nothing to do
 	case *sqlast.PGAlterDataTypeColumnAction:
 		a.apply(n, "DataType", nil, n.DataType)
+		if n.Using != nil {
+			a.apply(n, "Using", nil, n.Using)
+		}
 	case *sqlast.PGSetNotNullColumnAction:
 		// nothing to do
 	case *sqlast.PGDropNotNullColumnAction:
@@ -415,8 +613,17 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		a.apply(n, "Constraint", nil, n.Constraint)
 	case *sqlast.DropConstraintTableAction:
 		a.apply(n, "Name", nil, n.Name)
-	case *sqlast.DropTableStmt:
-		a.applyList(n, "TableNames")
+	case *sqlast.PGAttachPartitionTableAction:
+		a.apply(n, "Name", nil, n.Name)
+		a.apply(n, "Bound", nil, n.Bound)
+	case *sqlast.PGDetachPartitionTableAction:
+		a.apply(n, "Name", nil, n.Name)
+	case *sqlast.PartitionBound:
+		a.applyList(n, "In")
+		a.applyList(n, "Lower")
+		a.applyList(n, "Upper")
+	case *sqlast.DropStmt:
+		a.applyList(n, "Names")
 	case *sqlast.CreateIndexStmt:
 		a.apply(n, "TableName", nil, n.TableName)
 		if n.IndexName != nil {
@@ -425,20 +632,90 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		if n.MethodName != nil {
 			a.apply(n, "MethodName", nil, n.MethodName)
 		}
-		a.applyList(n, "ColumnNames")
+		a.applyList(n, "Columns")
 		if n.Selection != nil {
 			a.apply(n, "Selection", nil, n.Selection)
 		}
-	case *sqlast.DropIndexStmt:
-		a.applyList(n, "IndexNames")
+	case *sqlast.IndexColumn:
+		if n.Expr != nil {
+			a.apply(n, "Expr", nil, n.Expr)
+		} else {
+			a.apply(n, "Name", nil, n.Name)
+		}
+		if n.OpClass != nil {
+			a.apply(n, "OpClass", nil, n.OpClass)
+		}
 	case *sqlast.ExplainStmt:
 		a.apply(n, "Stmt", nil, n.Stmt)
+	case *sqlast.PrepareStmt:
+		a.apply(n, "Name", nil, n.Name)
+		a.applyList(n, "ParamTypes")
+		a.apply(n, "Stmt", nil, n.Stmt)
+	case *sqlast.ExecuteStmt:
+		a.apply(n, "Name", nil, n.Name)
+		a.applyList(n, "Args")
+	case *sqlast.DeallocateStmt:
+		if n.Name != nil {
+			a.apply(n, "Name", nil, n.Name)
+		}
+	case *sqlast.SetStmt:
+		if n.Name != nil {
+			a.apply(n, "Name", nil, n.Name)
+		}
+		a.applyList(n, "Values")
+	case *sqlast.ShowStmt:
+		if n.Name != nil {
+			a.apply(n, "Name", nil, n.Name)
+		}
+	case *sqlast.ResetStmt:
+		if n.Name != nil {
+			a.apply(n, "Name", nil, n.Name)
+		}
+	case *sqlast.DoStmt:
+		a.apply(n, "Body", nil, n.Body)
+		if n.Language != nil {
+			a.apply(n, "Language", nil, n.Language)
+		}
+	case *sqlast.DeclareCursorStmt:
+		a.apply(n, "Name", nil, n.Name)
+		a.apply(n, "Query", nil, n.Query)
+	case *sqlast.FetchStmt:
+		if n.Count != nil {
+			a.apply(n, "Count", nil, n.Count)
+		}
+		a.apply(n, "Cursor", nil, n.Cursor)
+	case *sqlast.CloseStmt:
+		if n.Cursor != nil {
+			a.apply(n, "Cursor", nil, n.Cursor)
+		}
+	case *sqlast.CurrentOf:
+		a.apply(n, "Cursor", nil, n.Cursor)
+	case *sqlast.BeginStmt:
+		a.applyList(n, "Modes")
+	case *sqlast.TransactionMode, *sqlast.CommitStmt:
+		// nothing to do
+	case *sqlast.RollbackStmt:
+		if n.Savepoint != nil {
+			a.apply(n, "Savepoint", nil, n.Savepoint)
+		}
+	case *sqlast.SavepointStmt:
+		a.apply(n, "Name", nil, n.Name)
+	case *sqlast.ReleaseSavepointStmt:
+		a.apply(n, "Name", nil, n.Name)
+	case *sqlast.CommentStmt:
+		a.apply(n, "Name", nil, n.Name)
+		if n.Text != nil {
+			a.apply(n, "Text", nil, n.Text)
+		}
 	case *sqlast.Operator:
 		// nothing to do
+	case *sqlast.TypedLiteral:
+		a.apply(n, "Literal", nil, n.Literal)
 	case *sqlast.NullValue,
 		*sqlast.LongValue,
 		*sqlast.DoubleValue,
 		*sqlast.SingleQuotedString,
+		*sqlast.DollarQuotedString,
 		*sqlast.NationalStringLiteral,
 		*sqlast.BooleanValue,
 		*sqlast.DateValue,
@@ -0,0 +1,61 @@
+package sqlastutil
+
+import (
+	"fmt"
+
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+// Warning is a positioned message reported by structural checks.
+// Unlike parse errors, the statement is still valid syntax.
+type Warning struct {
+	Pos     sqltoken.Pos
+	Message string
+}
+
+func (w *Warning) String() string {
+	return fmt.Sprintf("%d:%d: %s", w.Pos.Line, w.Pos.Col, w.Message)
+}
+
+// CheckDistinctOn reports queries whose DISTINCT ON expressions don't match
+// the leftmost ORDER BY expressions, which PostgreSQL rejects.
+// Expressions are compared structurally by their SQL string.
+func CheckDistinctOn(root sqlast.Node) []*Warning {
+	var warnings []*Warning
+
+	sqlast.Inspect(root, func(node sqlast.Node) bool {
+		q, ok := node.(*sqlast.QueryStmt)
+		if !ok {
+			return true
+		}
+		sel, ok := q.Body.(*sqlast.SQLSelect)
+		if !ok || len(sel.DistinctOn) == 0 || len(q.OrderBy) == 0 {
+			return true
+		}
+
+		remain := make(map[string]struct{}, len(sel.DistinctOn))
+		for _, d := range sel.DistinctOn {
+			remain[d.ToSQLString()] = struct{}{}
+		}
+
+		for _, o := range q.OrderBy {
+			if len(remain) == 0 {
+				break
+			}
+			str := o.Expr.ToSQLString()
+			if _, ok := remain[str]; !ok {
+				warnings = append(warnings, &Warning{
+					Pos:     o.Pos(),
+					Message: fmt.Sprintf("SELECT DISTINCT ON expressions must match initial ORDER BY expressions but got %s", str),
+				})
+				break
+			}
+			delete(remain, str)
+		}
+
+		return true
+	})
+
+	return warnings
+}
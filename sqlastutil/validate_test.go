@@ -0,0 +1,66 @@
+package sqlastutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/akito0107/xsqlparser"
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+func TestCheckDistinctOn(t *testing.T) {
+	cases := []struct {
+		name   string
+		src    string
+		expect []*Warning
+	}{
+		{
+			name: "matching order by",
+			src:  "SELECT DISTINCT ON (a, b) a, b, c FROM t ORDER BY b, a, c DESC",
+		},
+		{
+			name: "without order by",
+			src:  "SELECT DISTINCT ON (a) a, b FROM t",
+		},
+		{
+			name: "mismatching order by",
+			src:  "SELECT DISTINCT ON (a) a, b FROM t ORDER BY b, a",
+			expect: []*Warning{
+				{
+					Pos:     sqltoken.NewPos(1, 45),
+					Message: "SELECT DISTINCT ON expressions must match initial ORDER BY expressions but got b",
+				},
+			},
+		},
+		{
+			name: "mismatching in subquery",
+			src:  "SELECT * FROM (SELECT DISTINCT ON (a) a, b FROM t ORDER BY c) AS s",
+			expect: []*Warning{
+				{
+					Pos:     sqltoken.NewPos(1, 60),
+					Message: "SELECT DISTINCT ON expressions must match initial ORDER BY expressions but got c",
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := xsqlparser.NewParser(bytes.NewBufferString(c.src), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(c.expect, CheckDistinctOn(stmt)); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package sqltoken
+
+import (
+	"strings"
+
+	errors "golang.org/x/xerrors"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+// CanonicalizeOption configures Canonicalize.
+type CanonicalizeOption func(*canonicalizer)
+
+type canonicalizer struct {
+	replaceLiterals bool
+}
+
+// ReplaceLiterals makes Canonicalize replace string and numeric literals with `?`.
+// Literals are kept by default.
+func ReplaceLiterals(c *canonicalizer) {
+	c.replaceLiterals = true
+}
+
+// Canonicalize re-emits the tokens of src without parsing it, for fingerprinting or logging.
+// Comments are removed, keywords are upper-cased, and tokens are separated by a single space
+// except after `(` and `.`, and before `)`, `,`, `.` and `;`.
+// The contents of string literals and quoted identifiers are kept as they are,
+// and hexadecimal and bit string literals such as X'41' and B'0101' are kept as one literal.
+func Canonicalize(src string, d dialect.Dialect, opts ...CanonicalizeOption) (string, error) {
+	var c canonicalizer
+	for _, o := range opts {
+		o(&c)
+	}
+
+	toks, err := Tokenize(src, d, SkipWhitespace, SkipComments)
+	if err != nil {
+		return "", errors.Errorf("Tokenize failed: %w", err)
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(toks); i++ {
+		tok := toks[i]
+		if i != 0 && spaceBetween(toks[i-1].Kind, tok.Kind) {
+			b.WriteByte(' ')
+		}
+		if i+1 < len(toks) && isStringPrefix(tok, toks[i+1]) {
+			i++
+			if c.replaceLiterals {
+				b.WriteString("?")
+			} else {
+				b.WriteString(strings.ToUpper(tok.Value.(*SQLWord).Value) + c.tokenString(toks[i]))
+			}
+			continue
+		}
+		b.WriteString(c.tokenString(tok))
+	}
+
+	return b.String(), nil
+}
+
+func (c *canonicalizer) tokenString(tok *Token) string {
+	switch tok.Kind {
+	case SQLKeyword:
+		w := tok.Value.(*SQLWord)
+		if _, ok := dialect.Keywords[w.Keyword]; ok && w.QuoteStyle == 0 {
+			return w.Keyword
+		}
+		return w.String()
+	case SingleQuotedString, NationalStringLiteral, UnicodeStringLiteral, Number:
+		if c.replaceLiterals {
+			return "?"
+		}
+		s := tok.Value.(string)
+		if tok.Kind == Number {
+			return s
+		}
+		s = "'" + strings.ReplaceAll(s, "'", "''") + "'"
+		switch tok.Kind {
+		case NationalStringLiteral:
+			s = "N" + s
+		case UnicodeStringLiteral:
+			s = "U&" + s
+		}
+		return s
+	case DollarQuotedString:
+		if c.replaceLiterals {
+			return "?"
+		}
+		return tok.Value.(*DollarQuote).String()
+	}
+	return tok.Value.(string)
+}
+
+// isStringPrefix reports whether tok is X or B written right before the string
+// of a hexadecimal or bit string literal, which is tokenized as a word and a string.
+func isStringPrefix(tok, next *Token) bool {
+	if tok.Kind != SQLKeyword || next.Kind != SingleQuotedString || tok.To != next.From {
+		return false
+	}
+	w := tok.Value.(*SQLWord)
+	return w.QuoteStyle == 0 && (strings.EqualFold(w.Value, "X") || strings.EqualFold(w.Value, "B"))
+}
+
+func spaceBetween(prev, next Kind) bool {
+	switch prev {
+	case LParen, Period:
+		return false
+	}
+	switch next {
+	case RParen, Comma, Period, Semicolon:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,102 @@
+package sqltoken
+
+import (
+	"testing"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+func TestCanonicalize(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      []string
+		out     string
+		opts    []CanonicalizeOption
+		dialect dialect.Dialect
+	}{
+		{
+			name: "whitespace and comments",
+			in: []string{
+				"select a,b from t  where x = 'A  b' -- comment",
+				"SELECT a , b\n\tFROM t /* c */ WHERE x='A  b'",
+			},
+			out: "SELECT a, b FROM t WHERE x = 'A  b'",
+		},
+		{
+			name: "functions and qualified names",
+			in: []string{
+				"select count( * ) from s.t where t.id in ( 1,2 );",
+				"SELECT count(*) FROM s . t WHERE t.id IN (1, 2) ;",
+			},
+			out: "SELECT COUNT (*) FROM s.t WHERE t.id IN (1, 2);",
+		},
+		{
+			name: "quoted",
+			in: []string{
+				`SELECT "Select", 'it''s -- not a comment', N'x' FROM t`,
+			},
+			out: `SELECT "Select", 'it''s -- not a comment', N'x' FROM t`,
+		},
+		{
+			name: "replace literals",
+			in: []string{
+				"SELECT * FROM t WHERE name = 'alice' AND age > 20",
+				"select * from t where name = 'bob' and age > 30.5",
+			},
+			out:  "SELECT * FROM t WHERE name = ? AND age > ?",
+			opts: []CanonicalizeOption{ReplaceLiterals},
+		},
+		{
+			name: "hexadecimal and bit strings",
+			in: []string{
+				"select x'41', b'0101', x from t where x = X'ff'",
+			},
+			out: "SELECT X'41', B'0101', x FROM t WHERE x = X'ff'",
+		},
+		{
+			name: "replace hexadecimal and bit strings",
+			in: []string{
+				"SELECT X'41', B'0101' FROM t",
+				"SELECT 'A', 5 FROM t",
+			},
+			out:  "SELECT ?, ? FROM t",
+			opts: []CanonicalizeOption{ReplaceLiterals},
+		},
+		{
+			name: "dollar quoted",
+			in: []string{
+				"select $$it's$$, $x$ -- y $x$ from t",
+			},
+			out:     "SELECT $$it's$$, $x$ -- y $x$ FROM t",
+			dialect: &dialect.PostgresqlDialect{},
+		},
+		{
+			name: "replace dollar quoted",
+			in: []string{
+				"SELECT * FROM t WHERE a = $$x$$ AND b = $t$y$t$",
+				"SELECT * FROM t WHERE a = 'x' AND b = 'y'",
+			},
+			out:     "SELECT * FROM t WHERE a = ? AND b = ?",
+			opts:    []CanonicalizeOption{ReplaceLiterals},
+			dialect: &dialect.PostgresqlDialect{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := c.dialect
+			if d == nil {
+				d = &dialect.GenericSQLDialect{}
+			}
+			for _, in := range c.in {
+				out, err := Canonicalize(in, d, c.opts...)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if out != c.out {
+					t.Errorf("should be %q but %q", c.out, out)
+				}
+			}
+		})
+	}
+}
@@ -66,6 +66,73 @@ const (
 	LBrace
 	// Right brace `}`
 	RBrace
+	// Placeholder of prepared statement i.e: $1, ?
+	Placeholder
+	// Tilde `~`
+	Tilde
+	// Double pipe `||`
+	DoublePipe
+	// Pipe `|`
+	Pipe
+	// Caret `^`
+	Caret
+	// Right arrow `=>` of named argument
+	RArrow
+	// Colon equal `:=` of named argument
+	ColonEq
+	// Case insensitive regex match `~*` (PostgreSQL)
+	TildeAsterisk
+	// Regex not match `!~` (PostgreSQL)
+	ExclamationTilde
+	// Case insensitive regex not match `!~*` (PostgreSQL)
+	ExclamationTildeAsterisk
+	// String with Unicode escapes i.e: U&'d\0061t\0061' (PostgreSQL)
+	UnicodeStringLiteral
+	// Dollar quoted string i.e: $$string$$, $tag$string$tag$ (PostgreSQL)
+	DollarQuotedString
+	// Shift left `<<`
+	ShiftLeft
+	// Shift right `>>`
+	ShiftRight
 	// ILLEGAL sqltoken
 	ILLEGAL
 )
+
+// IsKeyword reports whether k is a word, which is either a keyword or an identifier.
+func (k Kind) IsKeyword() bool {
+	return k == SQLKeyword
+}
+
+// IsLiteral reports whether k is a literal value or a placeholder standing for one.
+func (k Kind) IsLiteral() bool {
+	switch k {
+	case Number, SingleQuotedString, NationalStringLiteral, UnicodeStringLiteral, DollarQuotedString, Placeholder:
+		return true
+	}
+	return false
+}
+
+// IsOperator reports whether k is an operator such as `+`, `<=` or `::`.
+func (k Kind) IsOperator() bool {
+	switch k {
+	case Eq, Neq, Lt, Gt, LtEq, GtEq,
+		Plus, Minus, Mult, Div, Mod,
+		Ampersand, Tilde, DoublePipe, Pipe, Caret, DoubleColon,
+		ShiftLeft, ShiftRight,
+		TildeAsterisk, ExclamationTilde, ExclamationTildeAsterisk:
+		return true
+	}
+	return false
+}
+
+// IsPunctuation reports whether k is a delimiter such as `,`, `(` or `;`.
+// Markers of named arguments (`=>` and `:=`) are punctuation too.
+func (k Kind) IsPunctuation() bool {
+	switch k {
+	case Comma, Period, Colon, Semicolon, Backslash,
+		LParen, RParen, LBracket, RBracket, LBrace, RBrace,
+		RArrow, ColonEq:
+		return true
+	}
+	return false
+}
@@ -39,12 +39,26 @@ func _() {
 	_ = x[Ampersand-28]
 	_ = x[LBrace-29]
 	_ = x[RBrace-30]
-	_ = x[ILLEGAL-31]
+	_ = x[Placeholder-31]
+	_ = x[Tilde-32]
+	_ = x[DoublePipe-33]
+	_ = x[Pipe-34]
+	_ = x[Caret-35]
+	_ = x[RArrow-36]
+	_ = x[ColonEq-37]
+	_ = x[TildeAsterisk-38]
+	_ = x[ExclamationTilde-39]
+	_ = x[ExclamationTildeAsterisk-40]
+	_ = x[UnicodeStringLiteral-41]
+	_ = x[DollarQuotedString-42]
+	_ = x[ShiftLeft-43]
+	_ = x[ShiftRight-44]
+	_ = x[ILLEGAL-45]
 }
 
-const _Kind_name = "SQLKeywordNumberCharSingleQuotedStringNationalStringLiteralCommaWhitespaceCommentEqNeqLtGtLtEqGtEqPlusMinusMultDivModLParenRParenPeriodColonDoubleColonSemicolonBackslashLBracketRBracketAmpersandLBraceRBraceILLEGAL"
+const _Kind_name = "SQLKeywordNumberCharSingleQuotedStringNationalStringLiteralCommaWhitespaceCommentEqNeqLtGtLtEqGtEqPlusMinusMultDivModLParenRParenPeriodColonDoubleColonSemicolonBackslashLBracketRBracketAmpersandLBraceRBracePlaceholderTildeDoublePipePipeCaretRArrowColonEqTildeAsteriskExclamationTildeExclamationTildeAsteriskUnicodeStringLiteralDollarQuotedStringShiftLeftShiftRightILLEGAL"
 
-var _Kind_index = [...]uint8{0, 10, 16, 20, 38, 59, 64, 74, 81, 83, 86, 88, 90, 94, 98, 102, 107, 111, 114, 117, 123, 129, 135, 140, 151, 160, 169, 177, 185, 194, 200, 206, 213}
+var _Kind_index = [...]uint16{0, 10, 16, 20, 38, 59, 64, 74, 81, 83, 86, 88, 90, 94, 98, 102, 107, 111, 114, 117, 123, 129, 135, 140, 151, 160, 169, 177, 185, 194, 200, 206, 217, 222, 232, 236, 241, 247, 254, 267, 283, 307, 327, 345, 354, 364, 371}
 
 func (i Kind) String() string {
 	if i < 0 || i >= Kind(len(_Kind_index)-1) {
@@ -0,0 +1,51 @@
+package sqltoken
+
+import "testing"
+
+func TestKind_Classification(t *testing.T) {
+	var keywords, literals, operators, punctuations, others int
+
+	for k := SQLKeyword; k <= ILLEGAL; k++ {
+		var n int
+		if k.IsKeyword() {
+			keywords++
+			n++
+		}
+		if k.IsLiteral() {
+			literals++
+			n++
+		}
+		if k.IsOperator() {
+			operators++
+			n++
+		}
+		if k.IsPunctuation() {
+			punctuations++
+			n++
+		}
+		switch n {
+		case 0:
+			others++
+		case 1:
+		default:
+			t.Errorf("%s is classified into %d categories", k, n)
+		}
+	}
+
+	if keywords != 1 {
+		t.Errorf("keywords must be 1 but %d", keywords)
+	}
+	if literals != 6 {
+		t.Errorf("literals must be 6 but %d", literals)
+	}
+	if operators != 22 {
+		t.Errorf("operators must be 22 but %d", operators)
+	}
+	if punctuations != 13 {
+		t.Errorf("punctuations must be 13 but %d", punctuations)
+	}
+	// Char, Whitespace, Comment and ILLEGAL
+	if others != 4 {
+		t.Errorf("others must be 4 but %d", others)
+	}
+}
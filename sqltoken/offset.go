@@ -0,0 +1,75 @@
+package sqltoken
+
+import (
+	"unicode/utf8"
+)
+
+// Offset returns the byte offset of p in src, or -1 if p does not point
+// to the start of a rune (or the end) of src.
+// Positions are counted in the same way as Tokenizer with DefaultTabWidth:
+// every rune advances one column, a tab advances DefaultTabWidth columns,
+// and each of "\n", "\r\n" and "\r" starts a new line.
+func Offset(src string, p Pos) int {
+	return OffsetWithTabWidth(src, p, DefaultTabWidth)
+}
+
+// OffsetWithTabWidth is same as Offset but a tab advances tabWidth columns.
+// tabWidth must be the TabWidth of the Tokenizer which gave p.
+func OffsetWithTabWidth(src string, p Pos, tabWidth int) int {
+	cur := NewPos(1, 1)
+	for i := 0; i <= len(src); {
+		if cur == p {
+			return i
+		}
+		if ComparePos(cur, p) > 0 || i == len(src) {
+			return -1
+		}
+		cur, i = advance(src, cur, i, tabWidth)
+	}
+	return -1
+}
+
+// PosAt returns the position of the rune at byte offset in src.
+// The offset is clamped to [0, len(src)], and an offset in the middle of
+// a multi-byte rune or of "\r\n" returns the position of its first byte.
+// Tabs advance DefaultTabWidth columns as in Offset.
+func PosAt(src string, offset int) Pos {
+	return PosAtWithTabWidth(src, offset, DefaultTabWidth)
+}
+
+// PosAtWithTabWidth is same as PosAt but a tab advances tabWidth columns.
+func PosAtWithTabWidth(src string, offset, tabWidth int) Pos {
+	if offset > len(src) {
+		offset = len(src)
+	}
+	cur := NewPos(1, 1)
+	for i := 0; i < offset; {
+		next, n := advance(src, cur, i, tabWidth)
+		if n > offset {
+			break
+		}
+		cur, i = next, n
+	}
+	return cur
+}
+
+// advance returns the position and the byte offset after the rune at offset i.
+func advance(src string, cur Pos, i, tabWidth int) (Pos, int) {
+	r, size := utf8.DecodeRuneInString(src[i:])
+	switch r {
+	case '\t':
+		cur.Col += tabWidth
+	case '\r':
+		if i+size < len(src) && src[i+size] == '\n' {
+			size++
+		}
+		cur.Line++
+		cur.Col = 1
+	case '\n':
+		cur.Line++
+		cur.Col = 1
+	default:
+		cur.Col++
+	}
+	return cur, i + size
+}
@@ -0,0 +1,107 @@
+package sqltoken
+
+import (
+	"testing"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+func TestOffset(t *testing.T) {
+	cases := []struct {
+		name   string
+		src    string
+		pos    Pos
+		offset int
+	}{
+		{
+			name:   "first",
+			src:    "SELECT 1",
+			pos:    NewPos(1, 1),
+			offset: 0,
+		},
+		{
+			name:   "end",
+			src:    "SELECT 1",
+			pos:    NewPos(1, 9),
+			offset: 8,
+		},
+		{
+			name:   "after tab",
+			src:    "\tSELECT",
+			pos:    NewPos(1, 5),
+			offset: 1,
+		},
+		{
+			name:   "next line",
+			src:    "SELECT\n1",
+			pos:    NewPos(2, 1),
+			offset: 7,
+		},
+		{
+			name:   "crlf",
+			src:    "SELECT\r\n  1",
+			pos:    NewPos(2, 3),
+			offset: 10,
+		},
+		{
+			name:   "multi-byte runes",
+			src:    "'あい' = x",
+			pos:    NewPos(1, 6),
+			offset: 9,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if o := Offset(c.src, c.pos); o != c.offset {
+				t.Errorf("expected offset %d but %d", c.offset, o)
+			}
+			if p := PosAt(c.src, c.offset); p != c.pos {
+				t.Errorf("expected pos %+v but %+v", c.pos, p)
+			}
+		})
+	}
+
+	t.Run("tab width", func(t *testing.T) {
+		if o := OffsetWithTabWidth("\tSELECT", NewPos(1, 9), 8); o != 1 {
+			t.Errorf("expected offset 1 but %d", o)
+		}
+		if p := PosAtWithTabWidth("\tSELECT", 1, 1); p != NewPos(1, 2) {
+			t.Errorf("expected {1 2} but %+v", p)
+		}
+	})
+
+	t.Run("out of source", func(t *testing.T) {
+		for _, p := range []Pos{NewPos(1, 14), NewPos(2, 1), NewPos(1, 3)} {
+			if o := Offset("\tSELECT 1", p); o != -1 {
+				t.Errorf("%+v: expected -1 but %d", p, o)
+			}
+		}
+		if p := PosAt("SELECT", 100); p != NewPos(1, 7) {
+			t.Errorf("expected end of source but %+v", p)
+		}
+		if p := PosAt("'あ'", 2); p != NewPos(1, 2) {
+			t.Errorf("expected start of rune but %+v", p)
+		}
+	})
+
+	t.Run("agree with tokenizer", func(t *testing.T) {
+		src := "SELECT\ta,\r\n\t'いろは' AS b -- コメント\n  FROM t\rWHERE /* x\n */ c = 1"
+		toks, err := Tokenize(src, &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, tok := range toks {
+			o := Offset(src, tok.From)
+			if o < 0 {
+				t.Fatalf("%+v: offset not found", tok)
+			}
+			if p := PosAt(src, o); p != tok.From {
+				t.Errorf("%+v: expected %+v but %+v", tok, tok.From, p)
+			}
+			if o := Offset(src, tok.To); o < 0 {
+				t.Errorf("%+v: offset of end not found", tok)
+			}
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package sqltoken
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	errors "golang.org/x/xerrors"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+// Edit is a change of a source text which replaces the bytes in [From, To) with Text.
+type Edit struct {
+	From, To int // byte offsets in the source before the edit
+	Text     string
+}
+
+// Apply returns src with the edit applied.
+func (e Edit) Apply(src string) string {
+	return src[:e.From] + e.Text + src[e.To:]
+}
+
+// Retokenize returns the tokens of e.Apply(src), where prev is the result of
+// Tokenize(src, d, opts...). Only the region around the edit is tokenized again,
+// and the tokens before it are reused as they are. The tokens after it are reused
+// once the new tokens are in step with them again, and are copied with their
+// positions shifted if the edit changes their line or column.
+// The result is the same as tokenizing the whole edited source.
+func Retokenize(src string, prev []*Token, e Edit, d dialect.Dialect, opts ...TokenizerOption) ([]*Token, error) {
+	if e.From < 0 || e.From > e.To || e.To > len(src) {
+		return nil, errors.Errorf("edit [%d, %d) is out of the source of length %d", e.From, e.To, len(src))
+	}
+	newSrc := e.Apply(src)
+
+	conf := Tokenizer{TabWidth: DefaultTabWidth}
+	for _, o := range opts {
+		o(&conf)
+	}
+
+	// Tokens ending before the edit are not changed, but the end of a token
+	// depends on the character after it, so the last one of them is tokenized again.
+	editPos := PosAtWithTabWidth(src, e.From, conf.TabWidth)
+	r := sort.Search(len(prev), func(i int) bool {
+		return ComparePos(prev[i].From, editPos) >= 0
+	})
+	if r -= 2; r < 0 {
+		r = 0
+	}
+
+	// tokenizing starts from the head of the source if no token is reused,
+	// as skipped whitespace or comments may be before the first token.
+	start, startPos := 0, NewPos(1, 1)
+	if r > 0 {
+		startPos = prev[r].From
+		start = OffsetWithTabWidth(src, startPos, conf.TabWidth)
+		if start < 0 {
+			return nil, errors.Errorf("token at %d:%d is not in the source", startPos.Line, startPos.Col)
+		}
+	}
+	tokens := append(make([]*Token, 0, len(prev)+8), prev[:r]...)
+
+	// old is the next token in prev which may be in step with the new tokens,
+	// and oldOffset is its offset in src.
+	old, oldPos, oldOffset := r, startPos, start
+	delta := len(e.Text) - (e.To - e.From)
+
+	t := NewTokenizer(strings.NewReader(newSrc[start:]), d, KeepRaw(conf.raw != nil), TabWidth(conf.TabWidth))
+	t.Line, t.Col = startPos.Line, startPos.Col
+	for {
+		offset := start + t.offset()
+		if offset >= e.From+len(e.Text) {
+			for old < len(prev) && oldOffset < offset-delta {
+				oldPos, oldOffset = advanceTo(src, oldPos, oldOffset, prev[old].From, conf.TabWidth)
+				if oldOffset < offset-delta {
+					old++
+				}
+			}
+			if old < len(prev) && oldOffset == offset-delta && prev[old].From == oldPos {
+				return append(tokens, shiftTokens(prev[old:], oldPos, t.Pos())...), nil
+			}
+		}
+
+		tok, err := t.NextToken()
+		if err == io.EOF {
+			if len(tokens) == 0 {
+				// same as Tokenize for blank sources
+				return nil, nil
+			}
+			return tokens, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if (conf.skipWhitespace && tok.Kind == Whitespace) || (conf.skipComments && tok.Kind == Comment) {
+			continue
+		}
+		tokens = append(tokens, tok)
+	}
+}
+
+// advanceTo returns the position and the byte offset of p in src, walking from
+// cur at offset i. It stops at the end of src if p is not reached.
+func advanceTo(src string, cur Pos, i int, p Pos, tabWidth int) (Pos, int) {
+	for i < len(src) && ComparePos(cur, p) < 0 {
+		cur, i = advance(src, cur, i, tabWidth)
+	}
+	return cur, i
+}
+
+// shiftTokens returns tokens moved so that from is placed at to.
+// Tokens are copied only if their positions change.
+func shiftTokens(tokens []*Token, from, to Pos) []*Token {
+	if from == to {
+		return tokens
+	}
+
+	shift := func(p Pos) Pos {
+		if p.Line == from.Line {
+			p.Col += to.Col - from.Col
+		}
+		p.Line += to.Line - from.Line
+		return p
+	}
+
+	shifted := make([]*Token, len(tokens))
+	var copies []Token
+	if from.Line != to.Line {
+		copies = make([]Token, len(tokens))
+	}
+	for i, tok := range tokens {
+		if from.Line == to.Line && tok.From.Line != from.Line {
+			// the rest of tokens are on the following lines which are not changed
+			copy(shifted[i:], tokens[i:])
+			break
+		}
+		var c *Token
+		if copies != nil {
+			c = &copies[i]
+		} else {
+			c = &Token{}
+		}
+		*c = *tok
+		c.From, c.To = shift(tok.From), shift(tok.To)
+		shifted[i] = c
+	}
+	return shifted
+}
@@ -0,0 +1,205 @@
+package sqltoken
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+func TestRetokenize(t *testing.T) {
+	src := "SELECT a, 'x''y' AS \"b c\"\n\tFROM t -- comment\r\nWHERE a <= 1.5 /* multi\nline */ AND b != $1;"
+
+	cases := []struct {
+		name string
+		edit Edit
+		opts []TokenizerOption
+	}{
+		{name: "insert into word", edit: Edit{From: 8, To: 8, Text: "bc"}},
+		{name: "replace word", edit: Edit{From: 7, To: 8, Text: "col"}},
+		{name: "join words", edit: Edit{From: 6, To: 7, Text: ""}},
+		{name: "split operator", edit: Edit{From: 55, To: 55, Text: " "}},
+		{name: "insert newline", edit: Edit{From: 9, To: 9, Text: "\n  "}},
+		{name: "delete newline", edit: Edit{From: 25, To: 27, Text: " "}},
+		{name: "open string", edit: Edit{From: 7, To: 7, Text: "'"}},
+		{name: "close comment early", edit: Edit{From: 65, To: 65, Text: "*/"}},
+		{name: "start comment", edit: Edit{From: 0, To: 0, Text: "/* "}},
+		{name: "split CRLF", edit: Edit{From: 45, To: 45, Text: "x"}},
+		{name: "join CRLF", edit: Edit{From: 25, To: 25, Text: "\r"}},
+		{name: "append", edit: Edit{From: len(src), To: len(src), Text: " SELECT 2"}},
+		{name: "replace all", edit: Edit{From: 0, To: len(src), Text: "SELECT 1"}},
+		{name: "no change", edit: Edit{From: 3, To: 3}},
+		{name: "skip whitespace", edit: Edit{From: 9, To: 9, Text: "\n  x,"}, opts: []TokenizerOption{SkipWhitespace}},
+		{name: "skip comments", edit: Edit{From: 73, To: 74, Text: "x\ny"}, opts: []TokenizerOption{SkipComments}},
+		{name: "keep raw", edit: Edit{From: 12, To: 13, Text: "z''"}, opts: []TokenizerOption{KeepRaw(true)}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertRetokenize(t, src, c.edit, &dialect.PostgresqlDialect{}, c.opts...)
+		})
+	}
+
+	t.Run("every small edit", func(t *testing.T) {
+		for from := 0; from <= len(src); from++ {
+			if from < len(src) && !utf8.RuneStart(src[from]) {
+				continue
+			}
+			for _, text := range []string{"", "x", " ", "\n", "-", "*", "'", "="} {
+				for to := from; to <= from+2 && to <= len(src); to++ {
+					if text == "" && to == from {
+						continue
+					}
+					assertRetokenize(t, src, Edit{From: from, To: to, Text: text}, &dialect.PostgresqlDialect{})
+				}
+			}
+		}
+	})
+
+	t.Run("unicode escapes", func(t *testing.T) {
+		src := `SELECT U&'\0061', u&1, U&"b"`
+		for from := 0; from <= len(src); from++ {
+			for _, text := range []string{"", "U", "&", " ", "'"} {
+				for to := from; to <= from+1 && to <= len(src); to++ {
+					if text == "" && to == from {
+						continue
+					}
+					assertRetokenize(t, src, Edit{From: from, To: to, Text: text}, &dialect.PostgresqlDialect{}, KeepRaw(true))
+				}
+			}
+		}
+	})
+
+	t.Run("dollar quotes", func(t *testing.T) {
+		src := "DO $a$ x; $$ $a$; SELECT $b + $1"
+		for from := 0; from <= len(src); from++ {
+			for _, text := range []string{"", "$", "a", " ", "1"} {
+				for to := from; to <= from+1 && to <= len(src); to++ {
+					if text == "" && to == from {
+						continue
+					}
+					assertRetokenize(t, src, Edit{From: from, To: to, Text: text}, &dialect.PostgresqlDialect{}, KeepRaw(true))
+				}
+			}
+		}
+	})
+
+	t.Run("leading whitespace", func(t *testing.T) {
+		cases := []struct {
+			src  string
+			edit Edit
+		}{
+			{src: " --", edit: Edit{From: 0, To: 1, Text: "$>"}},
+			{src: "\t\t$$", edit: Edit{From: 1, To: 1, Text: "~"}},
+			{src: "\t--'\n\ne", edit: Edit{From: 0, To: 6, Text: "éé"}},
+			{src: " é*/(|", edit: Edit{From: 0, To: 5, Text: "'"}},
+		}
+		for _, c := range cases {
+			for _, opts := range [][]TokenizerOption{nil, {SkipWhitespace}, {SkipComments}} {
+				assertRetokenize(t, c.src, c.edit, &dialect.GenericSQLDialect{}, opts...)
+			}
+		}
+	})
+
+	t.Run("random edits", func(t *testing.T) {
+		const chars = " \t\n\r-*/'\"$é|()e1x;:=<>"
+		runes := []rune(chars)
+		randomText := func(rnd *rand.Rand, n int) string {
+			var b strings.Builder
+			for i := 0; i < n; i++ {
+				b.WriteRune(runes[rnd.Intn(len(runes))])
+			}
+			return b.String()
+		}
+		dialects := []dialect.Dialect{&dialect.GenericSQLDialect{}, &dialect.PostgresqlDialect{}, &dialect.MySQLDialect{}}
+		options := [][]TokenizerOption{nil, {SkipWhitespace}, {SkipComments}, {SkipWhitespace, SkipComments}, {KeepRaw(true)}, {TabWidth(1)}, {TabWidth(8), SkipWhitespace}}
+
+		rnd := rand.New(rand.NewSource(1))
+		for i := 0; i < 10000; i++ {
+			src := randomText(rnd, rnd.Intn(10))
+			from := rnd.Intn(len(src) + 1)
+			to := from + rnd.Intn(len(src)-from+1)
+			if !utf8.ValidString(src[:from]) || !utf8.ValidString(src[from:to]) {
+				continue
+			}
+			e := Edit{From: from, To: to, Text: randomText(rnd, rnd.Intn(3))}
+			d := dialects[rnd.Intn(len(dialects))]
+			opts := options[rnd.Intn(len(options))]
+			if _, err := Tokenize(src, d, opts...); err != nil {
+				continue
+			}
+			assertRetokenize(t, src, e, d, opts...)
+		}
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		prev, err := Tokenize(src, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Retokenize(src, prev, Edit{From: 3, To: len(src) + 1}, nil); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
+
+func assertRetokenize(t *testing.T, src string, e Edit, d dialect.Dialect, opts ...TokenizerOption) {
+	t.Helper()
+
+	prev, err := Tokenize(src, d, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, wantErr := Tokenize(e.Apply(src), d, opts...)
+	got, err := Retokenize(src, prev, e, d, opts...)
+	if (err != nil) != (wantErr != nil) {
+		t.Fatalf("edit %+v: error must be %v but %v", e, wantErr, err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("edit %+v: diff %s", e, diff)
+	}
+}
+
+func largeDocument() string {
+	var b strings.Builder
+	for i := 0; i < 2000; i++ {
+		b.WriteString("SELECT u.id, u.name, count(o.id) AS orders -- per user\n")
+		b.WriteString("FROM users AS u\n\tLEFT JOIN orders AS o ON o.user_id = u.id\n")
+		b.WriteString("WHERE u.created_at >= '2020-01-01' AND u.status <> 'deleted'\n")
+		b.WriteString("GROUP BY u.id, u.name ORDER BY orders DESC LIMIT 10;\n")
+	}
+	return b.String()
+}
+
+func BenchmarkTokenize_LargeDocument(b *testing.B) {
+	src := largeDocument()
+	e := Edit{From: len(src) / 2, To: len(src) / 2, Text: "\n x"}
+	edited := e.Apply(src)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Tokenize(edited, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRetokenize_LargeDocument(b *testing.B) {
+	src := largeDocument()
+	e := Edit{From: len(src) / 2, To: len(src) / 2, Text: "\n x"}
+	prev, err := Tokenize(src, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Retokenize(src, prev, e, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
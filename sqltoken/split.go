@@ -0,0 +1,157 @@
+package sqltoken
+
+import (
+	"io"
+	"strings"
+
+	errors "golang.org/x/xerrors"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+// Splitter reads statements one by one from a source such as a dump file.
+// Semicolons inside of BEGIN ... END blocks of stored routines and triggers
+// do not end statements. In MySQL dialect, the DELIMITER command changes
+// the delimiter of the following statements, e.g. `DELIMITER $$`,
+// and the command itself is not returned as a statement.
+type Splitter struct {
+	t         *Tokenizer
+	delimiter string
+	mysql     bool
+}
+
+// NewSplitter creates a Splitter reading src.
+// GenericSQLDialect is used if d is nil.
+func NewSplitter(src io.Reader, d dialect.Dialect) *Splitter {
+	t := NewTokenizer(src, d, KeepRaw(true))
+	return &Splitter{
+		t:         t,
+		delimiter: ";",
+		mysql:     t.Dialect.Supports(dialect.DelimiterCommand),
+	}
+}
+
+// SplitStatements splits src into statements. It is a shorthand of
+// calling Next of NewSplitter(strings.NewReader(src), d) until io.EOF.
+func SplitStatements(src string, d dialect.Dialect) ([]string, error) {
+	s := NewSplitter(strings.NewReader(src), d)
+
+	var stmts []string
+	for {
+		stmt, err := s.Next()
+		if err == io.EOF {
+			return stmts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, stmt)
+	}
+}
+
+// Next returns the source text of the next statement without its delimiter
+// and surrounding whitespace. Comments before the statement are included.
+// The last statement does not need a delimiter, and io.EOF is returned
+// after it.
+func (s *Splitter) Next() (string, error) {
+	var buf strings.Builder
+	var hasContent bool
+	var depth int
+	var afterEnd bool // the previous token is END, which may close IF, LOOP and so on
+
+	for {
+		tok, err := s.t.NextToken()
+		if err == io.EOF {
+			if hasContent {
+				return strings.TrimSpace(buf.String()), nil
+			}
+			return "", io.EOF
+		}
+		if err != nil {
+			return "", errors.Errorf("NextToken failed: %w", err)
+		}
+		buf.WriteString(tok.Raw)
+
+		if tok.Kind == Whitespace || tok.Kind == Comment {
+			continue
+		}
+
+		kw := keywordOf(tok)
+		if !hasContent && s.mysql && kw == "DELIMITER" {
+			d, err := s.readDelimiter()
+			if err != nil {
+				return "", errors.Errorf("readDelimiter failed: %w", err)
+			}
+			s.delimiter = d
+			buf.Reset()
+			continue
+		}
+
+		// Blocks matter only for semicolons. A custom delimiter is used
+		// exactly not to split at semicolons in blocks.
+		if s.delimiter == ";" {
+			if afterEnd {
+				afterEnd = false
+				switch kw {
+				case "IF", "LOOP", "WHILE", "REPEAT":
+				default:
+					if depth > 0 {
+						depth--
+					}
+				}
+				if kw == "CASE" {
+					continue
+				}
+			}
+			switch kw {
+			case "BEGIN":
+				// BEGIN at the head of a statement starts a transaction
+				if hasContent {
+					depth++
+				}
+			case "CASE":
+				depth++
+			case "END":
+				afterEnd = true
+			}
+		}
+		hasContent = true
+
+		if depth > 0 || tok.Kind == SingleQuotedString || tok.Kind == NationalStringLiteral || tok.Kind == UnicodeStringLiteral || tok.Kind == DollarQuotedString {
+			continue
+		}
+		if text := buf.String(); strings.HasSuffix(text, s.delimiter) {
+			stmt := strings.TrimSpace(strings.TrimSuffix(text, s.delimiter))
+			if stmt == "" {
+				buf.Reset()
+				hasContent = false
+				continue
+			}
+			return stmt, nil
+		}
+	}
+}
+
+// readDelimiter reads the rest of the line of DELIMITER command.
+func (s *Splitter) readDelimiter() (string, error) {
+	var buf strings.Builder
+	for {
+		tok, err := s.t.NextToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", errors.Errorf("NextToken failed: %w", err)
+		}
+		if tok.Kind == Whitespace && tok.Value == "\n" {
+			break
+		}
+		buf.WriteString(tok.Raw)
+	}
+
+	d := strings.TrimSpace(buf.String())
+	if d == "" {
+		return "", errors.New("DELIMITER requires a delimiter")
+	}
+	return d, nil
+}
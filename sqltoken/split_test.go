@@ -0,0 +1,143 @@
+package sqltoken
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+func TestSplitStatements(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      string
+		out     []string
+		dialect dialect.Dialect
+	}{
+		{
+			name: "simple statements",
+			in:   "SELECT 1; SELECT 'a;b';\n-- last\nSELECT 2",
+			out:  []string{"SELECT 1", "SELECT 'a;b'", "-- last\nSELECT 2"},
+		},
+		{
+			name: "empty statements and trailing comments",
+			in:   ";;SELECT 1;\n;\n-- end of file\n",
+			out:  []string{"SELECT 1"},
+		},
+		{
+			name: "trigger body with nested semicolons",
+			in: `CREATE TRIGGER trg BEFORE INSERT ON t FOR EACH ROW
+BEGIN
+  SET NEW.a = 1;
+  IF NEW.b IS NULL THEN
+    SET NEW.b = CASE WHEN NEW.a > 0 THEN 1 ELSE 0 END;
+  END IF;
+  label: BEGIN
+    SET NEW.c = 2;
+  END label;
+END;
+INSERT INTO t (a) VALUES (1);`,
+			out: []string{
+				`CREATE TRIGGER trg BEFORE INSERT ON t FOR EACH ROW
+BEGIN
+  SET NEW.a = 1;
+  IF NEW.b IS NULL THEN
+    SET NEW.b = CASE WHEN NEW.a > 0 THEN 1 ELSE 0 END;
+  END IF;
+  label: BEGIN
+    SET NEW.c = 2;
+  END label;
+END`,
+				"INSERT INTO t (a) VALUES (1)",
+			},
+			dialect: &dialect.MySQLDialect{},
+		},
+		{
+			name: "case statement in procedure",
+			in: `CREATE PROCEDURE p(x INT) BEGIN
+  CASE x WHEN 1 THEN SELECT 1; ELSE SELECT 2; END CASE;
+  WHILE x > 0 DO SET x = x - 1; END WHILE;
+END; CALL p(1)`,
+			out: []string{
+				`CREATE PROCEDURE p(x INT) BEGIN
+  CASE x WHEN 1 THEN SELECT 1; ELSE SELECT 2; END CASE;
+  WHILE x > 0 DO SET x = x - 1; END WHILE;
+END`,
+				"CALL p(1)",
+			},
+			dialect: &dialect.MySQLDialect{},
+		},
+		{
+			name: "transaction",
+			in:   "BEGIN; UPDATE t SET a = 1; END;",
+			out:  []string{"BEGIN", "UPDATE t SET a = 1", "END"},
+		},
+		{
+			name:    "dollar quoted body",
+			in:      "DO $$ BEGIN PERFORM 1; END $$;\nSELECT 1;",
+			out:     []string{"DO $$ BEGIN PERFORM 1; END $$", "SELECT 1"},
+			dialect: &dialect.PostgresqlDialect{},
+		},
+		{
+			name: "delimiter command",
+			in: `DELIMITER $$
+CREATE TRIGGER trg BEFORE INSERT ON t FOR EACH ROW
+BEGIN
+  SET NEW.a = 1;
+  SET NEW.b = 2;
+END$$
+DELIMITER ;
+INSERT INTO t (a) VALUES (1);
+delimiter //
+SELECT 1; SELECT 2 //`,
+			out: []string{
+				`CREATE TRIGGER trg BEFORE INSERT ON t FOR EACH ROW
+BEGIN
+  SET NEW.a = 1;
+  SET NEW.b = 2;
+END`,
+				"INSERT INTO t (a) VALUES (1)",
+				"SELECT 1; SELECT 2",
+			},
+			dialect: &dialect.MySQLDialect{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			stmts, err := SplitStatements(c.in, c.dialect)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if diff := cmp.Diff(c.out, stmts); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+		})
+	}
+
+	t.Run("delimiter without value", func(t *testing.T) {
+		if _, err := SplitStatements("DELIMITER\nSELECT 1;", &dialect.MySQLDialect{}); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
+
+func TestSplitter_Next(t *testing.T) {
+	s := NewSplitter(strings.NewReader("SELECT 1;\nSELECT 2;\n"), nil)
+
+	for _, want := range []string{"SELECT 1", "SELECT 2"} {
+		stmt, err := s.Next()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if stmt != want {
+			t.Errorf("should be %q but %q", want, stmt)
+		}
+	}
+	if _, err := s.Next(); err != io.EOF {
+		t.Errorf("should be io.EOF but %v", err)
+	}
+}
@@ -0,0 +1,129 @@
+package sqltoken
+
+import (
+	"io"
+	"strings"
+
+	errors "golang.org/x/xerrors"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+// StmtKind is the kind of statement classified by StatementKind.
+type StmtKind int
+
+const (
+	StmtOther StmtKind = iota
+	StmtSelect
+	StmtInsert
+	StmtUpdate
+	StmtDelete
+	StmtDDL
+)
+
+func (s StmtKind) String() string {
+	switch s {
+	case StmtSelect:
+		return "Select"
+	case StmtInsert:
+		return "Insert"
+	case StmtUpdate:
+		return "Update"
+	case StmtDelete:
+		return "Delete"
+	case StmtDDL:
+		return "DDL"
+	}
+	return "Other"
+}
+
+var stmtKindKeywords = map[string]StmtKind{
+	"SELECT":   StmtSelect,
+	"VALUES":   StmtSelect,
+	"TABLE":    StmtSelect,
+	"INSERT":   StmtInsert,
+	"UPDATE":   StmtUpdate,
+	"DELETE":   StmtDelete,
+	"CREATE":   StmtDDL,
+	"ALTER":    StmtDDL,
+	"DROP":     StmtDDL,
+	"TRUNCATE": StmtDDL,
+	"COMMENT":  StmtDDL,
+}
+
+// StatementKind classifies the first statement of src by its leading keyword
+// without parsing it. Only the tokens up to the keyword are read.
+// Leading comments, whitespace and parentheses are skipped, and for a statement
+// starting with WITH, the CTEs are skipped to find the primary statement.
+// An empty src or an unknown statement is StmtOther.
+func StatementKind(src string, d dialect.Dialect) (StmtKind, error) {
+	t := NewTokenizer(strings.NewReader(src), d, SkipWhitespace, SkipComments)
+
+	for {
+		tok, err := t.NextToken()
+		if err == io.EOF {
+			return StmtOther, nil
+		}
+		if err != nil {
+			return StmtOther, errors.Errorf("NextToken failed: %w", err)
+		}
+
+		switch keywordOf(tok) {
+		case "":
+			if tok.Kind == LParen {
+				continue
+			}
+			return StmtOther, nil
+		case "WITH":
+			return statementKindAfterCTEs(t)
+		default:
+			return stmtKindKeywords[keywordOf(tok)], nil
+		}
+	}
+}
+
+// statementKindAfterCTEs skips CTEs, which are balanced parentheses, until
+// the primary statement. A parenthesis directly after the body of a CTE
+// starts a parenthesized query.
+func statementKindAfterCTEs(t *Tokenizer) (StmtKind, error) {
+	var depth int
+	var afterBody bool
+	for {
+		tok, err := t.NextToken()
+		if err == io.EOF {
+			return StmtOther, nil
+		}
+		if err != nil {
+			return StmtOther, errors.Errorf("NextToken failed: %w", err)
+		}
+
+		switch {
+		case tok.Kind == LParen:
+			if depth == 0 && afterBody {
+				return StmtSelect, nil
+			}
+			depth++
+		case tok.Kind == RParen:
+			depth--
+			afterBody = depth == 0
+			continue
+		case depth == 0:
+			if k, ok := stmtKindKeywords[keywordOf(tok)]; ok {
+				return k, nil
+			}
+		}
+		afterBody = false
+	}
+}
+
+// keywordOf returns the keyword of an unquoted word token, or "" for others.
+func keywordOf(tok *Token) string {
+	if tok.Kind != SQLKeyword {
+		return ""
+	}
+	w := tok.Value.(*SQLWord)
+	if w.QuoteStyle != 0 {
+		return ""
+	}
+	return w.Keyword
+}
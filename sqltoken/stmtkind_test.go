@@ -0,0 +1,85 @@
+package sqltoken
+
+import (
+	"testing"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+func TestStatementKind(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  StmtKind
+	}{
+		{name: "select", in: "SELECT * FROM t", out: StmtSelect},
+		{name: "lower case", in: "select 1", out: StmtSelect},
+		{name: "parenthesized select", in: "(SELECT 1) UNION (SELECT 2)", out: StmtSelect},
+		{name: "values", in: "VALUES (1, 2)", out: StmtSelect},
+		{name: "insert", in: "INSERT INTO t VALUES (1)", out: StmtInsert},
+		{name: "update", in: "UPDATE t SET a = 1", out: StmtUpdate},
+		{name: "delete", in: "DELETE FROM t", out: StmtDelete},
+		{name: "create", in: "CREATE TABLE t (a int)", out: StmtDDL},
+		{name: "alter", in: "ALTER TABLE t ADD COLUMN b int", out: StmtDDL},
+		{name: "drop", in: "DROP TABLE t", out: StmtDDL},
+		{name: "other", in: "SET search_path = public", out: StmtOther},
+		{name: "empty", in: "", out: StmtOther},
+		{name: "only comments", in: "-- nothing\n/* here */", out: StmtOther},
+		{
+			name: "leading comments and whitespace",
+			in:   "\n  -- read the users\n/* block */ DELETE FROM users",
+			out:  StmtDelete,
+		},
+		{
+			name: "with select",
+			in:   "WITH a AS (SELECT 1) SELECT * FROM a",
+			out:  StmtSelect,
+		},
+		{
+			name: "with delete",
+			in:   "WITH old AS (SELECT id FROM t WHERE created < now()) DELETE FROM t WHERE id IN (SELECT id FROM old)",
+			out:  StmtDelete,
+		},
+		{
+			name: "with recursive and column list",
+			in:   "WITH RECURSIVE r (n) AS (SELECT 1 UNION SELECT n + 1 FROM r), s AS (SELECT 2) INSERT INTO t SELECT n FROM r",
+			out:  StmtInsert,
+		},
+		{
+			name: "with update in cte",
+			in:   "WITH u AS (UPDATE t SET a = 1 RETURNING *) SELECT * FROM u",
+			out:  StmtSelect,
+		},
+		{
+			name: "with parenthesized query",
+			in:   "WITH a AS (SELECT 1) (SELECT * FROM a)",
+			out:  StmtSelect,
+		},
+		{
+			name: "quoted identifier",
+			in:   `"select"`,
+			out:  StmtOther,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			k, err := StatementKind(c.in, &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if k != c.out {
+				t.Errorf("expected %s but %s", c.out, k)
+			}
+		})
+	}
+
+	t.Run("tokenize error", func(t *testing.T) {
+		if _, err := StatementKind("SELECT 'unclosed", &dialect.GenericSQLDialect{}); err != nil {
+			t.Errorf("should stop at the first keyword but %v", err)
+		}
+		if _, err := StatementKind("'unclosed", &dialect.GenericSQLDialect{}); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package sqltoken
+
+import (
+	"strings"
+
+	errors "golang.org/x/xerrors"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+// StripComments returns src without comments.
+// Everything else including whitespace is kept as it is, except that a
+// comment directly between two tokens is replaced by a space so as not to join them.
+func StripComments(src string, d dialect.Dialect) (string, error) {
+	toks, err := Tokenize(src, d)
+	if err != nil {
+		return "", errors.Errorf("Tokenize failed: %w", err)
+	}
+
+	// tokens are in order, so offsets are computed by moving forward only
+	cur, off := NewPos(1, 1), 0
+	seek := func(p Pos) int {
+		for ComparePos(cur, p) < 0 && off < len(src) {
+			cur, off = advance(src, cur, off, DefaultTabWidth)
+		}
+		return off
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, tok := range toks {
+		if tok.Kind != Comment {
+			continue
+		}
+		start := seek(tok.From)
+		end := seek(tok.To)
+		b.WriteString(src[last:start])
+		if out := b.String(); out != "" && end < len(src) && !isSpace(out[len(out)-1]) && !isSpace(src[end]) {
+			b.WriteByte(' ')
+		}
+		last = end
+	}
+	b.WriteString(src[last:])
+
+	return b.String(), nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
@@ -0,0 +1,80 @@
+package sqltoken
+
+import (
+	"testing"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+func TestStripComments(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      string
+		out     string
+		dialect dialect.Dialect
+	}{
+		{
+			name: "line comment",
+			in:   "SELECT a -- comment\nFROM t",
+			out:  "SELECT a \nFROM t",
+		},
+		{
+			name: "block comment",
+			in:   "SELECT /* columns */ a\n\tFROM t",
+			out:  "SELECT  a\n\tFROM t",
+		},
+		{
+			name: "multiline block comment",
+			in:   "SELECT a /* first\n\tsecond */ FROM t /* last */",
+			out:  "SELECT a  FROM t ",
+		},
+		{
+			name: "comment between tokens",
+			in:   "SELECT a/*x*/FROM t",
+			out:  "SELECT a FROM t",
+		},
+		{
+			name: "comments adjacent to strings",
+			in:   "SELECT '--a'/* c */, '/* b */'-- d\n",
+			out:  "SELECT '--a' , '/* b */'\n",
+		},
+		{
+			name: "comment at the end without new line",
+			in:   "SELECT 1 -- one",
+			out:  "SELECT 1 ",
+		},
+		{
+			name: "tab in comment",
+			in:   "SELECT 1 --\tone\n, 2 /*\t*/ -- two",
+			out:  "SELECT 1 \n, 2  ",
+		},
+		{
+			name:    "hash comment in mysql",
+			in:      "SELECT '#a' # comment\nFROM t",
+			out:     "SELECT '#a' \nFROM t",
+			dialect: &dialect.MySQLDialect{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := c.dialect
+			if d == nil {
+				d = &dialect.GenericSQLDialect{}
+			}
+			out, err := StripComments(c.in, d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != c.out {
+				t.Errorf("should be %q but %q", c.out, out)
+			}
+		})
+	}
+
+	t.Run("unclosed comment", func(t *testing.T) {
+		if _, err := StripComments("SELECT 1 /* one", &dialect.GenericSQLDialect{}); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
@@ -1,6 +1,8 @@
 package sqltoken
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"strings"
@@ -15,9 +17,14 @@ type SQLWord struct {
 	Value      string
 	QuoteStyle rune
 	Keyword    string
+	Normalized string // Value normalized by Dialect.NormalizeIdent
+	Unicode    bool   // U&"..." of PostgreSQL, Value keeps the escapes and Normalized is decoded
 }
 
 func (s *SQLWord) String() string {
+	if s.Unicode {
+		return `U&"` + s.Value + `"`
+	}
 	if s.QuoteStyle == '"' || s.QuoteStyle == '[' || s.QuoteStyle == '`' {
 		return string(s.QuoteStyle) + s.Value + string(matchingEndQuote(s.QuoteStyle))
 	} else if s.QuoteStyle == 0 {
@@ -26,6 +33,16 @@ func (s *SQLWord) String() string {
 	return ""
 }
 
+// DollarQuote is the value of a DollarQuotedString token.
+type DollarQuote struct {
+	Tag   string // empty for $$
+	Value string // text between the delimiters
+}
+
+func (q *DollarQuote) String() string {
+	return "$" + q.Tag + "$" + q.Value + "$" + q.Tag + "$"
+}
+
 func matchingEndQuote(quoteStyle rune) rune {
 	switch quoteStyle {
 	case '"':
@@ -57,11 +74,15 @@ func MakeKeyword(word string, quoteStyle rune) *SQLWord {
 	}
 }
 
+// Token is a lexical token of SQL.
+// For literals and delimited identifiers, Value holds the content without delimiters
+// and escapes, while the span From-To covers the whole source text including them.
 type Token struct {
 	Kind  Kind
 	Value interface{}
 	From  Pos
 	To    Pos
+	Raw   string // source text of the token, set only with KeepRaw
 }
 
 func NewPos(line, col int) Pos {
@@ -76,8 +97,24 @@ type Pos struct {
 	Col  int
 }
 
-func (p *Pos) String() string {
-	return fmt.Sprintf("{Line: %d Col: %d}", p.Line, p.Col)
+// String returns the position as `line:col`.
+func (p Pos) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Col)
+}
+
+// Before reports whether p is before q. Positions are ordered by line, then column.
+func (p Pos) Before(q Pos) bool {
+	return ComparePos(p, q) < 0
+}
+
+// After reports whether p is after q.
+func (p Pos) After(q Pos) bool {
+	return ComparePos(p, q) > 0
+}
+
+// Equal reports whether p and q are the same position.
+func (p Pos) Equal(q Pos) bool {
+	return p == q
 }
 
 func ComparePos(x, y Pos) int {
@@ -98,27 +135,159 @@ func ComparePos(x, y Pos) int {
 	return -1
 }
 
+// DefaultTabWidth is the number of columns a tab advances by default.
+const DefaultTabWidth = 4
+
 type Tokenizer struct {
 	Dialect dialect.Dialect
 	Scanner *scanner.Scanner
 	Line    int
 	Col     int
+	// TabWidth is the number of columns Col advances for each tab in the
+	// source, including tabs inside quoted strings, identifiers and
+	// comments. Set 1 to count columns by runes. Tokenizing fails if it is
+	// less than 1.
+	TabWidth int
+
+	peeked    *Token
+	peekedErr error
+
+	skipWhitespace bool
+	skipComments   bool
+
+	raw     *bytes.Buffer // source read but not tokenized yet if KeepRaw is enabled
+	rawBase int           // offset of the head of raw in the source
+
+	// tokens and words are allocated in chunks to save allocations per token,
+	// and runes is the buffer reused for reading the text of a token.
+	tokens []Token
+	words  []SQLWord
+	runes  []rune
+
+	// pendingAmpersand is set when `&` after U is read to see whether a quote
+	// follows it, and the `&` is returned as the next token.
+	pendingAmpersand bool
+	// pendingWord is set when a word after `$` is read to see whether it is
+	// a tag of a dollar quote, and the word is returned as the next token.
+	pendingWord string
+	// pendingOperator is set when `<<` or `>>` is followed by `=` or `>`,
+	// which is read as `<` or `>` followed by this operator, such as `<=`.
+	pendingOperator string
+}
+
+// allocChunk is the number of tokens and words allocated at once.
+const allocChunk = 64
+
+func (t *Tokenizer) newToken() *Token {
+	if len(t.tokens) == 0 {
+		t.tokens = make([]Token, allocChunk)
+	}
+	tok := &t.tokens[0]
+	t.tokens = t.tokens[1:]
+	return tok
+}
+
+func (t *Tokenizer) newWord() *SQLWord {
+	if len(t.words) == 0 {
+		t.words = make([]SQLWord, allocChunk)
+	}
+	w := &t.words[0]
+	t.words = t.words[1:]
+	return w
+}
+
+type TokenizerOption func(*Tokenizer)
+
+// SkipWhitespace makes the tokenizer omit Whitespace tokens.
+func SkipWhitespace(t *Tokenizer) {
+	t.skipWhitespace = true
+}
+
+// SkipComments makes the tokenizer omit Comment tokens.
+func SkipComments(t *Tokenizer) {
+	t.skipComments = true
+}
+
+// KeepRaw makes the tokenizer set Token.Raw, the source text of each token
+// including quotes and escapes, which Value does not have.
+func KeepRaw(keep bool) TokenizerOption {
+	return func(t *Tokenizer) {
+		if keep {
+			t.raw = &bytes.Buffer{}
+		} else {
+			t.raw = nil
+		}
+	}
+}
+
+// TabWidth sets the number of columns a tab advances, DefaultTabWidth by default.
+// width must be 1 or more.
+func TabWidth(width int) TokenizerOption {
+	return func(t *Tokenizer) {
+		t.TabWidth = width
+	}
 }
 
-func NewTokenizer(src io.Reader, dialect dialect.Dialect) *Tokenizer {
+// NewTokenizer creates a Tokenizer reading src.
+// GenericSQLDialect is used if d is nil.
+func NewTokenizer(src io.Reader, d dialect.Dialect, opts ...TokenizerOption) *Tokenizer {
+	if d == nil {
+		d = &dialect.GenericSQLDialect{}
+	}
 	var scan scanner.Scanner
-	return &Tokenizer{
-		Dialect: dialect,
-		Scanner: scan.Init(src),
-		Line:    1,
-		Col:     1,
+	tokenizer := &Tokenizer{
+		Dialect:  d,
+		Scanner:  scan.Init(src),
+		Line:     1,
+		Col:      1,
+		TabWidth: DefaultTabWidth,
+	}
+	// illegal characters are reported by the tokenizer itself
+	tokenizer.Scanner.Error = func(*scanner.Scanner, string) {}
+
+	for _, o := range opts {
+		o(tokenizer)
 	}
+	if tokenizer.raw != nil {
+		// nothing is read by Init, so the scanner can be set up again
+		tokenizer.Scanner.Init(io.TeeReader(src, tokenizer.raw))
+		tokenizer.Scanner.Error = func(*scanner.Scanner, string) {}
+	}
+
+	return tokenizer
+}
+
+// Tokenize splits src into tokens. It is a shorthand of
+// NewTokenizer(strings.NewReader(src), d, opts...).Tokenize().
+func Tokenize(src string, d dialect.Dialect, opts ...TokenizerOption) ([]*Token, error) {
+	return NewTokenizer(strings.NewReader(src), d, opts...).Tokenize()
+}
+
+// TokenizeBytes is same as Tokenize but accepts a byte slice.
+func TokenizeBytes(src []byte, d dialect.Dialect, opts ...TokenizerOption) ([]*Token, error) {
+	return NewTokenizer(bytes.NewReader(src), d, opts...).Tokenize()
 }
 
 func (t *Tokenizer) Tokenize() ([]*Token, error) {
+	return t.TokenizeContext(context.Background())
+}
+
+// contextCheckInterval is the number of tokens read between checks of
+// the context in TokenizeContext.
+const contextCheckInterval = 1024
+
+// TokenizeContext is same as Tokenize but aborts with the error of ctx
+// once ctx is canceled or its deadline is exceeded. ctx is checked every
+// contextCheckInterval tokens, so a single huge token is not interrupted.
+func (t *Tokenizer) TokenizeContext(ctx context.Context) ([]*Token, error) {
 	var tokenset []*Token
 
-	for {
+	for i := 0; ; i++ {
+		if i%contextCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, errors.Errorf("tokenize aborted at %s: %w", t.Pos(), err)
+			}
+		}
 		t, err := t.NextToken()
 		if err == io.EOF {
 			break
@@ -132,8 +301,45 @@ func (t *Tokenizer) Tokenize() ([]*Token, error) {
 	return tokenset, nil
 }
 
+// Peek returns the token that the next call of NextToken returns
+// without consuming it. Since the token is already scanned,
+// Pos returns the end of the peeked token until NextToken is called.
+func (t *Tokenizer) Peek() (*Token, error) {
+	if t.peeked == nil && t.peekedErr == nil {
+		t.peeked, t.peekedErr = t.readToken()
+	}
+	return t.peeked, t.peekedErr
+}
+
 func (t *Tokenizer) NextToken() (*Token, error) {
+	if t.peeked != nil || t.peekedErr != nil {
+		tok, err := t.peeked, t.peekedErr
+		t.peeked, t.peekedErr = nil, nil
+		return tok, err
+	}
+	return t.readToken()
+}
+
+// readToken returns the next token which is not omitted by the options.
+func (t *Tokenizer) readToken() (*Token, error) {
+	for {
+		tok, err := t.nextToken()
+		if err != nil {
+			return tok, err
+		}
+		if (t.skipWhitespace && tok.Kind == Whitespace) || (t.skipComments && tok.Kind == Comment) {
+			continue
+		}
+		return tok, nil
+	}
+}
+
+func (t *Tokenizer) nextToken() (*Token, error) {
 	pos := t.Pos()
+	if t.TabWidth < 1 {
+		return &Token{Kind: ILLEGAL, Value: "", From: pos, To: pos}, errors.Errorf("tokenize failed: TabWidth must be 1 or more but %d", t.TabWidth)
+	}
+	offset := t.offset()
 	tok, str, err := t.next()
 	if err == io.EOF {
 		return nil, io.EOF
@@ -142,7 +348,15 @@ func (t *Tokenizer) NextToken() (*Token, error) {
 		return &Token{Kind: ILLEGAL, Value: "", From: pos, To: t.Pos()}, errors.Errorf("tokenize failed: %w", err)
 	}
 
-	return &Token{Kind: tok, Value: str, From: pos, To: t.Pos()}, nil
+	token := t.newToken()
+	*token = Token{Kind: tok, Value: str, From: pos, To: t.Pos()}
+	if t.raw != nil {
+		end := t.offset() - t.rawBase
+		token.Raw = string(t.raw.Bytes()[offset-t.rawBase : end])
+		t.raw.Next(end)
+		t.rawBase += end
+	}
+	return token, nil
 }
 
 func (t *Tokenizer) Pos() Pos {
@@ -152,7 +366,41 @@ func (t *Tokenizer) Pos() Pos {
 	}
 }
 
+// offset returns the byte offset of the next token in the source.
+func (t *Tokenizer) offset() int {
+	o := t.Scanner.Pos().Offset
+	if t.pendingAmpersand {
+		o--
+	}
+	return o - len(t.pendingWord) - len(t.pendingOperator)
+}
+
 func (t *Tokenizer) next() (Kind, interface{}, error) {
+	if t.pendingAmpersand {
+		t.pendingAmpersand = false
+		t.Col += 1
+		return Ampersand, "&", nil
+	}
+	if t.pendingWord != "" {
+		w := t.pendingWord
+		t.pendingWord = ""
+		t.Col += len([]rune(w))
+		return SQLKeyword, t.makeWord(w, 0), nil
+	}
+	if t.pendingOperator != "" {
+		o := t.pendingOperator
+		t.pendingOperator = ""
+		t.Col += 2
+		switch o {
+		case "<=":
+			return LtEq, o, nil
+		case "<>":
+			return Neq, o, nil
+		default:
+			return GtEq, o, nil
+		}
+	}
+
 	r := t.Scanner.Peek()
 	switch {
 	case ' ' == r:
@@ -162,7 +410,7 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 
 	case '\t' == r:
 		t.Scanner.Next()
-		t.Col += 4
+		t.Col += t.TabWidth
 		return Whitespace, "\t", nil
 
 	case '\n' == r:
@@ -193,13 +441,16 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 			return NationalStringLiteral, str, nil
 		}
 		s := t.tokenizeWord('N')
-		v := MakeKeyword(s, 0)
+		v := t.makeWord(s, 0)
 		return SQLKeyword, v, nil
 
+	case (r == 'U' || r == 'u') && t.unicodeEscapes():
+		return t.tokenizeUnicodeEscaped(r)
+
 	case t.Dialect.IsIdentifierStart(r):
 		t.Scanner.Next()
 		s := t.tokenizeWord(r)
-		return SQLKeyword, MakeKeyword(s, 0), nil
+		return SQLKeyword, t.makeWord(s, 0), nil
 
 	case '\'' == r:
 		s, err := t.tokenizeSingleQuotedString()
@@ -208,21 +459,19 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 		}
 		return SingleQuotedString, s, nil
 
-	case t.Dialect.IsDelimitedIdentifierStart(r):
-		t.Scanner.Next()
-		end := matchingEndQuote(r)
-
-		var s []rune
-		for {
-			n := t.Scanner.Next()
-			if n == end {
-				break
-			}
-			s = append(s, n)
+	case '"' == r && t.doubleQuoteStrings():
+		s, err := t.tokenizeQuotedString('"')
+		if err != nil {
+			return ILLEGAL, "", err
 		}
-		t.Col += 2 + len(s)
+		return SingleQuotedString, s, nil
 
-		return SQLKeyword, MakeKeyword(string(s), r), nil
+	case t.Dialect.IsDelimitedIdentifierStart(r):
+		s, err := t.tokenizeDelimitedIdent(r)
+		if err != nil {
+			return ILLEGAL, "", err
+		}
+		return SQLKeyword, t.makeWord(s, r), nil
 
 	case '0' <= r && r <= '9':
 		var s []rune
@@ -258,22 +507,15 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 
 		if '-' == t.Scanner.Peek() {
 			t.Scanner.Next()
-
-			var s []rune
-			for {
-				ch := t.Scanner.Peek()
-				if ch != scanner.EOF && ch != '\n' {
-					t.Scanner.Next()
-					s = append(s, ch)
-				} else {
-					t.Col += len(s) + 2
-					return Comment, string(s), nil // Comment Node
-				}
-			}
+			return Comment, t.tokenizeLineComment("--"), nil // Comment Node
 		}
 		t.Col += 1
 		return Minus, "-", nil
 
+	case '#' == r && t.hashComments():
+		t.Scanner.Next()
+		return Comment, t.tokenizeLineComment("#"), nil
+
 	case '/' == r:
 		t.Scanner.Next()
 
@@ -302,6 +544,11 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 		return Mod, "%", nil
 	case '=' == r:
 		t.Scanner.Next()
+		if t.Scanner.Peek() == '>' {
+			t.Scanner.Next()
+			t.Col += 2
+			return RArrow, "=>", nil
+		}
 		t.Col += 1
 		return Eq, "=", nil
 	case '.' == r:
@@ -317,6 +564,16 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 			t.Col += 2
 			return Neq, "!=", nil
 		}
+		if n == '~' && t.regexOperators() {
+			t.Scanner.Next()
+			if t.Scanner.Peek() == '*' {
+				t.Scanner.Next()
+				t.Col += 3
+				return ExclamationTildeAsterisk, "!~*", nil
+			}
+			t.Col += 2
+			return ExclamationTilde, "!~", nil
+		}
 		return ILLEGAL, "", errors.Errorf("tokenizer error: illegal sequence %s%s", string(r), string(n))
 
 	case '<' == r:
@@ -330,6 +587,17 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 			t.Scanner.Next()
 			t.Col += 2
 			return Neq, "<>", nil
+		case '<':
+			t.Scanner.Next()
+			t.Col += 1
+			// `<<=` and `<<>` are read as `<` followed by `<=` or `<>`
+			if n := t.Scanner.Peek(); n == '=' || n == '>' {
+				t.Scanner.Next()
+				t.pendingOperator = string([]rune{'<', n})
+				return Lt, "<", nil
+			}
+			t.Col += 1
+			return ShiftLeft, "<<", nil
 		default:
 			t.Col += 1
 			return Lt, "<", nil
@@ -341,6 +609,17 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 			t.Scanner.Next()
 			t.Col += 2
 			return GtEq, ">=", nil
+		case '>':
+			t.Scanner.Next()
+			t.Col += 1
+			// `>>=` is read as `>` followed by `>=`
+			if t.Scanner.Peek() == '=' {
+				t.Scanner.Next()
+				t.pendingOperator = ">="
+				return Gt, ">", nil
+			}
+			t.Col += 1
+			return ShiftRight, ">>", nil
 		default:
 			t.Col += 1
 			return Gt, ">", nil
@@ -353,6 +632,11 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 			t.Col += 2
 			return DoubleColon, "::", nil
 		}
+		if n == '=' {
+			t.Scanner.Next()
+			t.Col += 2
+			return ColonEq, ":=", nil
+		}
 		t.Col += 1
 		return Colon, ":", nil
 	case ';' == r:
@@ -383,8 +667,56 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 		t.Scanner.Next()
 		t.Col += 1
 		return RBrace, "}", nil
+	case '$' == r:
+		t.Scanner.Next()
+		if n := t.Scanner.Peek(); t.dollarQuotes() && (n == '$' || t.Dialect.IsIdentifierStart(n)) {
+			return t.tokenizeDollarQuoted()
+		}
+		s := []rune{r}
+		for {
+			n := t.Scanner.Peek()
+			if '0' <= n && n <= '9' {
+				s = append(s, n)
+				t.Scanner.Next()
+			} else {
+				break
+			}
+		}
+		t.Col += len(s)
+		if len(s) == 1 {
+			return Char, string(r), nil
+		}
+		return Placeholder, string(s), nil
+	case '|' == r:
+		t.Scanner.Next()
+		if t.Scanner.Peek() == '|' {
+			t.Scanner.Next()
+			t.Col += 2
+			return DoublePipe, "||", nil
+		}
+		t.Col += 1
+		return Pipe, "|", nil
+	case '^' == r:
+		t.Scanner.Next()
+		t.Col += 1
+		return Caret, "^", nil
+	case '~' == r:
+		t.Scanner.Next()
+		if t.Scanner.Peek() == '*' && t.regexOperators() {
+			t.Scanner.Next()
+			t.Col += 2
+			return TildeAsterisk, "~*", nil
+		}
+		t.Col += 1
+		return Tilde, "~", nil
+	case '?' == r:
+		t.Scanner.Next()
+		t.Col += 1
+		return Placeholder, "?", nil
 	case scanner.EOF == r:
 		return ILLEGAL, "", io.EOF
+	case isControl(r):
+		return ILLEGAL, "", &IllegalCharacterError{Char: r, Pos: t.Pos()}
 	default:
 		t.Scanner.Next()
 		t.Col += 1
@@ -392,9 +724,75 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 	}
 }
 
+// IllegalCharacterError is returned when a control character other than
+// whitespace appears outside of literals and comments.
+type IllegalCharacterError struct {
+	Char rune
+	Pos  Pos
+}
+
+func (e *IllegalCharacterError) Error() string {
+	return fmt.Sprintf("illegal character %U at %d:%d", e.Char, e.Pos.Line, e.Pos.Col)
+}
+
+// isControl reports whether r is an ASCII control character (including NUL and DEL)
+// which is not tokenized as whitespace.
+func isControl(r rune) bool {
+	if r == '\t' || r == '\n' || r == '\r' {
+		return false
+	}
+	return (0 <= r && r < 0x20) || r == 0x7f
+}
+
+// nestedComments reports whether block comments nest as in PostgreSQL.
+func (t *Tokenizer) nestedComments() bool {
+	return t.Dialect.Supports(dialect.NestedBlockComments)
+}
+
+// hashComments reports whether # starts a comment as in MySQL.
+func (t *Tokenizer) hashComments() bool {
+	return t.Dialect.Supports(dialect.HashComments)
+}
+
+// doubleQuoteStrings reports whether `"` quotes string literals as in MySQL
+// without ANSI_QUOTES mode.
+func (t *Tokenizer) doubleQuoteStrings() bool {
+	return t.Dialect.Supports(dialect.DoubleQuotedStrings)
+}
+
+// unicodeEscapes reports whether U&'...' and U&"..." are tokenized as
+// a string and an identifier with Unicode escapes as in PostgreSQL.
+func (t *Tokenizer) unicodeEscapes() bool {
+	return t.Dialect.Supports(dialect.UnicodeEscapes)
+}
+
+// dollarQuotes reports whether $$...$$ and $tag$...$tag$ are tokenized
+// as string literals as in PostgreSQL.
+func (t *Tokenizer) dollarQuotes() bool {
+	return t.Dialect.Supports(dialect.DollarQuotes)
+}
+
+// regexOperators reports whether ~*, !~ and !~* are tokenized
+// as the regex match operators of PostgreSQL.
+func (t *Tokenizer) regexOperators() bool {
+	return t.Dialect.Supports(dialect.RegexOperators)
+}
+
+// makeWord returns the same word as MakeKeyword with Normalized set.
+func (t *Tokenizer) makeWord(word string, quoteStyle rune) *SQLWord {
+	w := t.newWord()
+	*w = SQLWord{
+		Value:      word,
+		QuoteStyle: quoteStyle,
+		Keyword:    strings.ToUpper(word),
+		Normalized: t.Dialect.NormalizeIdent(word, quoteStyle != 0),
+	}
+	return w
+}
+
 func (t *Tokenizer) tokenizeWord(f rune) string {
-	var str []rune
-	str = append(str, f)
+	str := append(t.runes[:0], f)
+	defer func() { t.runes = str }()
 
 	for {
 		r := t.Scanner.Peek()
@@ -409,37 +807,216 @@ func (t *Tokenizer) tokenizeWord(f rune) string {
 	return string(str)
 }
 
+// tokenizeDelimitedIdent reads an identifier quoted with quote
+// and returns it without the quotes.
+func (t *Tokenizer) tokenizeDelimitedIdent(quote rune) (string, error) {
+	t.Scanner.Next()
+	end := matchingEndQuote(quote)
+
+	t.Col += 1
+
+	s := t.runes[:0]
+	defer func() { t.runes = s }()
+	for {
+		n := t.Scanner.Next()
+		if n == scanner.EOF {
+			return "", errors.Errorf("unclosed delimited identifier: %s at %+v", string(s), t.Pos())
+		}
+		if n == end {
+			t.Col += 1
+			break
+		}
+		t.advanceQuoted(n)
+		s = append(s, n)
+	}
+	return string(s), nil
+}
+
+// tokenizeUnicodeEscaped reads U&'...' or U&"..." beginning with u, which is
+// U or u. The escapes of the string are decoded by the parser because UESCAPE
+// after it may change the escape character, while the identifier is decoded
+// into Normalized here. A word U which is not followed by them is returned
+// as it is.
+func (t *Tokenizer) tokenizeUnicodeEscaped(u rune) (Kind, interface{}, error) {
+	t.Scanner.Next()
+	if t.Scanner.Peek() != '&' {
+		return SQLKeyword, t.makeWord(t.tokenizeWord(u), 0), nil
+	}
+	t.Scanner.Next()
+
+	switch t.Scanner.Peek() {
+	case '\'':
+		t.Col += 2
+		s, err := t.tokenizeSingleQuotedString()
+		if err != nil {
+			return ILLEGAL, "", err
+		}
+		return UnicodeStringLiteral, s, nil
+	case '"':
+		t.Col += 2
+		s, err := t.tokenizeDelimitedIdent('"')
+		if err != nil {
+			return ILLEGAL, "", err
+		}
+		decoded, err := DecodeUnicodeEscapes(s, '\\')
+		if err != nil {
+			return ILLEGAL, "", errors.Errorf("DecodeUnicodeEscapes failed: %w", err)
+		}
+		w := t.makeWord(s, '"')
+		w.Unicode = true
+		w.Normalized = decoded
+		return SQLKeyword, w, nil
+	}
+
+	// `&` is an operator such as U&1
+	t.pendingAmpersand = true
+	t.Col += 1
+	return SQLKeyword, t.makeWord(string(u), 0), nil
+}
+
+// tokenizeDollarQuoted reads a dollar quoted string such as $$text$$ or
+// $tag$text$tag$ whose first `$` has already been read. `$` followed by
+// a word which is not closed by `$` is returned as a character, and the word
+// is returned as the next token.
+func (t *Tokenizer) tokenizeDollarQuoted() (Kind, interface{}, error) {
+	tag := t.runes[:0]
+	for {
+		n := t.Scanner.Peek()
+		if n == '$' || !t.Dialect.IsIdentifierPart(n) {
+			break
+		}
+		t.Scanner.Next()
+		tag = append(tag, n)
+	}
+	if t.Scanner.Peek() != '$' {
+		t.pendingWord = string(tag)
+		t.runes = tag
+		t.Col += 1
+		return Char, "$", nil
+	}
+	t.Scanner.Next()
+	q := &DollarQuote{Tag: string(tag)}
+	t.Col += len(tag) + 2
+
+	delim := []rune("$" + q.Tag + "$")
+	body := tag[:0]
+	defer func() { t.runes = body }()
+	for {
+		n := t.Scanner.Next()
+		if n == scanner.EOF {
+			return ILLEGAL, "", errors.Errorf("unclosed dollar quoted string: %s at %+v", string(body), t.Pos())
+		}
+		t.advanceQuoted(n)
+		body = append(body, n)
+		if hasRuneSuffix(body, delim) {
+			q.Value = string(body[:len(body)-len(delim)])
+			return DollarQuotedString, q, nil
+		}
+	}
+}
+
+func hasRuneSuffix(s, suffix []rune) bool {
+	if len(s) < len(suffix) {
+		return false
+	}
+	for i, r := range suffix {
+		if s[len(s)-len(suffix)+i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+// tokenizeSingleQuotedString reads a single quoted string and returns its value
+// without the surrounding quotes. The position advances over the whole source text,
+// so the span of the token includes the quotes and the doubled quotes of escapes.
 func (t *Tokenizer) tokenizeSingleQuotedString() (string, error) {
-	var str []rune
+	return t.tokenizeQuotedString('\'')
+}
+
+// tokenizeQuotedString reads a string literal quoted with quote,
+// in which a doubled quote stands for the quote itself.
+func (t *Tokenizer) tokenizeQuotedString(quote rune) (string, error) {
+	str := t.runes[:0]
+	defer func() { t.runes = str }()
 	t.Scanner.Next()
+	t.Col += 1
 
 	for {
 		n := t.Scanner.Peek()
-		if n == '\'' {
+		if n == quote {
 			t.Scanner.Next()
-			if t.Scanner.Peek() == '\'' {
-				str = append(str, '\'')
+			t.Col += 1
+			if t.Scanner.Peek() == quote {
+				str = append(str, quote)
 				t.Scanner.Next()
+				t.Col += 1
 			} else {
 				break
 			}
 			continue
 		}
 		if n == scanner.EOF {
-			return "", errors.Errorf("unclosed single quoted string: %s at %+v", string(str), t.Pos())
+			return "", errors.Errorf("unclosed quoted string: %s at %+v", string(str), t.Pos())
 		}
 
 		t.Scanner.Next()
+		t.advanceQuoted(n)
 		str = append(str, n)
 	}
-	t.Col += 2 + len(str)
 
 	return string(str), nil
 }
 
+// advanceQuoted moves the position over r which is read inside of quotes.
+// Line breaks in quoted text move the position to the next line.
+func (t *Tokenizer) advanceQuoted(r rune) {
+	switch r {
+	case '\n':
+		t.Line += 1
+		t.Col = 1
+	case '\r':
+		if t.Scanner.Peek() != '\n' {
+			t.Line += 1
+			t.Col = 1
+		}
+	case '\t':
+		t.Col += t.TabWidth
+	default:
+		t.Col += 1
+	}
+}
+
+// tokenizeLineComment reads a comment until the end of line.
+// marker is the leading `--` or `#` that has already been read.
+func (t *Tokenizer) tokenizeLineComment(marker string) string {
+	t.Col += len(marker)
+
+	s := t.runes[:0]
+	defer func() { t.runes = s }()
+	for {
+		ch := t.Scanner.Peek()
+		if ch == scanner.EOF || ch == '\n' || ch == '\r' {
+			return string(s)
+		}
+		t.Scanner.Next()
+		if ch == '\t' {
+			t.Col += t.TabWidth
+		} else {
+			t.Col += 1
+		}
+		s = append(s, ch)
+	}
+}
+
+// tokenizeMultilineComment reads a block comment after its leading `/*`.
+// Under the PostgreSQL dialect block comments nest, and the inner ones
+// are kept in the returned value with their delimiters.
 func (t *Tokenizer) tokenizeMultilineComment() (string, error) {
-	var str []rune
-	var mayBeClosingComment bool
+	str := t.runes[:0]
+	defer func() { t.runes = str }()
+	nested := t.nestedComments()
+	depth := 1
 	t.Col += 2
 	for {
 		n := t.Scanner.Next()
@@ -455,21 +1032,30 @@ func (t *Tokenizer) tokenizeMultilineComment() (string, error) {
 			t.Line += 1
 		} else if n == scanner.EOF {
 			return "", errors.Errorf("unclosed multiline comment: %s at %+v", string(str), t.Pos())
+		} else if n == '\t' {
+			t.Col += t.TabWidth
 		} else {
 			t.Col += 1
 		}
 
-		if mayBeClosingComment {
-			if n == '/' {
+		if n == '*' && t.Scanner.Peek() == '/' {
+			t.Scanner.Next()
+			t.Col += 1
+			depth--
+			if depth == 0 {
 				break
-			} else {
-				str = append(str, n)
 			}
+			str = append(str, '*', '/')
+			continue
 		}
-		mayBeClosingComment = n == '*'
-		if !mayBeClosingComment {
-			str = append(str, n)
+		if nested && n == '/' && t.Scanner.Peek() == '*' {
+			t.Scanner.Next()
+			t.Col += 1
+			depth++
+			str = append(str, '/', '*')
+			continue
 		}
+		str = append(str, n)
 	}
 
 	return string(str), nil
@@ -2,21 +2,25 @@ package sqltoken
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	errors "golang.org/x/xerrors"
 
 	"github.com/akito0107/xsqlparser/dialect"
 )
 
 func TestTokenizer_Tokenize(t *testing.T) {
 	cases := []struct {
-		name string
-		in   string
-		out  []*Token
+		name    string
+		in      string
+		out     []*Token
+		dialect dialect.Dialect
 	}{
 		{
 			name: "whitespace",
@@ -98,8 +102,9 @@ func TestTokenizer_Tokenize(t *testing.T) {
 				{
 					Kind: SQLKeyword,
 					Value: &SQLWord{
-						Value:   "NOT",
-						Keyword: "NOT",
+						Value:      "NOT",
+						Keyword:    "NOT",
+						Normalized: "NOT",
 					},
 					From: Pos{Line: 1, Col: 11},
 					To:   Pos{Line: 1, Col: 14},
@@ -113,8 +118,9 @@ func TestTokenizer_Tokenize(t *testing.T) {
 				{
 					Kind: SQLKeyword,
 					Value: &SQLWord{
-						Value:   "select",
-						Keyword: "SELECT",
+						Value:      "select",
+						Keyword:    "SELECT",
+						Normalized: "select",
 					},
 					From: Pos{Line: 1, Col: 1},
 					To:   Pos{Line: 1, Col: 7},
@@ -143,6 +149,7 @@ func TestTokenizer_Tokenize(t *testing.T) {
 						Value:      "SELECT",
 						Keyword:    "SELECT",
 						QuoteStyle: '"',
+						Normalized: "SELECT",
 					},
 					From: Pos{Line: 1, Col: 1},
 					To:   Pos{Line: 1, Col: 9},
@@ -229,6 +236,43 @@ comment */`,
 				},
 			},
 		},
+		{
+			name: "asterisk in comment",
+			in:   "/* a*b */",
+			out: []*Token{
+				{
+					Kind:  Comment,
+					Value: " a*b ",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 10},
+				},
+			},
+		},
+		{
+			name: "block comment does not nest",
+			in:   "/* a /* b */",
+			out: []*Token{
+				{
+					Kind:  Comment,
+					Value: " a /* b ",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 13},
+				},
+			},
+		},
+		{
+			name:    "nested block comment in postgres",
+			in:      "/* a /* b /* c */ */ d */",
+			dialect: &dialect.PostgresqlDialect{},
+			out: []*Token{
+				{
+					Kind:  Comment,
+					Value: " a /* b /* c */ */ d ",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 26},
+				},
+			},
+		},
 		{
 			name: "operators",
 			in:   "1/1*1+1%1=1.1-.",
@@ -379,6 +423,48 @@ comment */`,
 				},
 			},
 		},
+		{
+			name: "shifts",
+			in:   "<<1>>2<<>",
+			out: []*Token{
+				{
+					Kind:  ShiftLeft,
+					Value: "<<",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 3},
+				},
+				{
+					Kind:  Number,
+					Value: "1",
+					From:  Pos{Line: 1, Col: 3},
+					To:    Pos{Line: 1, Col: 4},
+				},
+				{
+					Kind:  ShiftRight,
+					Value: ">>",
+					From:  Pos{Line: 1, Col: 4},
+					To:    Pos{Line: 1, Col: 6},
+				},
+				{
+					Kind:  Number,
+					Value: "2",
+					From:  Pos{Line: 1, Col: 6},
+					To:    Pos{Line: 1, Col: 7},
+				},
+				{
+					Kind:  Lt,
+					Value: "<",
+					From:  Pos{Line: 1, Col: 7},
+					To:    Pos{Line: 1, Col: 8},
+				},
+				{
+					Kind:  Neq,
+					Value: "<>",
+					From:  Pos{Line: 1, Col: 8},
+					To:    Pos{Line: 1, Col: 10},
+				},
+			},
+		},
 		{
 			name: "colons",
 			in:   ":1::1;",
@@ -457,14 +543,148 @@ comment */`,
 				},
 			},
 		},
+		{
+			name: "double pipe",
+			in:   "1||2",
+			out: []*Token{
+				{
+					Kind:  Number,
+					Value: "1",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 2},
+				},
+				{
+					Kind:  DoublePipe,
+					Value: "||",
+					From:  Pos{Line: 1, Col: 2},
+					To:    Pos{Line: 1, Col: 4},
+				},
+				{
+					Kind:  Number,
+					Value: "2",
+					From:  Pos{Line: 1, Col: 4},
+					To:    Pos{Line: 1, Col: 5},
+				},
+			},
+		},
+		{
+			name: "bitwise operators",
+			in:   "|^",
+			out: []*Token{
+				{
+					Kind:  Pipe,
+					Value: "|",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 2},
+				},
+				{
+					Kind:  Caret,
+					Value: "^",
+					From:  Pos{Line: 1, Col: 2},
+					To:    Pos{Line: 1, Col: 3},
+				},
+			},
+		},
+		{
+			name: "named argument arrows",
+			in:   "=>:=",
+			out: []*Token{
+				{
+					Kind:  RArrow,
+					Value: "=>",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 3},
+				},
+				{
+					Kind:  ColonEq,
+					Value: ":=",
+					From:  Pos{Line: 1, Col: 3},
+					To:    Pos{Line: 1, Col: 5},
+				},
+			},
+		},
+		{
+			name: "placeholders",
+			in:   "$12,?",
+			out: []*Token{
+				{
+					Kind:  Placeholder,
+					Value: "$12",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 4},
+				},
+				{
+					Kind:  Comma,
+					Value: ",",
+					From:  Pos{Line: 1, Col: 4},
+					To:    Pos{Line: 1, Col: 5},
+				},
+				{
+					Kind:  Placeholder,
+					Value: "?",
+					From:  Pos{Line: 1, Col: 5},
+					To:    Pos{Line: 1, Col: 6},
+				},
+			},
+		},
+		{
+			name:    "regex match operators",
+			in:      "~*!~ !~*",
+			dialect: &dialect.PostgresqlDialect{},
+			out: []*Token{
+				{
+					Kind:  TildeAsterisk,
+					Value: "~*",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 3},
+				},
+				{
+					Kind:  ExclamationTilde,
+					Value: "!~",
+					From:  Pos{Line: 1, Col: 3},
+					To:    Pos{Line: 1, Col: 5},
+				},
+				{
+					Kind:  Whitespace,
+					Value: " ",
+					From:  Pos{Line: 1, Col: 5},
+					To:    Pos{Line: 1, Col: 6},
+				},
+				{
+					Kind:  ExclamationTildeAsterisk,
+					Value: "!~*",
+					From:  Pos{Line: 1, Col: 6},
+					To:    Pos{Line: 1, Col: 9},
+				},
+			},
+		},
+		{
+			name: "tilde asterisk outside postgres",
+			in:   "~*",
+			out: []*Token{
+				{
+					Kind:  Tilde,
+					Value: "~",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 2},
+				},
+				{
+					Kind:  Mult,
+					Value: "*",
+					From:  Pos{Line: 1, Col: 2},
+					To:    Pos{Line: 1, Col: 3},
+				},
+			},
+		},
 	}
 
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			src := strings.NewReader(c.in)
-			tokenizer := NewTokenizer(src, &dialect.GenericSQLDialect{})
-
-			tok, err := tokenizer.Tokenize()
+			d := c.dialect
+			if d == nil {
+				d = &dialect.GenericSQLDialect{}
+			}
+			tok, err := Tokenize(c.in, d)
 			if err != nil {
 				t.Errorf("should be no error %v", err)
 			}
@@ -491,6 +711,236 @@ comment */`,
 	}
 }
 
+func TestTokenizer_NormalizeIdent(t *testing.T) {
+	cases := []struct {
+		name    string
+		dialect dialect.Dialect
+		in      string
+		out     *SQLWord
+	}{
+		{
+			name:    "generic keeps case",
+			dialect: &dialect.GenericSQLDialect{},
+			in:      "MyTable",
+			out:     &SQLWord{Value: "MyTable", Keyword: "MYTABLE", Normalized: "MyTable"},
+		},
+		{
+			name:    "postgres folds unquoted identifier",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "MyTable",
+			out:     &SQLWord{Value: "MyTable", Keyword: "MYTABLE", Normalized: "mytable"},
+		},
+		{
+			name:    "postgres keeps quoted identifier",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      `"MyTable"`,
+			out:     &SQLWord{Value: "MyTable", Keyword: "MYTABLE", QuoteStyle: '"', Normalized: "MyTable"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tok, err := Tokenize(c.in, c.dialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if d := cmp.Diff(c.out, tok[0].Value); d != "" {
+				t.Errorf("must be same but diff: %s", d)
+			}
+		})
+	}
+}
+
+func TestTokenizer_UnicodeEscapes(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      string
+		dialect dialect.Dialect
+		out     []*Token
+	}{
+		{
+			name:    "string",
+			in:      `u&'d\0061t''a'`,
+			dialect: &dialect.PostgresqlDialect{},
+			out: []*Token{
+				{Kind: UnicodeStringLiteral, Value: `d\0061t'a`, From: NewPos(1, 1), To: NewPos(1, 15), Raw: `u&'d\0061t''a'`},
+			},
+		},
+		{
+			name:    "identifier",
+			in:      `U&"d\0061t"`,
+			dialect: &dialect.PostgresqlDialect{},
+			out: []*Token{
+				{
+					Kind:  SQLKeyword,
+					Value: &SQLWord{Value: `d\0061t`, QuoteStyle: '"', Keyword: `D\0061T`, Normalized: "dat", Unicode: true},
+					From:  NewPos(1, 1),
+					To:    NewPos(1, 12),
+					Raw:   `U&"d\0061t"`,
+				},
+			},
+		},
+		{
+			name:    "bitwise and",
+			in:      "U&1",
+			dialect: &dialect.PostgresqlDialect{},
+			out: []*Token{
+				{Kind: SQLKeyword, Value: &SQLWord{Value: "U", Keyword: "U", Normalized: "u"}, From: NewPos(1, 1), To: NewPos(1, 2), Raw: "U"},
+				{Kind: Ampersand, Value: "&", From: NewPos(1, 2), To: NewPos(1, 3), Raw: "&"},
+				{Kind: Number, Value: "1", From: NewPos(1, 3), To: NewPos(1, 4), Raw: "1"},
+			},
+		},
+		{
+			name:    "word beginning with U",
+			in:      "user",
+			dialect: &dialect.PostgresqlDialect{},
+			out: []*Token{
+				{Kind: SQLKeyword, Value: &SQLWord{Value: "user", Keyword: "USER", Normalized: "user"}, From: NewPos(1, 1), To: NewPos(1, 5), Raw: "user"},
+			},
+		},
+		{
+			name:    "generic dialect",
+			in:      "U&'a'",
+			dialect: &dialect.GenericSQLDialect{},
+			out: []*Token{
+				{Kind: SQLKeyword, Value: &SQLWord{Value: "U", Keyword: "U", Normalized: "U"}, From: NewPos(1, 1), To: NewPos(1, 2), Raw: "U"},
+				{Kind: Ampersand, Value: "&", From: NewPos(1, 2), To: NewPos(1, 3), Raw: "&"},
+				{Kind: SingleQuotedString, Value: "a", From: NewPos(1, 3), To: NewPos(1, 6), Raw: "'a'"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			toks, err := Tokenize(c.in, c.dialect, KeepRaw(true))
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if diff := cmp.Diff(c.out, toks); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+		})
+	}
+
+	t.Run("invalid escape of identifier", func(t *testing.T) {
+		if _, err := Tokenize(`U&"\00zz"`, &dialect.PostgresqlDialect{}); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
+
+func TestTokenizer_DollarQuotes(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      string
+		dialect dialect.Dialect
+		out     []*Token
+	}{
+		{
+			name:    "empty tag",
+			in:      "$$it's; $1$$",
+			dialect: &dialect.PostgresqlDialect{},
+			out: []*Token{
+				{Kind: DollarQuotedString, Value: &DollarQuote{Value: "it's; $1"}, From: NewPos(1, 1), To: NewPos(1, 13), Raw: "$$it's; $1$$"},
+			},
+		},
+		{
+			name:    "tag",
+			in:      "$fn$a$$b$fn$ x",
+			dialect: &dialect.PostgresqlDialect{},
+			out: []*Token{
+				{Kind: DollarQuotedString, Value: &DollarQuote{Tag: "fn", Value: "a$$b"}, From: NewPos(1, 1), To: NewPos(1, 13), Raw: "$fn$a$$b$fn$"},
+				{Kind: Whitespace, Value: " ", From: NewPos(1, 13), To: NewPos(1, 14), Raw: " "},
+				{Kind: SQLKeyword, Value: &SQLWord{Value: "x", Keyword: "X", Normalized: "x"}, From: NewPos(1, 14), To: NewPos(1, 15), Raw: "x"},
+			},
+		},
+		{
+			name:    "multiple lines",
+			in:      "$$a\nb$$",
+			dialect: &dialect.PostgresqlDialect{},
+			out: []*Token{
+				{Kind: DollarQuotedString, Value: &DollarQuote{Value: "a\nb"}, From: NewPos(1, 1), To: NewPos(2, 4), Raw: "$$a\nb$$"},
+			},
+		},
+		{
+			name:    "placeholder",
+			in:      "$1",
+			dialect: &dialect.PostgresqlDialect{},
+			out: []*Token{
+				{Kind: Placeholder, Value: "$1", From: NewPos(1, 1), To: NewPos(1, 3), Raw: "$1"},
+			},
+		},
+		{
+			name:    "word without closing dollar",
+			in:      "$ab+",
+			dialect: &dialect.PostgresqlDialect{},
+			out: []*Token{
+				{Kind: Char, Value: "$", From: NewPos(1, 1), To: NewPos(1, 2), Raw: "$"},
+				{Kind: SQLKeyword, Value: &SQLWord{Value: "ab", Keyword: "AB", Normalized: "ab"}, From: NewPos(1, 2), To: NewPos(1, 4), Raw: "ab"},
+				{Kind: Plus, Value: "+", From: NewPos(1, 4), To: NewPos(1, 5), Raw: "+"},
+			},
+		},
+		{
+			name:    "generic dialect",
+			in:      "$$",
+			dialect: &dialect.GenericSQLDialect{},
+			out: []*Token{
+				{Kind: Char, Value: "$", From: NewPos(1, 1), To: NewPos(1, 2), Raw: "$"},
+				{Kind: Char, Value: "$", From: NewPos(1, 2), To: NewPos(1, 3), Raw: "$"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			toks, err := Tokenize(c.in, c.dialect, KeepRaw(true))
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if diff := cmp.Diff(c.out, toks); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+		})
+	}
+
+	t.Run("unclosed", func(t *testing.T) {
+		if _, err := Tokenize("$a$text$b$", &dialect.PostgresqlDialect{}); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
+
+func TestTokenize(t *testing.T) {
+	src := "SELECT \"id\", name FROM users /* comment */\nWHERE id <> $1"
+	d := &dialect.PostgresqlDialect{}
+
+	expect, err := NewTokenizer(strings.NewReader(src), d).Tokenize()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("string", func(t *testing.T) {
+		tok, err := Tokenize(src, d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(expect, tok); diff != "" {
+			t.Errorf("must be same but diff: %s", diff)
+		}
+	})
+
+	t.Run("bytes", func(t *testing.T) {
+		tok, err := TokenizeBytes([]byte(src), d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(expect, tok); diff != "" {
+			t.Errorf("must be same but diff: %s", diff)
+		}
+	})
+}
+
 func TestTokenizer_Pos(t *testing.T) {
 	t.Run("operators", func(t *testing.T) {
 		cases := []struct {
@@ -614,6 +1064,74 @@ test comment
 		}
 	})
 
+	t.Run("tab width", func(t *testing.T) {
+		cases := []struct {
+			width  int
+			expect Pos
+		}{
+			{
+				width:  1,
+				expect: Pos{Line: 1, Col: 3},
+			},
+			{
+				width:  4,
+				expect: Pos{Line: 1, Col: 9},
+			},
+			{
+				width:  8,
+				expect: Pos{Line: 1, Col: 17},
+			},
+		}
+
+		for _, c := range cases {
+			t.Run(fmt.Sprintf("%d", c.width), func(t *testing.T) {
+				tokenizer := NewTokenizer(bytes.NewBufferString("\t\tselect\t1"), &dialect.GenericSQLDialect{})
+				tokenizer.TabWidth = c.width
+
+				toks, err := tokenizer.Tokenize()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if d := cmp.Diff(toks[2].From, c.expect); d != "" {
+					t.Errorf("must be same but diff: %s", d)
+				}
+				if d := cmp.Diff(toks[4].From, Pos{Line: 1, Col: c.expect.Col + 6 + c.width}); d != "" {
+					t.Errorf("must be same but diff: %s", d)
+				}
+			})
+		}
+
+		t.Run("inside literals and comments", func(t *testing.T) {
+			src := "'\t' \"\t\" --\t\n/*\t*/ 1"
+			toks, err := Tokenize(src, &dialect.GenericSQLDialect{}, TabWidth(8))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expects := []Pos{
+				{Line: 1, Col: 11},
+				{Line: 1, Col: 22},
+				{Line: 1, Col: 33},
+				{Line: 2, Col: 13},
+				{Line: 2, Col: 15},
+			}
+			for i, tok := range []*Token{toks[0], toks[2], toks[4], toks[6], toks[8]} {
+				if d := cmp.Diff(tok.To, expects[i]); d != "" {
+					t.Errorf("%d: must be same but diff: %s", i, d)
+				}
+			}
+		})
+
+		for _, width := range []int{0, -1} {
+			t.Run(fmt.Sprintf("%d", width), func(t *testing.T) {
+				if _, err := Tokenize("\tselect", &dialect.GenericSQLDialect{}, TabWidth(width)); err == nil {
+					t.Error("must be error but nil")
+				}
+			})
+		}
+	})
+
 	t.Run("illegal cases", func(t *testing.T) {
 		cases := []struct {
 			name   string
@@ -634,9 +1152,7 @@ test
 
 		for _, c := range cases {
 			t.Run(c.name, func(t *testing.T) {
-				tokenizer := NewTokenizer(bytes.NewBufferString(c.src), &dialect.GenericSQLDialect{})
-
-				_, err := tokenizer.Tokenize()
+				_, err := Tokenize(c.src, &dialect.GenericSQLDialect{})
 				if err == nil {
 					t.Errorf("must be error but blank")
 				}
@@ -646,3 +1162,480 @@ test
 		}
 	})
 }
+
+func TestTokenizer_Peek(t *testing.T) {
+	src := "SELECT a -- c\nFROM t"
+	expect, err := Tokenize(src, &dialect.GenericSQLDialect{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokenizer := NewTokenizer(bytes.NewBufferString(src), &dialect.GenericSQLDialect{})
+	for _, e := range expect {
+		peeked, err := tokenizer.Peek()
+		if err != nil {
+			t.Fatal(err)
+		}
+		again, err := tokenizer.Peek()
+		if err != nil {
+			t.Fatal(err)
+		}
+		next, err := tokenizer.NextToken()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if d := cmp.Diff(e, peeked); d != "" {
+			t.Errorf("must be same but diff: %s", d)
+		}
+		if peeked != again || peeked != next {
+			t.Errorf("peeked token must be returned by NextToken: %+v, %+v, %+v", peeked, again, next)
+		}
+	}
+
+	if _, err := tokenizer.Peek(); err != io.EOF {
+		t.Errorf("expected EOF but %+v", err)
+	}
+	if _, err := tokenizer.NextToken(); err != io.EOF {
+		t.Errorf("expected EOF but %+v", err)
+	}
+}
+
+func TestTokenizer_SkipOptions(t *testing.T) {
+	src := "SELECT a /* c */\n-- d\nFROM t"
+	all, err := Tokenize(src, &dialect.GenericSQLDialect{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filter := func(skip ...Kind) []*Token {
+		var toks []*Token
+	L:
+		for _, tok := range all {
+			for _, k := range skip {
+				if tok.Kind == k {
+					continue L
+				}
+			}
+			toks = append(toks, tok)
+		}
+		return toks
+	}
+
+	cases := []struct {
+		name   string
+		opts   []TokenizerOption
+		expect []*Token
+	}{
+		{
+			name:   "default",
+			expect: all,
+		},
+		{
+			name:   "skip whitespace",
+			opts:   []TokenizerOption{SkipWhitespace},
+			expect: filter(Whitespace),
+		},
+		{
+			name:   "skip comments",
+			opts:   []TokenizerOption{SkipComments},
+			expect: filter(Comment),
+		},
+		{
+			name:   "skip both",
+			opts:   []TokenizerOption{SkipWhitespace, SkipComments},
+			expect: filter(Whitespace, Comment),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			toks, err := Tokenize(src, &dialect.GenericSQLDialect{}, c.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d := cmp.Diff(c.expect, toks); d != "" {
+				t.Errorf("must be same but diff: %s", d)
+			}
+		})
+	}
+
+	t.Run("peek", func(t *testing.T) {
+		tokenizer := NewTokenizer(bytes.NewBufferString(src), &dialect.GenericSQLDialect{}, SkipWhitespace, SkipComments)
+		tokenizer.NextToken()
+		tokenizer.NextToken()
+		tok, err := tokenizer.Peek()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tok.Kind != SQLKeyword || tok.Value.(*SQLWord).Keyword != "FROM" || tok.From != (Pos{Line: 3, Col: 1}) {
+			t.Errorf("expected FROM at 3:1 but %+v", tok)
+		}
+	})
+}
+
+func TestNewTokenizer_NilDialect(t *testing.T) {
+	tokenizer := NewTokenizer(bytes.NewBufferString("SELECT \"a\" FROM t"), nil)
+	if _, ok := tokenizer.Dialect.(*dialect.GenericSQLDialect); !ok {
+		t.Errorf("expected GenericSQLDialect but %T", tokenizer.Dialect)
+	}
+
+	toks, err := tokenizer.Tokenize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect, err := Tokenize("SELECT \"a\" FROM t", &dialect.GenericSQLDialect{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := cmp.Diff(expect, toks); d != "" {
+		t.Errorf("must be same but diff: %s", d)
+	}
+}
+
+func TestTokenizer_IllegalCharacter(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		char rune
+		pos  Pos
+	}{
+		{
+			name: "nul",
+			in:   "SELECT a\x00 FROM t",
+			char: 0,
+			pos:  NewPos(1, 9),
+		},
+		{
+			name: "vertical tab",
+			in:   "SELECT a\nFROM\v t",
+			char: '\v',
+			pos:  NewPos(2, 5),
+		},
+		{
+			name: "delete",
+			in:   "SELECT \x7f",
+			char: 0x7f,
+			pos:  NewPos(1, 8),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := Tokenize(c.in, &dialect.GenericSQLDialect{})
+			var ierr *IllegalCharacterError
+			if !errors.As(err, &ierr) {
+				t.Fatalf("should be IllegalCharacterError but %v", err)
+			}
+			if ierr.Char != c.char || ierr.Pos != c.pos {
+				t.Errorf("expected %U at %v but %U at %v", c.char, c.pos, ierr.Char, ierr.Pos)
+			}
+		})
+	}
+
+	t.Run("in literal and comment", func(t *testing.T) {
+		if _, err := Tokenize("SELECT 'a\x00b' /* \v */", &dialect.GenericSQLDialect{}); err != nil {
+			t.Errorf("should be no error but %v", err)
+		}
+	})
+}
+
+func TestTokenizer_LiteralSpan(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      string
+		literal string
+		kind    Kind
+		value   interface{}
+		dialect dialect.Dialect
+	}{
+		{
+			name:    "single quoted string",
+			in:      "SELECT 'abc' FROM t",
+			literal: "'abc'",
+			kind:    SingleQuotedString,
+			value:   "abc",
+		},
+		{
+			name:    "single quoted string with escaped quotes",
+			in:      "SELECT 'it''s ''ok''' FROM t",
+			literal: "'it''s ''ok'''",
+			kind:    SingleQuotedString,
+			value:   "it's 'ok'",
+		},
+		{
+			name:    "empty string",
+			in:      "SELECT '' FROM t",
+			literal: "''",
+			kind:    SingleQuotedString,
+			value:   "",
+		},
+		{
+			name:    "multi line string",
+			in:      "SELECT 'a\nb\r\nc' FROM t",
+			literal: "'a\nb\r\nc'",
+			kind:    SingleQuotedString,
+			value:   "a\nb\r\nc",
+		},
+		{
+			name:    "string with tab",
+			in:      "SELECT 'a\tb' FROM t",
+			literal: "'a\tb'",
+			kind:    SingleQuotedString,
+			value:   "a\tb",
+		},
+		{
+			name:    "national string",
+			in:      "SELECT N'abc' FROM t",
+			literal: "N'abc'",
+			kind:    NationalStringLiteral,
+			value:   "abc",
+		},
+		{
+			name:    "national string with escaped quote",
+			in:      "SELECT N'a''b' FROM t",
+			literal: "N'a''b'",
+			kind:    NationalStringLiteral,
+			value:   "a'b",
+		},
+		{
+			name:    "double quoted identifier",
+			in:      `SELECT "a b" FROM t`,
+			literal: `"a b"`,
+			kind:    SQLKeyword,
+			value:   "a b",
+		},
+		{
+			name:    "back quoted identifier",
+			in:      "SELECT `a b` FROM t",
+			literal: "`a b`",
+			kind:    SQLKeyword,
+			value:   "a b",
+			dialect: &dialect.MySQLDialect{},
+		},
+		{
+			name:    "double quoted string of mysql",
+			in:      `SELECT "a""b" FROM t`,
+			literal: `"a""b"`,
+			kind:    SingleQuotedString,
+			value:   `a"b`,
+			dialect: &dialect.MySQLDialect{},
+		},
+		{
+			name:    "double quoted identifier of mysql with ansi quotes",
+			in:      `SELECT "a b" FROM t`,
+			literal: `"a b"`,
+			kind:    SQLKeyword,
+			value:   "a b",
+			dialect: &dialect.MySQLDialect{ANSIQuotes: true},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := c.dialect
+			if d == nil {
+				d = &dialect.GenericSQLDialect{}
+			}
+			toks, err := Tokenize(c.in, d)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			// the third token is the literal: SELECT, whitespace, literal
+			tok := toks[2]
+			if tok.Kind != c.kind {
+				t.Fatalf("expected %s but %s", c.kind, tok.Kind)
+			}
+			value := tok.Value
+			if w, ok := value.(*SQLWord); ok {
+				value = w.Value
+			}
+			if value != c.value {
+				t.Errorf("expected value %q but %q", c.value, value)
+			}
+
+			from, to := Offset(c.in, tok.From), Offset(c.in, tok.To)
+			if from < 0 || to < 0 {
+				t.Fatalf("span %v-%v is out of source", tok.From, tok.To)
+			}
+			if src := c.in[from:to]; src != c.literal {
+				t.Errorf("expected span %q but %q", c.literal, src)
+			}
+			if next := toks[3]; next.From != tok.To {
+				t.Errorf("next token should start at %v but %v", tok.To, next.From)
+			}
+		})
+	}
+
+	t.Run("unclosed delimited identifier", func(t *testing.T) {
+		if _, err := Tokenize(`SELECT "abc`, &dialect.GenericSQLDialect{}); err == nil {
+			t.Error("should be error")
+		}
+	})
+}
+
+func TestTokenizer_KeepRaw(t *testing.T) {
+	in := "SELECT 'it''s', N'a''b', \"Col\" -- note\nFROM t /* x */;"
+	toks, err := Tokenize(in, &dialect.GenericSQLDialect{}, KeepRaw(true))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	var b strings.Builder
+	for _, tok := range toks {
+		b.WriteString(tok.Raw)
+	}
+	if b.String() != in {
+		t.Errorf("concatenated Raw should be %q but %q", in, b.String())
+	}
+
+	str := toks[2]
+	if str.Kind != SingleQuotedString {
+		t.Fatalf("expected %s but %s", SingleQuotedString, str.Kind)
+	}
+	if str.Raw != "'it''s'" || str.Value != "it's" {
+		t.Errorf("expected Raw 'it''s' and Value it's but %s and %s", str.Raw, str.Value)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		toks, err := Tokenize(in, &dialect.GenericSQLDialect{}, KeepRaw(false))
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		for _, tok := range toks {
+			if tok.Raw != "" {
+				t.Errorf("Raw should be blank but %q", tok.Raw)
+			}
+		}
+	})
+
+	t.Run("multi byte", func(t *testing.T) {
+		toks, err := Tokenize("'日本' 語", &dialect.GenericSQLDialect{}, KeepRaw(true))
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if toks[0].Raw != "'日本'" || toks[2].Raw != "語" {
+			t.Errorf("unexpected Raw %q %q", toks[0].Raw, toks[2].Raw)
+		}
+	})
+}
+
+func TestPos_Compare(t *testing.T) {
+	cases := []struct {
+		name          string
+		p, q          Pos
+		before, after bool
+	}{
+		{name: "same position", p: NewPos(2, 5), q: NewPos(2, 5)},
+		{name: "same line", p: NewPos(2, 3), q: NewPos(2, 5), before: true},
+		{name: "previous line with larger column", p: NewPos(1, 10), q: NewPos(2, 1), before: true},
+		{name: "next line with smaller column", p: NewPos(3, 1), q: NewPos(2, 10), after: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.p.Before(c.q) != c.before {
+				t.Errorf("%s.Before(%s) must be %v", c.p, c.q, c.before)
+			}
+			if c.p.After(c.q) != c.after {
+				t.Errorf("%s.After(%s) must be %v", c.p, c.q, c.after)
+			}
+			if equal := !c.before && !c.after; c.p.Equal(c.q) != equal {
+				t.Errorf("%s.Equal(%s) must be %v", c.p, c.q, equal)
+			}
+			// the opposite order
+			if c.q.Before(c.p) != c.after || c.q.After(c.p) != c.before {
+				t.Errorf("comparison of %s and %s must be antisymmetric", c.q, c.p)
+			}
+		})
+	}
+
+	if s := NewPos(12, 3).String(); s != "12:3" {
+		t.Errorf("must be 12:3 but %s", s)
+	}
+}
+
+// cancelReader cancels the context after n bytes are read.
+type cancelReader struct {
+	r      io.Reader
+	n      int
+	cancel context.CancelFunc
+}
+
+func (c *cancelReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	if c.n -= n; c.n <= 0 {
+		c.cancel()
+	}
+	return n, err
+}
+
+func TestTokenizer_TokenizeContext(t *testing.T) {
+	src := largeDocument()
+
+	t.Run("not canceled", func(t *testing.T) {
+		want, err := Tokenize(src, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := NewTokenizer(strings.NewReader(src), nil).TokenizeContext(context.Background())
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if !reflect.DeepEqual(want, got) {
+			t.Error("should be same as Tokenize")
+		}
+	})
+
+	t.Run("canceled in the middle", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		r := &cancelReader{r: strings.NewReader(src), n: len(src) / 2, cancel: cancel}
+		tokenizer := NewTokenizer(r, nil)
+		if _, err := tokenizer.TokenizeContext(ctx); !errors.Is(err, context.Canceled) {
+			t.Fatalf("should be context.Canceled but %v", err)
+		}
+		if pos := tokenizer.Pos(); pos.Line == 1 || pos.Line > strings.Count(src, "\n") {
+			t.Errorf("should be aborted in the middle but at %s", pos)
+		}
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 0)
+		defer cancel()
+
+		if _, err := NewTokenizer(strings.NewReader(src), nil).TokenizeContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("should be context.DeadlineExceeded but %v", err)
+		}
+	})
+}
+
+func BenchmarkTokenizer_Tokenize(b *testing.B) {
+	src := largeDocument()
+
+	cases := []struct {
+		name    string
+		dialect dialect.Dialect
+		opts    []TokenizerOption
+	}{
+		{name: "generic", dialect: &dialect.GenericSQLDialect{}},
+		{name: "postgresql", dialect: &dialect.PostgresqlDialect{}},
+		{name: "mysql", dialect: &dialect.MySQLDialect{}},
+		{name: "skip whitespace", dialect: &dialect.GenericSQLDialect{}, opts: []TokenizerOption{SkipWhitespace}},
+		{name: "keep raw", dialect: &dialect.GenericSQLDialect{}, opts: []TokenizerOption{KeepRaw(true)}},
+	}
+
+	for _, c := range cases {
+		b.Run(c.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(src)))
+			for i := 0; i < b.N; i++ {
+				if _, err := Tokenize(src, c.dialect, c.opts...); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package sqltoken
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	errors "golang.org/x/xerrors"
+)
+
+// DecodeUnicodeEscapes decodes the escapes of U&'...' and U&"..." literals,
+// where escape is `\` unless UESCAPE specifies another character.
+// An escape is followed by 4 hexadecimal digits, + and 6 hexadecimal digits,
+// or another escape which stands for the escape character itself.
+// Surrogate pairs are combined into one character.
+func DecodeUnicodeEscapes(s string, escape rune) (string, error) {
+	if !strings.ContainsRune(s, escape) {
+		return s, nil
+	}
+
+	var b strings.Builder
+	var high rune // high surrogate waiting for the low one
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r != escape {
+			if high != 0 {
+				return "", errors.Errorf("invalid Unicode surrogate pair at %d", i)
+			}
+			b.WriteRune(r)
+			i += size
+			continue
+		}
+		i += size
+
+		if strings.HasPrefix(s[i:], string(escape)) {
+			if high != 0 {
+				return "", errors.Errorf("invalid Unicode surrogate pair at %d", i)
+			}
+			b.WriteRune(escape)
+			i += size
+			continue
+		}
+
+		digits := 4
+		if strings.HasPrefix(s[i:], "+") {
+			digits = 6
+			i++
+		}
+		if i+digits > len(s) {
+			return "", errors.Errorf("invalid Unicode escape at %d", i)
+		}
+		code, err := strconv.ParseUint(s[i:i+digits], 16, 32)
+		if err != nil {
+			return "", errors.Errorf("invalid Unicode escape at %d", i)
+		}
+		i += digits
+
+		c := rune(code)
+		switch {
+		case high != 0:
+			if !utf16.IsSurrogate(c) || c < 0xdc00 {
+				return "", errors.Errorf("invalid Unicode surrogate pair at %d", i)
+			}
+			b.WriteRune(utf16.DecodeRune(high, c))
+			high = 0
+		case 0xd800 <= c && c < 0xdc00:
+			high = c
+		case utf16.IsSurrogate(c) || !utf8.ValidRune(c) || c == 0:
+			return "", errors.Errorf("invalid Unicode code point %X", c)
+		default:
+			b.WriteRune(c)
+		}
+	}
+	if high != 0 {
+		return "", errors.New("invalid Unicode surrogate pair at the end")
+	}
+	return b.String(), nil
+}
+
+// EncodeUnicodeEscapes is the reverse of DecodeUnicodeEscapes,
+// which only doubles the escape characters in s.
+func EncodeUnicodeEscapes(s string, escape rune) string {
+	e := string(escape)
+	return strings.ReplaceAll(s, e, e+e)
+}
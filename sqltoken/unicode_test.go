@@ -0,0 +1,40 @@
+package sqltoken
+
+import "testing"
+
+func TestDecodeUnicodeEscapes(t *testing.T) {
+	cases := []struct {
+		in     string
+		escape rune
+		out    string
+	}{
+		{in: `d\0061t\0061`, escape: '\\', out: "data"},
+		{in: `\+01F600 \\`, escape: '\\', out: "😀 \\"},
+		{in: `\D83D\DE00`, escape: '\\', out: "😀"},
+		{in: `d!0061t!!`, escape: '!', out: "dat!"},
+		{in: `no escapes`, escape: '\\', out: "no escapes"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			out, err := DecodeUnicodeEscapes(c.in, c.escape)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if out != c.out {
+				t.Errorf("should be %q but %q", c.out, out)
+			}
+			if d, _ := DecodeUnicodeEscapes(EncodeUnicodeEscapes(out, c.escape), c.escape); d != out {
+				t.Errorf("encoded string must be decoded to %q but %q", out, d)
+			}
+		})
+	}
+
+	for _, in := range []string{`\006`, `\00zz`, `\+00006`, `\D83D`, `\D83Dx`, `\DE00`, `\0000`, `\+110000`} {
+		t.Run(in, func(t *testing.T) {
+			if _, err := DecodeUnicodeEscapes(in, '\\'); err == nil {
+				t.Error("should be error")
+			}
+		})
+	}
+}